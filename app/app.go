@@ -11,6 +11,7 @@ import (
 	"github.com/TruStory/truchain/x/claim"
 	"github.com/TruStory/truchain/x/community"
 	trudist "github.com/TruStory/truchain/x/distribution"
+	"github.com/TruStory/truchain/x/search"
 	truslashing "github.com/TruStory/truchain/x/slashing"
 	trustaking "github.com/TruStory/truchain/x/staking"
 	bam "github.com/cosmos/cosmos-sdk/baseapp"
@@ -63,6 +64,7 @@ var (
 		// trustory modules
 		community.AppModuleBasic{},
 		claim.AppModuleBasic{},
+		search.AppModuleBasic{},
 		account.AppModuleBasic{},
 		trubank.AppModuleBasic{},
 		trustaking.AppModuleBasic{},
@@ -79,9 +81,12 @@ var (
 		staking.NotBondedPoolName: {supply.Burner, supply.Staking},
 		gov.ModuleName:            {supply.Burner},
 		// trustory module accounts
-		trudist.UserGrowthPoolName:    {supply.Minter, supply.Burner},
-		trudist.UserRewardPoolName:    {supply.Minter, supply.Burner},
-		trustaking.UserStakesPoolName: {supply.Minter, supply.Burner},
+		trudist.UserGrowthPoolName:       {supply.Minter, supply.Burner},
+		trudist.UserRewardPoolName:       {supply.Minter, supply.Burner},
+		trustaking.UserStakesPoolName:    {supply.Minter, supply.Burner},
+		community.TreasuryPoolName:       nil,
+		claim.ClaimStakesPoolName:        {supply.Minter},
+		truslashing.AppealStakesPoolName: {supply.Minter},
 	}
 )
 
@@ -113,6 +118,7 @@ type TruChain struct {
 	appAccountKeeper      account.Keeper
 	communityKeeper       community.Keeper
 	claimKeeper           claim.Keeper
+	searchKeeper          search.Keeper
 	truBankKeeper         trubank.Keeper
 	truStakingKeeper      trustaking.Keeper
 	truSlashingKeeper     truslashing.Keeper
@@ -140,7 +146,7 @@ func NewTruChain(logger log.Logger, db dbm.DB, loadLatest bool,
 		supply.StoreKey, mint.StoreKey, distr.StoreKey, slashing.StoreKey,
 		gov.StoreKey, params.StoreKey,
 		community.StoreKey, claim.StoreKey, account.StoreKey, trustaking.StoreKey,
-		trubank.StoreKey, truslashing.StoreKey, trudist.StoreKey,
+		trubank.StoreKey, truslashing.StoreKey, trudist.StoreKey, search.StoreKey,
 	)
 	tkeys := sdk.NewTransientStoreKeys(staking.TStoreKey, params.TStoreKey)
 
@@ -201,12 +207,6 @@ func NewTruChain(logger log.Logger, db dbm.DB, loadLatest bool,
 	)
 
 	// add truchain keepers
-	app.communityKeeper = community.NewKeeper(
-		keys[community.StoreKey],
-		app.paramsKeeper.Subspace(community.StoreKey),
-		codec,
-	)
-
 	app.truBankKeeper = trubank.NewKeeper(
 		codec,
 		keys[trubank.StoreKey],
@@ -216,6 +216,13 @@ func NewTruChain(logger log.Logger, db dbm.DB, loadLatest bool,
 		app.supplyKeeper,
 	)
 
+	app.communityKeeper = community.NewKeeper(
+		keys[community.StoreKey],
+		app.paramsKeeper.Subspace(community.StoreKey),
+		codec,
+		app.truBankKeeper,
+	)
+
 	app.appAccountKeeper = account.NewKeeper(
 		keys[account.StoreKey],
 		appAccountSubspace,
@@ -225,12 +232,21 @@ func NewTruChain(logger log.Logger, db dbm.DB, loadLatest bool,
 		app.supplyKeeper,
 	)
 
+	app.searchKeeper = search.NewKeeper(
+		keys[search.StoreKey],
+		app.paramsKeeper.Subspace(search.StoreKey),
+		codec,
+	)
+
 	app.claimKeeper = claim.NewKeeper(
 		keys[claim.StoreKey],
 		app.paramsKeeper.Subspace(claim.StoreKey),
 		codec,
 		app.appAccountKeeper,
+		app.truBankKeeper,
 		app.communityKeeper,
+		app.searchKeeper,
+		app.supplyKeeper,
 	)
 
 	app.truStakingKeeper = trustaking.NewKeeper(
@@ -239,11 +255,22 @@ func NewTruChain(logger log.Logger, db dbm.DB, loadLatest bool,
 		app.appAccountKeeper,
 		app.truBankKeeper,
 		app.claimKeeper,
+		app.communityKeeper,
+		app.searchKeeper,
 		app.supplyKeeper,
 		truStakingSubspace,
 		trustaking.DefaultCodespace,
 	)
 
+	// register the claim hooks
+	// NOTE: only the claim module closes claims, so its keeper is the one that needs the hooks
+	app.claimKeeper = *app.claimKeeper.SetHooks(app.truStakingKeeper.Hooks()).
+		SetStakingKeeper(app.truStakingKeeper)
+
+	// register the keepers of the params communities can override, to validate the overrides
+	// NOTE: only the community module sets overrides, the keeper copies held by other modules only read them
+	app.communityKeeper = *app.communityKeeper.SetParamsKeepers(app.claimKeeper, app.truStakingKeeper)
+
 	app.truSlashingKeeper = truslashing.NewKeeper(
 		keys[truslashing.StoreKey],
 		truSlashingSubspace,
@@ -252,8 +279,16 @@ func NewTruChain(logger log.Logger, db dbm.DB, loadLatest bool,
 		app.truStakingKeeper,
 		app.appAccountKeeper,
 		app.claimKeeper,
+		app.communityKeeper,
+		app.supplyKeeper,
 	)
 
+	// register the account hooks so recovered accounts keep their claims, stakes and slashes
+	// NOTE: only the account module recovers accounts, the keeper copies held by other modules don't need them
+	app.appAccountKeeper = *app.appAccountKeeper.SetHooks(account.NewMultiAccountHooks(
+		app.claimKeeper.Hooks(), app.truStakingKeeper.Hooks(), app.truSlashingKeeper.Hooks(),
+	))
+
 	app.truDistributionKeeper = trudist.NewKeeper(
 		keys[trudist.StoreKey],
 		truDistSubspace,
@@ -283,13 +318,14 @@ func NewTruChain(logger log.Logger, db dbm.DB, loadLatest bool,
 		trustaking.NewAppModule(app.truStakingKeeper),
 		truslashing.NewAppModule(app.truSlashingKeeper),
 		trudist.NewAppModule(app.truDistributionKeeper),
+		search.NewAppModule(app.searchKeeper, app.claimKeeper, app.truStakingKeeper),
 	)
 
 	// During begin block slashing happens after distr.BeginBlocker so that
 	// there is nothing left over in the validator fee pool, so as to keep the
 	// CanWithdrawInvariant invariant.
 	app.mm.SetOrderBeginBlockers(mint.ModuleName, trudist.ModuleName, distr.ModuleName, slashing.ModuleName)
-	app.mm.SetOrderEndBlockers(crisis.ModuleName, gov.ModuleName, staking.ModuleName, trustaking.ModuleName, truslashing.ModuleName, account.ModuleName)
+	app.mm.SetOrderEndBlockers(crisis.ModuleName, gov.ModuleName, staking.ModuleName, claim.ModuleName, trustaking.ModuleName, truslashing.ModuleName, account.ModuleName)
 
 	// genutils must occur after staking so that pools are properly
 	// initialized with tokens from genesis accounts.
@@ -297,7 +333,8 @@ func NewTruChain(logger log.Logger, db dbm.DB, loadLatest bool,
 		staking.ModuleName, auth.ModuleName, bank.ModuleName,
 		slashing.ModuleName, gov.ModuleName, mint.ModuleName, supply.ModuleName,
 		crisis.ModuleName, genutil.ModuleName,
-		community.ModuleName, claim.ModuleName, trubank.ModuleName,
+		// search params must be set before claims and arguments are indexed
+		community.ModuleName, search.ModuleName, claim.ModuleName, trubank.ModuleName,
 		account.ModuleName, trustaking.ModuleName, truslashing.ModuleName, trudist.ModuleName)
 
 	app.mm.RegisterInvariants(&app.crisisKeeper)
@@ -3,8 +3,11 @@ package main
 import (
 	"fmt"
 
+	"github.com/TruStory/truchain/x/account"
+	"github.com/TruStory/truchain/x/bank"
 	"github.com/TruStory/truchain/x/claim"
 	"github.com/TruStory/truchain/x/community"
+	"github.com/TruStory/truchain/x/distribution"
 	"github.com/TruStory/truchain/x/slashing"
 	"github.com/TruStory/truchain/x/staking"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -39,6 +42,9 @@ func GetAdminCmd(cdc *codec.Codec) *cobra.Command {
 	adminCmd.AddCommand(ClaimAdminCmd(cdc))
 	adminCmd.AddCommand(StakingAdminCmd(cdc))
 	adminCmd.AddCommand(SlashingAdminCmd(cdc))
+	adminCmd.AddCommand(AccountAdminCmd(cdc))
+	adminCmd.AddCommand(BankAdminCmd(cdc))
+	adminCmd.AddCommand(DistributionAdminCmd(cdc))
 
 	return adminCmd
 }
@@ -180,6 +186,108 @@ func SlashingAdminCmd(cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+// AccountAdminCmd commands exposes the commands to interact with account admins
+func AccountAdminCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account [admin]",
+		Short: "Add/remove an admin to/from the account module",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			auth := cmd.Flag("auth").Value.String()
+			action := cmd.Flag("action").Value.String()
+			// build and sign the transaction, then broadcast to Tendermint
+			authAdmin, err := sdk.AccAddressFromBech32(auth)
+			if err != nil {
+				panic(err)
+			}
+			newAdmin, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				panic(err)
+			}
+			var msg sdk.Msg
+			if action == "add" {
+				msg = account.NewMsgAddAdmin(newAdmin, authAdmin)
+			} else if action == "remove" {
+				msg = account.NewMsgRemoveAdmin(newAdmin, authAdmin)
+			}
+
+			return executeMsg(cmd, args, cdc, msg)
+		},
+	}
+
+	cmd = client.PostCommands(cmd)[0]
+
+	return cmd
+}
+
+// BankAdminCmd commands exposes the commands to interact with bank admins
+func BankAdminCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bank [admin]",
+		Short: "Add/remove an admin to/from the bank module",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			auth := cmd.Flag("auth").Value.String()
+			action := cmd.Flag("action").Value.String()
+			// build and sign the transaction, then broadcast to Tendermint
+			authAdmin, err := sdk.AccAddressFromBech32(auth)
+			if err != nil {
+				panic(err)
+			}
+			newAdmin, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				panic(err)
+			}
+			var msg sdk.Msg
+			if action == "add" {
+				msg = bank.NewMsgAddAdmin(newAdmin, authAdmin)
+			} else if action == "remove" {
+				msg = bank.NewMsgRemoveAdmin(newAdmin, authAdmin)
+			}
+
+			return executeMsg(cmd, args, cdc, msg)
+		},
+	}
+
+	cmd = client.PostCommands(cmd)[0]
+
+	return cmd
+}
+
+// DistributionAdminCmd commands exposes the commands to interact with distribution admins
+func DistributionAdminCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "distribution [admin]",
+		Short: "Add/remove an admin to/from the distribution module",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			auth := cmd.Flag("auth").Value.String()
+			action := cmd.Flag("action").Value.String()
+			// build and sign the transaction, then broadcast to Tendermint
+			authAdmin, err := sdk.AccAddressFromBech32(auth)
+			if err != nil {
+				panic(err)
+			}
+			newAdmin, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				panic(err)
+			}
+			var msg sdk.Msg
+			if action == "add" {
+				msg = distribution.NewMsgAddAdmin(newAdmin, authAdmin)
+			} else if action == "remove" {
+				msg = distribution.NewMsgRemoveAdmin(newAdmin, authAdmin)
+			}
+
+			return executeMsg(cmd, args, cdc, msg)
+		},
+	}
+
+	cmd = client.PostCommands(cmd)[0]
+
+	return cmd
+}
+
 func executeMsg(cmd *cobra.Command, args []string, cdc *codec.Codec, msg sdk.Msg) error {
 	txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(auth.DefaultTxEncoder(cdc))
 	cliCtx := context.NewCLIContext().WithCodec(cdc)
@@ -50,3 +50,131 @@ func NewCommunityCmd(cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// UpdateCommunityCmd will update the details of a community
+func UpdateCommunityCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-community [id] [name] [description]",
+		Short: "Update the name, description, icon and rules of a community",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(auth.DefaultTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			iconURI := cmd.Flag("icon-uri").Value.String()
+			rules := cmd.Flag("rules").Value.String()
+
+			// build and sign the transaction, then broadcast to Tendermint
+			msg := community.NewMsgUpdateCommunity(args[0], args[1], args[2], iconURI, rules, cliCtx.GetFromAddress())
+			fromName := cliCtx.GetFromName()
+			passphrase, err := keys.GetPassphrase(fromName)
+			if err != nil {
+				return err
+			}
+
+			txBytes, err := txBldr.BuildAndSign(fromName, passphrase, []sdk.Msg{msg})
+			if err != nil {
+				return err
+			}
+
+			// broadcast to a Tendermint node
+			res, err := cliCtx.WithBroadcastMode(client.BroadcastBlock).BroadcastTx(txBytes)
+			if err != nil {
+				return err
+			}
+			fmt.Println(res)
+			return nil
+		},
+	}
+
+	cmd.Flags().String("icon-uri", "", "The URI of the community icon, left empty to remove it")
+	cmd.Flags().String("rules", "", "The rules of the community, left empty to remove them")
+	cmd = client.PostCommands(cmd)[0]
+
+	return cmd
+}
+
+// ArchiveCommunityCmd will archive a community so it stops accepting new claims
+func ArchiveCommunityCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "archive-community [id]",
+		Short: "Archive a community so it stops accepting new claims",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(auth.DefaultTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// build and sign the transaction, then broadcast to Tendermint
+			msg := community.NewMsgArchiveCommunity(args[0], cliCtx.GetFromAddress())
+			fromName := cliCtx.GetFromName()
+			passphrase, err := keys.GetPassphrase(fromName)
+			if err != nil {
+				return err
+			}
+
+			txBytes, err := txBldr.BuildAndSign(fromName, passphrase, []sdk.Msg{msg})
+			if err != nil {
+				return err
+			}
+
+			// broadcast to a Tendermint node
+			res, err := cliCtx.WithBroadcastMode(client.BroadcastBlock).BroadcastTx(txBytes)
+			if err != nil {
+				return err
+			}
+			fmt.Println(res)
+			return nil
+		},
+	}
+
+	cmd = client.PostCommands(cmd)[0]
+
+	return cmd
+}
+
+// TreasurySpendCmd will send coins from the treasury of a community
+func TreasurySpendCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "treasury-spend [community-id] [recipient] [amount]",
+		Short: "Send coins from the treasury of a community to fund a bounty or a gift",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(auth.DefaultTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			recipient, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+			amount, err := sdk.ParseCoin(args[2])
+			if err != nil {
+				return err
+			}
+
+			// build and sign the transaction, then broadcast to Tendermint
+			msg := community.NewMsgTreasurySpend(args[0], recipient, amount, cliCtx.GetFromAddress())
+			fromName := cliCtx.GetFromName()
+			passphrase, err := keys.GetPassphrase(fromName)
+			if err != nil {
+				return err
+			}
+
+			txBytes, err := txBldr.BuildAndSign(fromName, passphrase, []sdk.Msg{msg})
+			if err != nil {
+				return err
+			}
+
+			// broadcast to a Tendermint node
+			res, err := cliCtx.WithBroadcastMode(client.BroadcastBlock).BroadcastTx(txBytes)
+			if err != nil {
+				return err
+			}
+			fmt.Println(res)
+			return nil
+		},
+	}
+
+	cmd = client.PostCommands(cmd)[0]
+
+	return cmd
+}
@@ -53,6 +53,10 @@ func main() {
 		SendGiftCmd(cdc),
 		client.LineBreak,
 		NewCommunityCmd(cdc),
+		UpdateCommunityCmd(cdc),
+		ArchiveCommunityCmd(cdc),
+		TreasurySpendCmd(cdc),
+		RebuildSearchIndexCmd(cdc),
 		client.LineBreak,
 		GetParamsCmd(cdc),
 		GetAdminCmd(cdc),
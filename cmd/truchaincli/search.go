@@ -0,0 +1,52 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/TruStory/truchain/x/search"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/keys"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/spf13/cobra"
+)
+
+// RebuildSearchIndexCmd will rebuild the search index from the existing claims and arguments
+func RebuildSearchIndexCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rebuild-search-index",
+		Short: "Rebuild the search index from the existing claims and arguments",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(auth.DefaultTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// build and sign the transaction, then broadcast to Tendermint
+			msg := search.NewMsgRebuildIndex(cliCtx.GetFromAddress())
+			fromName := cliCtx.GetFromName()
+			passphrase, err := keys.GetPassphrase(fromName)
+			if err != nil {
+				return err
+			}
+
+			txBytes, err := txBldr.BuildAndSign(fromName, passphrase, []sdk.Msg{msg})
+			if err != nil {
+				return err
+			}
+
+			// broadcast to a Tendermint node
+			res, err := cliCtx.WithBroadcastMode(client.BroadcastBlock).BroadcastTx(txBytes)
+			if err != nil {
+				return err
+			}
+			fmt.Println(res)
+			return nil
+		},
+	}
+
+	cmd = client.PostCommands(cmd)[0]
+
+	return cmd
+}
@@ -2,6 +2,7 @@ package account
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -9,6 +10,8 @@ import (
 // EndBlocker called every block, process expiring stakes
 func EndBlocker(ctx sdk.Context, keeper Keeper) {
 	keeper.unjailAccounts(ctx)
+	keeper.decaySlashCounts(ctx)
+	keeper.executeRecoveries(ctx)
 }
 
 func (k Keeper) unjailAccounts(ctx sdk.Context) {
@@ -22,6 +25,10 @@ func (k Keeper) unjailAccounts(ctx sdk.Context) {
 		if err != nil {
 			panic(err)
 		}
+		err = k.afterJailExpired(ctx, acct.PrimaryAddress())
+		if err != nil {
+			panic(err)
+		}
 
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
@@ -33,3 +40,60 @@ func (k Keeper) unjailAccounts(ctx sdk.Context) {
 		k.Logger(ctx).Info(fmt.Sprintf("Unjailed %s", acct.String()))
 	}
 }
+
+// decaySlashCounts removes the slashes older than SlashCountWindow from the slash count of the users
+func (k Keeper) decaySlashCounts(ctx sdk.Context) {
+	window := k.GetParams(ctx).SlashCountWindow
+	if window == 0 {
+		return
+	}
+	cutoff := ctx.BlockHeader().Time.Add(-window)
+
+	store := k.store(ctx)
+	iterator := store.Iterator(SlashTimeAccountPrefix, sdk.PrefixEndBytes(slashTimeAccountsKey(cutoff)))
+	keys, addresses := make([][]byte, 0), make([]sdk.AccAddress, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+		addresses = append(addresses, iterator.Value())
+	}
+	iterator.Close()
+
+	for i, addr := range addresses {
+		store.Delete(keys[i])
+		user, ok := k.getAppAccount(ctx, addr)
+		if !ok {
+			continue
+		}
+		slashTimes := make([]time.Time, 0)
+		for _, slashTime := range user.SlashTimes {
+			if slashTime.After(cutoff) {
+				slashTimes = append(slashTimes, slashTime)
+			}
+		}
+		decayed := len(user.SlashTimes) - len(slashTimes)
+		if decayed == 0 {
+			continue
+		}
+		user.SlashTimes = slashTimes
+		user.SlashCount -= decayed
+		if user.SlashCount < 0 {
+			user.SlashCount = 0
+		}
+		k.setAppAccount(ctx, user)
+	}
+}
+
+// executeRecoveries recovers the AppAccounts whose recovery timelock is over.
+// A failed recovery is dropped without changing the AppAccount.
+func (k Keeper) executeRecoveries(ctx sdk.Context) {
+	for _, recovery := range k.RecoveriesBefore(ctx, ctx.BlockHeader().Time) {
+		k.deleteRecovery(ctx, recovery)
+		cacheCtx, write := ctx.CacheContext()
+		err := k.recoverAccount(cacheCtx, recovery)
+		if err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("Failed %s: %s", recovery.String(), err))
+			continue
+		}
+		write()
+	}
+}
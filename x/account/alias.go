@@ -9,6 +9,9 @@ const (
 	TransactionGift    = exported.TransactionGift
 	TransactionBacking = exported.TransactionBacking
 
+	TransactionRecoveryTransferOut = exported.TransactionRecoveryTransferOut
+	TransactionRecoveryTransferIn  = exported.TransactionRecoveryTransferIn
+
 	UserGrowthPoolName = distribution.UserGrowthPoolName
 )
 
@@ -7,9 +7,18 @@ import (
 // RegisterCodec registers all the necessary types and interfaces for the module
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgRegisterKey{}, "truchain/MsgRegisterKey", nil)
+	cdc.RegisterConcrete(MsgRegisterKeys{}, "account/MsgRegisterKeys", nil)
 	cdc.RegisterConcrete(AppAccount{}, "truchain/AppAccount", nil)
 	cdc.RegisterConcrete(PrimaryAccount{}, "truchain/PrimaryAccount", nil)
 	cdc.RegisterConcrete(MsgUpdateParams{}, "account/MsgUpdateParams", nil)
+	cdc.RegisterConcrete(MsgAddAdmin{}, "account/MsgAddAdmin", nil)
+	cdc.RegisterConcrete(MsgRemoveAdmin{}, "account/MsgRemoveAdmin", nil)
+	cdc.RegisterConcrete(MsgAddAccountKey{}, "account/MsgAddAccountKey", nil)
+	cdc.RegisterConcrete(MsgRemoveAccountKey{}, "account/MsgRemoveAccountKey", nil)
+	cdc.RegisterConcrete(MsgRequestRecovery{}, "account/MsgRequestRecovery", nil)
+	cdc.RegisterConcrete(MsgCancelRecovery{}, "account/MsgCancelRecovery", nil)
+	cdc.RegisterConcrete(MsgSetUsername{}, "account/MsgSetUsername", nil)
+	cdc.RegisterConcrete(MsgOverrideUsername{}, "account/MsgOverrideUsername", nil)
 }
 
 // ModuleCodec encodes module codec
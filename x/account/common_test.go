@@ -26,26 +26,36 @@ import (
 )
 
 // interface conformance check
-var _ BankKeeper = bankKeeper{}
+var _ BankKeeper = &bankKeeper{}
 
 type transaction struct {
 	Address sdk.Address
 	Coin    sdk.Coin
+	Type    bankexported.TransactionType
 }
 type bankKeeper struct {
 	Transactions []transaction
 }
 
 // AddCoin mock for bank keeper
-func (bk bankKeeper) AddCoin(ctx sdk.Context, to sdk.AccAddress, coin sdk.Coin,
+func (bk *bankKeeper) AddCoin(ctx sdk.Context, to sdk.AccAddress, coin sdk.Coin,
 	referenceID uint64, txType bankexported.TransactionType, setters ...bankexported.TransactionSetter) (sdk.Coins, sdk.Error) {
 
-	txn := transaction{to, coin}
+	txn := transaction{to, coin, txType}
 	bk.Transactions = append(bk.Transactions, txn)
 	return sdk.Coins{coin}, nil
 }
 
-func (bk bankKeeper) IterateUserTransactions(ctx sdk.Context, creator sdk.AccAddress, reverse bool, cb func(transaction bankexported.Transaction) (stop bool)) {
+// SubtractCoin mock for bank keeper
+func (bk *bankKeeper) SubtractCoin(ctx sdk.Context, from sdk.AccAddress, coin sdk.Coin,
+	referenceID uint64, txType bankexported.TransactionType, setters ...bankexported.TransactionSetter) (sdk.Coins, sdk.Error) {
+
+	txn := transaction{from, coin, txType}
+	bk.Transactions = append(bk.Transactions, txn)
+	return sdk.Coins{coin}, nil
+}
+
+func (bk *bankKeeper) IterateUserTransactions(ctx sdk.Context, creator sdk.AccAddress, reverse bool, cb func(transaction bankexported.Transaction) (stop bool)) {
 
 }
 
@@ -98,7 +108,7 @@ func mockDB(t *testing.T) (sdk.Context, Keeper) {
 	totalSupply := initCoins
 	supplyKeeper.SetSupply(ctx, supply.NewSupply(totalSupply))
 
-	bankKeeper := bankKeeper{
+	bankKeeper := &bankKeeper{
 		Transactions: []transaction{},
 	}
 	authKeeper := NewKeeper(authKey, paramsKeeper.Subspace(ModuleName), codec, bankKeeper, accountKeeper, supplyKeeper)
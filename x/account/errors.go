@@ -2,6 +2,7 @@ package account
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -12,6 +13,20 @@ const (
 
 	ErrorCodeAppAccountNotFound     sdk.CodeType = 201
 	ErrorCodeAppAccountCreateFailed sdk.CodeType = 202
+	ErrorCodeAccountKeyExists       sdk.CodeType = 203
+	ErrorCodeAccountKeyNotFound     sdk.CodeType = 204
+	ErrorCodeNotPrimaryAddress      sdk.CodeType = 205
+	ErrorCodeMaxAccountKeysReached  sdk.CodeType = 206
+	ErrorCodeNotRegistrar           sdk.CodeType = 207
+	ErrorCodeRecoveryExists         sdk.CodeType = 208
+	ErrorCodeRecoveryNotFound       sdk.CodeType = 209
+	ErrorCodeAddressNotAuthorised   sdk.CodeType = 210
+	ErrorCodeInvalidParams          sdk.CodeType = 211
+	ErrorCodeInvalidUsername        sdk.CodeType = 212
+	ErrorCodeUsernameTaken          sdk.CodeType = 213
+	ErrorCodeUsernameCooldown       sdk.CodeType = 214
+	ErrorCodeUsernameNotFound       sdk.CodeType = 215
+	ErrorCodeMaxBatchSizeExceeded   sdk.CodeType = 216
 )
 
 // ErrAppAccountNotFound throws an error when the searched AppAccount is not found
@@ -23,3 +38,73 @@ func ErrAppAccountNotFound(address sdk.AccAddress) sdk.Error {
 func ErrAppAccountCreateFailed(address sdk.AccAddress) sdk.Error {
 	return sdk.NewError(DefaultCodespace, ErrorCodeAppAccountCreateFailed, fmt.Sprintf("Creating AppAccount failed: %s", address))
 }
+
+// ErrAccountKeyExists throws an error when an address already belongs to an AppAccount
+func ErrAccountKeyExists(address sdk.AccAddress) sdk.Error {
+	return sdk.NewError(DefaultCodespace, ErrorCodeAccountKeyExists, fmt.Sprintf("Address already belongs to an AppAccount: %s", address))
+}
+
+// ErrAccountKeyNotFound throws an error when an address isn't linked to an AppAccount
+func ErrAccountKeyNotFound(address sdk.AccAddress) sdk.Error {
+	return sdk.NewError(DefaultCodespace, ErrorCodeAccountKeyNotFound, fmt.Sprintf("Address is not linked to the AppAccount: %s", address))
+}
+
+// ErrNotPrimaryAddress throws an error when a linked address is used where the primary address is required
+func ErrNotPrimaryAddress(address sdk.AccAddress) sdk.Error {
+	return sdk.NewError(DefaultCodespace, ErrorCodeNotPrimaryAddress, fmt.Sprintf("Address is not the primary address of an AppAccount: %s", address))
+}
+
+// ErrMaxAccountKeysReached throws an error when an AppAccount can't link more addresses
+func ErrMaxAccountKeysReached(max int) sdk.Error {
+	return sdk.NewError(DefaultCodespace, ErrorCodeMaxAccountKeysReached, fmt.Sprintf("An AppAccount can have at most %d keys", max))
+}
+
+// ErrNotRegistrar throws an error when an address other than the registrar tries to recover an account
+func ErrNotRegistrar(address sdk.AccAddress) sdk.Error {
+	return sdk.NewError(DefaultCodespace, ErrorCodeNotRegistrar, fmt.Sprintf("Address is not the registrar: %s", address))
+}
+
+// ErrRecoveryExists throws an error when an AppAccount already has a pending recovery
+func ErrRecoveryExists(address sdk.AccAddress) sdk.Error {
+	return sdk.NewError(DefaultCodespace, ErrorCodeRecoveryExists, fmt.Sprintf("AppAccount already has a pending recovery: %s", address))
+}
+
+// ErrRecoveryNotFound throws an error when an AppAccount has no pending recovery
+func ErrRecoveryNotFound(address sdk.AccAddress) sdk.Error {
+	return sdk.NewError(DefaultCodespace, ErrorCodeRecoveryNotFound, fmt.Sprintf("No pending recovery for AppAccount: %s", address))
+}
+
+// ErrAddressNotAuthorised throws an error when the address is not admin
+func ErrAddressNotAuthorised() sdk.Error {
+	return sdk.NewError(DefaultCodespace, ErrorCodeAddressNotAuthorised, "This address is not authorised to perform this action.")
+}
+
+// ErrInvalidParams throws an error when updated params have unusable values
+func ErrInvalidParams(message string) sdk.Error {
+	return sdk.NewError(DefaultCodespace, ErrorCodeInvalidParams, fmt.Sprintf("Invalid params: %s", message))
+}
+
+// ErrInvalidUsername throws an error when the username doesn't follow the format rules
+func ErrInvalidUsername(because string) sdk.Error {
+	return sdk.NewError(DefaultCodespace, ErrorCodeInvalidUsername, fmt.Sprintf("Invalid username: %s", because))
+}
+
+// ErrUsernameTaken throws an error when the username belongs to another AppAccount
+func ErrUsernameTaken(username string) sdk.Error {
+	return sdk.NewError(DefaultCodespace, ErrorCodeUsernameTaken, fmt.Sprintf("Username is already taken: %s", username))
+}
+
+// ErrUsernameCooldown throws an error when the username was changed too recently
+func ErrUsernameCooldown(until time.Time) sdk.Error {
+	return sdk.NewError(DefaultCodespace, ErrorCodeUsernameCooldown, fmt.Sprintf("Username can't be changed until %s", until))
+}
+
+// ErrUsernameNotFound throws an error when no AppAccount has the username
+func ErrUsernameNotFound(username string) sdk.Error {
+	return sdk.NewError(DefaultCodespace, ErrorCodeUsernameNotFound, fmt.Sprintf("AppAccount not found with Username: %s", username))
+}
+
+// ErrMaxBatchSizeExceeded throws an error when a batch has too many entries
+func ErrMaxBatchSizeExceeded(max int) sdk.Error {
+	return sdk.NewError(DefaultCodespace, ErrorCodeMaxBatchSizeExceeded, fmt.Sprintf("Batch can't have more than %d entries", max))
+}
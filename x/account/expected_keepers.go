@@ -9,6 +9,14 @@ import (
 type BankKeeper interface {
 	AddCoin(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coin,
 		referenceID uint64, txType bankexported.TransactionType, setters ...bankexported.TransactionSetter) (sdk.Coins, sdk.Error)
+	SubtractCoin(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coin,
+		referenceID uint64, txType bankexported.TransactionType, setters ...bankexported.TransactionSetter) (sdk.Coins, sdk.Error)
 
 	IterateUserTransactions(ctx sdk.Context, creator sdk.AccAddress, reverse bool, cb func(transaction bankexported.Transaction) (stop bool))
 }
+
+// AccountHooks event hooks for other modules to react to AppAccount changes
+type AccountHooks interface {
+	AfterAccountRecovered(ctx sdk.Context, oldAddress, newAddress sdk.AccAddress) sdk.Error
+	AfterJailExpired(ctx sdk.Context, address sdk.AccAddress) sdk.Error
+}
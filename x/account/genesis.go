@@ -2,6 +2,7 @@ package account
 
 import (
 	"fmt"
+	"strings"
 
 	app "github.com/TruStory/truchain/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -9,14 +10,17 @@ import (
 
 // GenesisState defines genesis data for the module
 type GenesisState struct {
-	AppAccounts []AppAccount `json:"app_accounts"`
-	Params      Params       `json:"params"`
+	AppAccounts        []AppAccount `json:"app_accounts"`
+	Recoveries         []Recovery   `json:"recoveries"`
+	TakenDownUsernames []string     `json:"taken_down_usernames"`
+	Params             Params       `json:"params"`
 }
 
 // NewGenesisState creates a new genesis state.
 func NewGenesisState() GenesisState {
 	return GenesisState{
 		AppAccounts: nil,
+		Recoveries:  nil,
 		Params:      DefaultParams(),
 	}
 }
@@ -28,9 +32,24 @@ func DefaultGenesisState() GenesisState { return NewGenesisState() }
 func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
 	for _, acc := range data.AppAccounts {
 		keeper.setAppAccount(ctx, acc)
-		if acc.IsJailed {
+		for _, addr := range acc.Addresses[1:] {
+			keeper.setLinkedAddress(ctx, addr, acc.PrimaryAddress())
+		}
+		if acc.IsJailed && !acc.IsJailedPermanently() {
 			keeper.setJailEndTimeAccount(ctx, acc.JailEndTime, acc.PrimaryAddress())
 		}
+		if acc.Username != "" {
+			keeper.setUsernameAddress(ctx, acc.Username, acc.PrimaryAddress())
+		}
+		for _, slashTime := range acc.SlashTimes {
+			keeper.setSlashTimeAccount(ctx, slashTime, acc.PrimaryAddress())
+		}
+	}
+	for _, recovery := range data.Recoveries {
+		keeper.setRecovery(ctx, recovery)
+	}
+	for _, username := range data.TakenDownUsernames {
+		keeper.setTakenDownUsername(ctx, username)
 	}
 	keeper.SetParams(ctx, data.Params)
 
@@ -55,23 +74,52 @@ func initUserGrowthPool(ctx sdk.Context, keeper Keeper) sdk.Error {
 // ExportGenesis exports the genesis state
 func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
 	return GenesisState{
-		AppAccounts: keeper.AppAccounts(ctx),
-		Params:      keeper.GetParams(ctx),
+		AppAccounts:        keeper.AppAccounts(ctx),
+		Recoveries:         keeper.Recoveries(ctx),
+		TakenDownUsernames: keeper.TakenDownUsernames(ctx),
+		Params:             keeper.GetParams(ctx),
 	}
 }
 
 // ValidateGenesis validates the genesis state data
 func ValidateGenesis(data GenesisState) error {
-	if len(data.Params.Registrar) == 0 {
-		return fmt.Errorf("Param: Registrar, must be a valid address")
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	addresses := make(map[string]bool)
+	usernames := make(map[string]bool)
+	for _, acc := range data.AppAccounts {
+		if len(acc.Addresses) == 0 {
+			return fmt.Errorf("AppAccount must have at least one address")
+		}
+		if acc.Username != "" {
+			if usernames[strings.ToLower(acc.Username)] {
+				return fmt.Errorf("Username %s belongs to more than one AppAccount", acc.Username)
+			}
+			usernames[strings.ToLower(acc.Username)] = true
+		}
+		for _, addr := range acc.Addresses {
+			if addresses[addr.String()] {
+				return fmt.Errorf("Address %s belongs to more than one AppAccount", addr)
+			}
+			addresses[addr.String()] = true
+		}
 	}
 
-	if data.Params.MaxSlashCount < 1 {
-		return fmt.Errorf("Param: MaxSlashCount, must have a positive value")
+	for _, recovery := range data.Recoveries {
+		if !addresses[recovery.Address.String()] {
+			return fmt.Errorf("Recovery of unknown AppAccount %s", recovery.Address)
+		}
+		if recovery.NewPubKey == nil || !sdk.AccAddress(recovery.NewPubKey.Address()).Equals(recovery.NewAddress) {
+			return fmt.Errorf("Recovery of %s has an invalid new public key", recovery.Address)
+		}
 	}
 
-	if data.Params.JailDuration.Seconds() < 1 {
-		return fmt.Errorf("Param: JailTime, must have a positive value")
+	for _, username := range data.TakenDownUsernames {
+		if usernames[strings.ToLower(username)] {
+			return fmt.Errorf("Taken down username %s belongs to an AppAccount", username)
+		}
 	}
 
 	return nil
@@ -12,6 +12,24 @@ func NewHandler(keeper Keeper) sdk.Handler {
 		switch msg := msg.(type) {
 		case MsgRegisterKey:
 			return handleMsgRegisterKey(ctx, keeper, msg)
+		case MsgRegisterKeys:
+			return handleMsgRegisterKeys(ctx, keeper, msg)
+		case MsgAddAccountKey:
+			return handleMsgAddAccountKey(ctx, keeper, msg)
+		case MsgRemoveAccountKey:
+			return handleMsgRemoveAccountKey(ctx, keeper, msg)
+		case MsgRequestRecovery:
+			return handleMsgRequestRecovery(ctx, keeper, msg)
+		case MsgCancelRecovery:
+			return handleMsgCancelRecovery(ctx, keeper, msg)
+		case MsgSetUsername:
+			return handleMsgSetUsername(ctx, keeper, msg)
+		case MsgOverrideUsername:
+			return handleMsgOverrideUsername(ctx, keeper, msg)
+		case MsgAddAdmin:
+			return handleMsgAddAdmin(ctx, keeper, msg)
+		case MsgRemoveAdmin:
+			return handleMsgRemoveAdmin(ctx, keeper, msg)
 		case MsgUpdateParams:
 			return handleMsgUpdateParams(ctx, keeper, msg)
 		default:
@@ -41,13 +59,198 @@ func handleMsgRegisterKey(ctx sdk.Context, k Keeper, msg MsgRegisterKey) sdk.Res
 	}
 }
 
+func handleMsgRegisterKeys(ctx sdk.Context, k Keeper, msg MsgRegisterKeys) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	appAccounts, err := k.RegisterKeys(ctx, msg.Registrar, msg.Registrations)
+	if err != nil {
+		return err.Result()
+	}
+
+	res, jsonErr := k.codec.MarshalJSON(appAccounts)
+	if jsonErr != nil {
+		return sdk.ErrInternal(fmt.Sprintf("Marshal result error: %s", jsonErr)).Result()
+	}
+
+	return sdk.Result{
+		Data: res,
+	}
+}
+
+func handleMsgAddAccountKey(ctx sdk.Context, k Keeper, msg MsgAddAccountKey) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	appAccount, err := k.AddAccountKey(ctx, msg.Primary, msg.Address, msg.PubKey)
+	if err != nil {
+		return err.Result()
+	}
+
+	res, jsonErr := k.codec.MarshalJSON(appAccount)
+	if jsonErr != nil {
+		return sdk.ErrInternal(fmt.Sprintf("Marshal result error: %s", jsonErr)).Result()
+	}
+
+	return sdk.Result{
+		Data:   res,
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func handleMsgRemoveAccountKey(ctx sdk.Context, k Keeper, msg MsgRemoveAccountKey) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	appAccount, err := k.RemoveAccountKey(ctx, msg.Primary, msg.Address)
+	if err != nil {
+		return err.Result()
+	}
+
+	res, jsonErr := k.codec.MarshalJSON(appAccount)
+	if jsonErr != nil {
+		return sdk.ErrInternal(fmt.Sprintf("Marshal result error: %s", jsonErr)).Result()
+	}
+
+	return sdk.Result{
+		Data:   res,
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func handleMsgRequestRecovery(ctx sdk.Context, k Keeper, msg MsgRequestRecovery) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	recovery, err := k.RequestRecovery(ctx, msg.Address, msg.NewPubKey, msg.Registrar)
+	if err != nil {
+		return err.Result()
+	}
+
+	res, jsonErr := k.codec.MarshalJSON(recovery)
+	if jsonErr != nil {
+		return sdk.ErrInternal(fmt.Sprintf("Marshal result error: %s", jsonErr)).Result()
+	}
+
+	return sdk.Result{
+		Data:   res,
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func handleMsgCancelRecovery(ctx sdk.Context, k Keeper, msg MsgCancelRecovery) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	recovery, err := k.CancelRecovery(ctx, msg.Address)
+	if err != nil {
+		return err.Result()
+	}
+
+	res, jsonErr := k.codec.MarshalJSON(recovery)
+	if jsonErr != nil {
+		return sdk.ErrInternal(fmt.Sprintf("Marshal result error: %s", jsonErr)).Result()
+	}
+
+	return sdk.Result{
+		Data:   res,
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func handleMsgSetUsername(ctx sdk.Context, k Keeper, msg MsgSetUsername) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	appAccount, err := k.SetUsername(ctx, msg.Address, msg.Username)
+	if err != nil {
+		return err.Result()
+	}
+
+	res, jsonErr := k.codec.MarshalJSON(appAccount)
+	if jsonErr != nil {
+		return sdk.ErrInternal(fmt.Sprintf("Marshal result error: %s", jsonErr)).Result()
+	}
+
+	return sdk.Result{
+		Data:   res,
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func handleMsgOverrideUsername(ctx sdk.Context, k Keeper, msg MsgOverrideUsername) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	appAccount, err := k.OverrideUsername(ctx, msg.Registrar, msg.Address, msg.Username)
+	if err != nil {
+		return err.Result()
+	}
+
+	res, jsonErr := k.codec.MarshalJSON(appAccount)
+	if jsonErr != nil {
+		return sdk.ErrInternal(fmt.Sprintf("Marshal result error: %s", jsonErr)).Result()
+	}
+
+	return sdk.Result{
+		Data:   res,
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func handleMsgAddAdmin(ctx sdk.Context, k Keeper, msg MsgAddAdmin) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	err := k.AddAdmin(ctx, msg.Admin, msg.Creator)
+	if err != nil {
+		return err.Result()
+	}
+
+	res, jsonErr := ModuleCodec.MarshalJSON(true)
+	if jsonErr != nil {
+		return sdk.ErrInternal(fmt.Sprintf("Marshal result error: %s", jsonErr)).Result()
+	}
+
+	return sdk.Result{
+		Data: res,
+	}
+}
+
+func handleMsgRemoveAdmin(ctx sdk.Context, k Keeper, msg MsgRemoveAdmin) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	err := k.RemoveAdmin(ctx, msg.Admin, msg.Remover)
+	if err != nil {
+		return err.Result()
+	}
+
+	res, jsonErr := ModuleCodec.MarshalJSON(true)
+	if jsonErr != nil {
+		return sdk.ErrInternal(fmt.Sprintf("Marshal result error: %s", jsonErr)).Result()
+	}
+
+	return sdk.Result{
+		Data: res,
+	}
+}
+
 func handleMsgUpdateParams(ctx sdk.Context, k Keeper, msg MsgUpdateParams) sdk.Result {
 	if err := msg.ValidateBasic(); err != nil {
 		return err.Result()
 	}
 
-	// TODO: only allow account admins to set params
-	err := k.UpdateParams(ctx, msg.Updates, msg.UpdatedFields)
+	err := k.UpdateParams(ctx, msg.Updater, msg.Updates, msg.UpdatedFields)
 	if err != nil {
 		return err.Result()
 	}
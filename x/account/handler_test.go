@@ -29,6 +29,61 @@ func TestHandleMsgRegisterKey(t *testing.T) {
 	assert.Equal(t, acc.GetPubKey(), publicKey)
 }
 
+func TestHandleMsgRegisterKeys(t *testing.T) {
+	ctx, keeper := mockDB(t)
+	handler := NewHandler(keeper)
+
+	registrations := make([]KeyRegistration, 0)
+	for i := 0; i < 3; i++ {
+		_, publicKey, address, coins := getFakeAppAccountParams()
+		registrations = append(registrations, KeyRegistration{address, publicKey, "secp256k1", coins})
+	}
+
+	registrar := keeper.GetParams(ctx).Registrar
+	result := handler(ctx, NewMsgRegisterKeys(registrations[0].Address, registrations))
+	assert.Equal(t, ErrorCodeNotRegistrar, result.Code)
+
+	result = handler(ctx, NewMsgRegisterKeys(registrar, registrations))
+	assert.True(t, result.IsOK())
+	var appAccounts []AppAccount
+	err := keeper.codec.UnmarshalJSON(result.Data, &appAccounts)
+	assert.NoError(t, err)
+	assert.Len(t, appAccounts, 3)
+	for _, registration := range registrations {
+		acc, err := keeper.PrimaryAccount(ctx, registration.Address)
+		assert.NoError(t, err)
+		assert.Equal(t, registration.PubKey, acc.GetPubKey())
+	}
+
+	// an already registered key fails the whole batch
+	_, publicKey, address, coins := getFakeAppAccountParams()
+	batch := []KeyRegistration{{address, publicKey, "secp256k1", coins}, registrations[0]}
+	result = handler(ctx, NewMsgRegisterKeys(registrar, batch))
+	assert.Equal(t, ErrorCodeAccountKeyExists, result.Code)
+	_, sdkErr := keeper.PrimaryAccount(ctx, address)
+	assert.Equal(t, ErrorCodeAppAccountNotFound, sdkErr.Code())
+
+	params := keeper.GetParams(ctx)
+	params.MaxRegisterBatchSize = 1
+	keeper.SetParams(ctx, params)
+	result = handler(ctx, NewMsgRegisterKeys(registrar, registrations[:2]))
+	assert.Equal(t, ErrorCodeMaxBatchSizeExceeded, result.Code)
+}
+
+func TestHandleMsgSetUsername(t *testing.T) {
+	ctx, keeper := mockDB(t)
+	handler := NewHandler(keeper)
+
+	_, publicKey, address, coins := getFakeAppAccountParams()
+	_, err := keeper.CreateAppAccount(ctx, address, coins, publicKey)
+	assert.NoError(t, err)
+
+	result := handler(ctx, NewMsgSetUsername(address, "alice"))
+	assert.True(t, result.IsOK())
+	assert.Len(t, result.Events, 1)
+	assert.Equal(t, EventTypeUsernameSet, result.Events[0].Type)
+}
+
 func TestByzantineMsg(t *testing.T) {
 	ctx, keeper := mockDB(t)
 
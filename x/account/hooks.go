@@ -0,0 +1,53 @@
+package account
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// afterAccountRecovered calls the registered hooks once an AppAccount moved to a new address
+func (k Keeper) afterAccountRecovered(ctx sdk.Context, oldAddress, newAddress sdk.AccAddress) sdk.Error {
+	if k.hooks == nil {
+		return nil
+	}
+	return k.hooks.AfterAccountRecovered(ctx, oldAddress, newAddress)
+}
+
+// afterJailExpired calls the registered hooks once an AppAccount served its jail time
+func (k Keeper) afterJailExpired(ctx sdk.Context, address sdk.AccAddress) sdk.Error {
+	if k.hooks == nil {
+		return nil
+	}
+	return k.hooks.AfterJailExpired(ctx, address)
+}
+
+// MultiAccountHooks combines the AccountHooks of several modules
+type MultiAccountHooks []AccountHooks
+
+var _ AccountHooks = MultiAccountHooks{}
+
+// NewMultiAccountHooks creates hooks calling each of the given hooks in order
+func NewMultiAccountHooks(hooks ...AccountHooks) MultiAccountHooks {
+	return hooks
+}
+
+// AfterAccountRecovered calls every hook, stopping at the first error
+func (h MultiAccountHooks) AfterAccountRecovered(ctx sdk.Context, oldAddress, newAddress sdk.AccAddress) sdk.Error {
+	for _, hook := range h {
+		err := hook.AfterAccountRecovered(ctx, oldAddress, newAddress)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AfterJailExpired calls every hook, stopping at the first error
+func (h MultiAccountHooks) AfterJailExpired(ctx sdk.Context, address sdk.AccAddress) sdk.Error {
+	for _, hook := range h {
+		err := hook.AfterJailExpired(ctx, address)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
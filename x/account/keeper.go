@@ -2,6 +2,7 @@ package account
 
 import (
 	"fmt"
+	"math"
 	"time"
 
 	app "github.com/TruStory/truchain/types"
@@ -25,6 +26,7 @@ type Keeper struct {
 	bankKeeper    BankKeeper
 	accountKeeper auth.AccountKeeper
 	supplyKeeper  supply.Keeper
+	hooks         AccountHooks
 }
 
 // NewKeeper creates a new keeper of the auth Keeper
@@ -36,13 +38,30 @@ func NewKeeper(storeKey sdk.StoreKey, paramStore params.Subspace, codec *codec.C
 		bankKeeper,
 		accountKeeper,
 		supplyKeeper,
+		nil,
 	}
 }
 
+// SetHooks sets the AppAccount hooks
+func (k *Keeper) SetHooks(hooks AccountHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set account hooks twice")
+	}
+	k.hooks = hooks
+	return k
+}
+
 // CreateAppAccount creates a new account on chain for a user
 func (k Keeper) CreateAppAccount(ctx sdk.Context, address sdk.AccAddress,
 	coins sdk.Coins, pubKey crypto.PubKey) (appAccnt AppAccount, sdkErr sdk.Error) {
 
+	if _, ok := k.linkedPrimaryAddress(ctx, address); ok {
+		return appAccnt, ErrAccountKeyExists(address)
+	}
+	if _, ok := k.getAppAccount(ctx, address); ok {
+		return appAccnt, ErrAccountKeyExists(address)
+	}
+
 	// first create a base account
 	baseAccount := auth.NewBaseAccountWithAddress(address)
 	err := baseAccount.SetPubKey(pubKey)
@@ -72,6 +91,32 @@ func (k Keeper) CreateAppAccount(ctx sdk.Context, address sdk.AccAddress,
 	return appAccnt, nil
 }
 
+// RegisterKeys creates an AppAccount for each key registration of the registrar.
+// Registrations are all or nothing, the first failing one fails the whole batch.
+func (k Keeper) RegisterKeys(ctx sdk.Context, registrar sdk.AccAddress, registrations []KeyRegistration) ([]AppAccount, sdk.Error) {
+	params := k.GetParams(ctx)
+	if !params.Registrar.Equals(registrar) {
+		return nil, ErrNotRegistrar(registrar)
+	}
+	if len(registrations) > params.MaxRegisterBatchSize {
+		return nil, ErrMaxBatchSizeExceeded(params.MaxRegisterBatchSize)
+	}
+
+	// accounts are created in a cache context, only written once the whole batch succeeded
+	cacheCtx, write := ctx.CacheContext()
+	appAccounts := make([]AppAccount, 0, len(registrations))
+	for _, registration := range registrations {
+		appAccount, err := k.CreateAppAccount(cacheCtx, registration.Address, registration.Coins, registration.PubKey)
+		if err != nil {
+			return nil, err
+		}
+		appAccounts = append(appAccounts, appAccount)
+	}
+	write()
+
+	return appAccounts, nil
+}
+
 // AppAccounts returns all app accounts
 func (k Keeper) AppAccounts(ctx sdk.Context) (appAccounts []AppAccount) {
 	iterator := sdk.KVStorePrefixIterator(k.store(ctx), AppAccountKeyPrefix)
@@ -86,13 +131,13 @@ func (k Keeper) AppAccounts(ctx sdk.Context) (appAccounts []AppAccount) {
 	return appAccounts
 }
 
-// PrimaryAccount gets the primary base account
+// PrimaryAccount gets the primary base account, addr can be any address of the AppAccount
 func (k Keeper) PrimaryAccount(ctx sdk.Context, addr sdk.AccAddress) (pAcc PrimaryAccount, err sdk.Error) {
 	appAcc, ok := k.getAppAccount(ctx, addr)
 	if !ok {
 		return pAcc, ErrAppAccountNotFound(addr)
 	}
-	acc := k.accountKeeper.GetAccount(ctx, addr)
+	acc := k.accountKeeper.GetAccount(ctx, appAcc.PrimaryAddress())
 
 	pAcc = PrimaryAccount{
 		BaseAccount: auth.BaseAccount{
@@ -102,6 +147,7 @@ func (k Keeper) PrimaryAccount(ctx sdk.Context, addr sdk.AccAddress) (pAcc Prima
 			AccountNumber: acc.GetAccountNumber(),
 			Sequence:      acc.GetSequence(),
 		},
+		Username:    appAcc.Username,
 		SlashCount:  appAcc.SlashCount,
 		IsJailed:    appAcc.IsJailed,
 		JailEndTime: appAcc.JailEndTime,
@@ -136,7 +182,7 @@ func (k Keeper) JailUntil(ctx sdk.Context, address sdk.AccAddress, until time.Ti
 
 	// delete previous jail time
 	if user.IsJailed {
-		k.deleteJailEndTimeAccount(ctx, user.JailEndTime, user.Addresses[0])
+		k.deleteJailEndTimeAccount(ctx, user.JailEndTime, user.PrimaryAddress())
 	}
 	user.IsJailed = true
 	user.JailEndTime = until
@@ -144,7 +190,7 @@ func (k Keeper) JailUntil(ctx sdk.Context, address sdk.AccAddress, until time.Ti
 	k.setAppAccount(ctx, user)
 
 	// persist in jail list (sorted by jail end time)
-	k.setJailEndTimeAccount(ctx, until, address)
+	k.setJailEndTimeAccount(ctx, until, user.PrimaryAddress())
 
 	return nil
 }
@@ -156,7 +202,23 @@ func (k Keeper) UnJail(ctx sdk.Context, address sdk.AccAddress) sdk.Error {
 		return ErrAppAccountNotFound(address)
 	}
 	user.IsJailed = false
-	k.deleteJailEndTimeAccount(ctx, user.JailEndTime, user.Addresses[0])
+	k.deleteJailEndTimeAccount(ctx, user.JailEndTime, user.PrimaryAddress())
+	k.setAppAccount(ctx, user)
+
+	return nil
+}
+
+// PardonLastJail drops the last jailing from the jail history of an AppAccount,
+// so it doesn't count towards the duration of the next jailings.
+func (k Keeper) PardonLastJail(ctx sdk.Context, address sdk.AccAddress) sdk.Error {
+	user, ok := k.getAppAccount(ctx, address)
+	if !ok {
+		return ErrAppAccountNotFound(address)
+	}
+	if len(user.JailHistory) == 0 {
+		return nil
+	}
+	user.JailHistory = user.JailHistory[:len(user.JailHistory)-1]
 	k.setAppAccount(ctx, user)
 
 	return nil
@@ -172,26 +234,44 @@ func (k Keeper) IsJailed(ctx sdk.Context, address sdk.AccAddress) (bool, sdk.Err
 	return user.IsJailed, nil
 }
 
-// IncrementSlashCount increments the slash count of the user
+// IncrementSlashCount increments the slash count of the user and jails it once MaxSlashCount is reached.
+// Every jailing lasts twice as long as the previous one, after MaxJailCount jailings the user is jailed permanently.
 func (k Keeper) IncrementSlashCount(ctx sdk.Context, address sdk.AccAddress) (jailed bool, err sdk.Error) {
 	user, ok := k.getAppAccount(ctx, address)
 	if !ok {
 		return false, ErrAppAccountNotFound(address)
 	}
 
+	params := k.GetParams(ctx)
+	slashTime := ctx.BlockHeader().Time
 	user.SlashCount++
-	k.setAppAccount(ctx, user)
+	user.SlashTimes = append(user.SlashTimes, slashTime)
+	k.setSlashTimeAccount(ctx, slashTime, user.PrimaryAddress())
 
-	if user.SlashCount >= k.GetParams(ctx).MaxSlashCount {
-		jailEndTime := ctx.BlockHeader().Time.Add(k.GetParams(ctx).JailDuration)
-		err := k.JailUntil(ctx, user.Addresses[0], jailEndTime)
-		if err != nil {
-			return false, err
-		}
-		return true, nil
+	if user.SlashCount < params.MaxSlashCount {
+		k.setAppAccount(ctx, user)
+		return false, nil
+	}
+
+	// slashes leading to a jailing don't count towards the next one
+	for _, cleared := range user.SlashTimes {
+		k.deleteSlashTimeAccount(ctx, cleared, user.PrimaryAddress())
+	}
+	user.SlashCount = 0
+	user.SlashTimes = nil
+	if user.IsJailed {
+		k.deleteJailEndTimeAccount(ctx, user.JailEndTime, user.PrimaryAddress())
+	}
+	user.IsJailed = true
+	user.JailEndTime = time.Time{}
+	if params.MaxJailCount == 0 || len(user.JailHistory) < params.MaxJailCount {
+		user.JailEndTime = slashTime.Add(jailDuration(params.JailDuration, len(user.JailHistory)))
+		k.setJailEndTimeAccount(ctx, user.JailEndTime, user.PrimaryAddress())
 	}
+	user.JailHistory = append(user.JailHistory, Jail{StartTime: slashTime, EndTime: user.JailEndTime})
+	k.setAppAccount(ctx, user)
 
-	return false, nil
+	return true, nil
 }
 
 // IterateAppAccounts iterates over all the stored app accounts and performs a callback function
@@ -209,7 +289,60 @@ func (k Keeper) IterateAppAccounts(ctx sdk.Context, cb func(acc AppAccount) (sto
 	}
 }
 
+// AddAdmin adds a new admin. The first admins are set in the genesis params.
+func (k Keeper) AddAdmin(ctx sdk.Context, admin, creator sdk.AccAddress) sdk.Error {
+	if !k.isAdmin(ctx, creator) {
+		return ErrAddressNotAuthorised()
+	}
+	params := k.GetParams(ctx)
+
+	// if already present, don't add again
+	for _, currentAdmin := range params.AccountAdmins {
+		if currentAdmin.Equals(admin) {
+			return nil
+		}
+	}
+
+	params.AccountAdmins = append(params.AccountAdmins, admin)
+
+	k.SetParams(ctx, params)
+
+	return nil
+}
+
+// RemoveAdmin removes an admin
+func (k Keeper) RemoveAdmin(ctx sdk.Context, admin, remover sdk.AccAddress) sdk.Error {
+	if !k.isAdmin(ctx, remover) {
+		return ErrAddressNotAuthorised()
+	}
+
+	params := k.GetParams(ctx)
+	for i, currentAdmin := range params.AccountAdmins {
+		if currentAdmin.Equals(admin) {
+			params.AccountAdmins = append(params.AccountAdmins[:i], params.AccountAdmins[i+1:]...)
+			break
+		}
+	}
+
+	k.SetParams(ctx, params)
+
+	return nil
+}
+
+func (k Keeper) isAdmin(ctx sdk.Context, address sdk.AccAddress) bool {
+	for _, admin := range k.GetParams(ctx).AccountAdmins {
+		if address.Equals(admin) {
+			return true
+		}
+	}
+	return false
+}
+
+// getAppAccount gets an AppAccount by its primary or any of its linked addresses
 func (k Keeper) getAppAccount(ctx sdk.Context, addr sdk.AccAddress) (acc AppAccount, ok bool) {
+	if primary, linked := k.linkedPrimaryAddress(ctx, addr); linked {
+		addr = primary
+	}
 	accBytes := k.store(ctx).Get(key(addr))
 	if accBytes == nil {
 		return
@@ -234,6 +367,23 @@ func (k Keeper) deleteJailEndTimeAccount(ctx sdk.Context, jailEndTime time.Time,
 	store.Delete(jailEndTimeAccountKey(jailEndTime, addr))
 }
 
+func (k Keeper) setSlashTimeAccount(ctx sdk.Context, slashTime time.Time, addr sdk.AccAddress) {
+	k.store(ctx).Set(slashTimeAccountKey(slashTime, addr), addr)
+}
+
+func (k Keeper) deleteSlashTimeAccount(ctx sdk.Context, slashTime time.Time, addr sdk.AccAddress) {
+	k.store(ctx).Delete(slashTimeAccountKey(slashTime, addr))
+}
+
+// jailDuration doubles the base jail duration for every previous jailing
+func jailDuration(base time.Duration, previousJails int) time.Duration {
+	duration := base
+	for i := 0; i < previousJails && duration <= math.MaxInt64/2; i++ {
+		duration *= 2
+	}
+	return duration
+}
+
 func (k Keeper) store(ctx sdk.Context) sdk.KVStore {
 	return gaskv.NewStore(ctx.MultiStore().GetKVStore(k.storeKey), ctx.GasMeter(), app.KVGasConfig())
 }
@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -84,3 +85,403 @@ func TestIncrementSlashCount_Success(t *testing.T) {
 	assert.True(t, ok)
 	assert.Equal(t, returnedAppAccount.SlashCount, 2)
 }
+
+func TestIncrementSlashCount_ProgressiveJail(t *testing.T) {
+	ctx, keeper := mockDB(t)
+	ctx = ctx.WithBlockTime(time.Now().UTC())
+
+	_, publicKey, address, coins := getFakeAppAccountParams()
+	_, err := keeper.CreateAppAccount(ctx, address, coins, publicKey)
+	assert.NoError(t, err)
+
+	params := keeper.GetParams(ctx)
+	jail := func() AppAccount {
+		for i := 0; i < params.MaxSlashCount; i++ {
+			jailed, err := keeper.IncrementSlashCount(ctx, address)
+			assert.NoError(t, err)
+			assert.Equal(t, i == params.MaxSlashCount-1, jailed)
+		}
+		user, _ := keeper.getAppAccount(ctx, address)
+		return user
+	}
+
+	for i := 0; i < params.MaxJailCount; i++ {
+		user := jail()
+		assert.True(t, user.IsJailed)
+		assert.Equal(t, 0, user.SlashCount)
+		assert.Len(t, user.JailHistory, i+1)
+		assert.Equal(t, params.JailDuration*time.Duration(1<<uint(i)), user.JailEndTime.Sub(ctx.BlockHeader().Time))
+
+		// served the jail time
+		ctx = ctx.WithBlockTime(user.JailEndTime.Add(time.Second))
+		EndBlocker(ctx, keeper)
+		user, _ = keeper.getAppAccount(ctx, address)
+		assert.False(t, user.IsJailed)
+	}
+
+	user := jail()
+	assert.True(t, user.IsJailedPermanently())
+	assert.Len(t, user.JailHistory, params.MaxJailCount+1)
+	EndBlocker(ctx.WithBlockTime(ctx.BlockHeader().Time.AddDate(10, 0, 0)), keeper)
+	isJailed, err := keeper.IsJailed(ctx, address)
+	assert.NoError(t, err)
+	assert.True(t, isJailed)
+
+	// permanent jailings survive an export
+	genesis := ExportGenesis(ctx, keeper)
+	ctx, keeper = mockDB(t)
+	InitGenesis(ctx, keeper, genesis)
+	EndBlocker(ctx.WithBlockTime(time.Now().AddDate(10, 0, 0)), keeper)
+	user, _ = keeper.getAppAccount(ctx, address)
+	assert.True(t, user.IsJailedPermanently())
+}
+
+func TestDecaySlashCounts(t *testing.T) {
+	ctx, keeper := mockDB(t)
+	now := time.Now().UTC()
+	window := keeper.GetParams(ctx).SlashCountWindow
+
+	_, publicKey, address, coins := getFakeAppAccountParams()
+	_, err := keeper.CreateAppAccount(ctx, address, coins, publicKey)
+	assert.NoError(t, err)
+
+	_, err = keeper.IncrementSlashCount(ctx.WithBlockTime(now), address)
+	assert.NoError(t, err)
+	_, err = keeper.IncrementSlashCount(ctx.WithBlockTime(now.Add(time.Hour)), address)
+	assert.NoError(t, err)
+
+	EndBlocker(ctx.WithBlockTime(now.Add(window).Add(-time.Second)), keeper)
+	user, _ := keeper.getAppAccount(ctx, address)
+	assert.Equal(t, 2, user.SlashCount)
+
+	EndBlocker(ctx.WithBlockTime(now.Add(window)), keeper)
+	user, _ = keeper.getAppAccount(ctx, address)
+	assert.Equal(t, 1, user.SlashCount)
+	assert.Equal(t, []time.Time{now.Add(time.Hour)}, user.SlashTimes)
+
+	// a decayed slash no longer counts towards the jailing
+	jailed, err := keeper.IncrementSlashCount(ctx.WithBlockTime(now.Add(window)), address)
+	assert.NoError(t, err)
+	assert.False(t, jailed)
+
+	EndBlocker(ctx.WithBlockTime(now.Add(window*2)), keeper)
+	user, _ = keeper.getAppAccount(ctx, address)
+	assert.Equal(t, 0, user.SlashCount)
+	assert.Len(t, user.SlashTimes, 0)
+}
+
+func TestIncrementSlashCount_ClearsSlashTimes(t *testing.T) {
+	ctx, keeper := mockDB(t)
+	ctx = ctx.WithBlockTime(time.Now().UTC())
+
+	_, publicKey, address, coins := getFakeAppAccountParams()
+	_, err := keeper.CreateAppAccount(ctx, address, coins, publicKey)
+	assert.NoError(t, err)
+
+	for i := 0; i < keeper.GetParams(ctx).MaxSlashCount; i++ {
+		_, err = keeper.IncrementSlashCount(ctx, address)
+		assert.NoError(t, err)
+	}
+
+	// the slashes cleared by the jailing are no longer indexed for decay
+	iterator := sdk.KVStorePrefixIterator(keeper.store(ctx), SlashTimeAccountPrefix)
+	defer iterator.Close()
+	assert.False(t, iterator.Valid())
+}
+
+func TestAccountKeys(t *testing.T) {
+	ctx, keeper := mockDB(t)
+
+	_, publicKey, primary, coins := getFakeAppAccountParams()
+	_, err := keeper.CreateAppAccount(ctx, primary, coins, publicKey)
+	assert.NoError(t, err)
+	_, phoneKey, phone := getFakeKeyPubAddr()
+	_, _, desktop := getFakeKeyPubAddr()
+
+	_, err = keeper.AddAccountKey(ctx, phone, desktop, nil)
+	assert.Equal(t, ErrorCodeAppAccountNotFound, err.Code())
+	appAccount, err := keeper.AddAccountKey(ctx, primary, phone, phoneKey)
+	assert.NoError(t, err)
+	assert.Len(t, appAccount.Addresses, 2)
+	assert.Equal(t, primary, appAccount.PrimaryAddress())
+	assert.NotNil(t, keeper.accountKeeper.GetAccount(ctx, phone))
+
+	// linked keys can't link or be linked again
+	_, err = keeper.AddAccountKey(ctx, phone, desktop, nil)
+	assert.Equal(t, ErrorCodeNotPrimaryAddress, err.Code())
+	_, err = keeper.AddAccountKey(ctx, primary, phone, nil)
+	assert.Equal(t, ErrorCodeAccountKeyExists, err.Code())
+	_, err = keeper.CreateAppAccount(ctx, phone, coins, phoneKey)
+	assert.Equal(t, ErrorCodeAccountKeyExists, err.Code())
+
+	// linked keys resolve to the same identity
+	assert.Equal(t, primary, keeper.PrimaryAddress(ctx, phone))
+	assert.Equal(t, []sdk.AccAddress{primary, phone}, keeper.Addresses(ctx, phone))
+	assert.Equal(t, []sdk.AccAddress{desktop}, keeper.Addresses(ctx, desktop))
+	_, err = keeper.IncrementSlashCount(ctx, phone)
+	assert.NoError(t, err)
+	acc, err := keeper.PrimaryAccount(ctx, phone)
+	assert.NoError(t, err)
+	assert.Equal(t, primary, acc.GetAddress())
+	assert.Equal(t, 1, acc.SlashCount)
+	err = keeper.JailUntil(ctx, phone, time.Now().AddDate(0, 0, 10))
+	assert.NoError(t, err)
+	jailed, err := keeper.IsJailed(ctx, primary)
+	assert.NoError(t, err)
+	assert.True(t, jailed)
+	accounts, _ := keeper.JailedAccountsBefore(ctx, time.Now().AddDate(0, 0, 11))
+	assert.Len(t, accounts, 1)
+
+	_, err = keeper.RemoveAccountKey(ctx, primary, primary)
+	assert.Equal(t, sdk.ErrInvalidAddress("").Code(), err.Code())
+	_, err = keeper.RemoveAccountKey(ctx, primary, desktop)
+	assert.Equal(t, ErrorCodeAccountKeyNotFound, err.Code())
+	appAccount, err = keeper.RemoveAccountKey(ctx, primary, phone)
+	assert.NoError(t, err)
+	assert.Len(t, appAccount.Addresses, 1)
+	_, err = keeper.IsJailed(ctx, phone)
+	assert.Equal(t, ErrorCodeAppAccountNotFound, err.Code())
+}
+
+func TestAccountKeys_MaxAccountKeys(t *testing.T) {
+	ctx, keeper := mockDB(t)
+
+	_, publicKey, primary, coins := getFakeAppAccountParams()
+	_, err := keeper.CreateAppAccount(ctx, primary, coins, publicKey)
+	assert.NoError(t, err)
+
+	maxKeys := keeper.GetParams(ctx).MaxAccountKeys
+	for i := 1; i < maxKeys; i++ {
+		_, _, address := getFakeKeyPubAddr()
+		_, err = keeper.AddAccountKey(ctx, primary, address, nil)
+		assert.NoError(t, err)
+	}
+	_, _, address := getFakeKeyPubAddr()
+	_, err = keeper.AddAccountKey(ctx, primary, address, nil)
+	assert.Equal(t, ErrorCodeMaxAccountKeysReached, err.Code())
+
+	// linked addresses are restored from genesis
+	genesis := ExportGenesis(ctx, keeper)
+	assert.NoError(t, ValidateGenesis(genesis))
+	ctx, keeper = mockDB(t)
+	InitGenesis(ctx, keeper, genesis)
+	linked := genesis.AppAccounts[0].Addresses[maxKeys-1]
+	assert.Equal(t, primary, keeper.PrimaryAddress(ctx, linked))
+}
+
+type mockAccountHooks struct {
+	recovered map[string]sdk.AccAddress
+}
+
+func (h mockAccountHooks) AfterAccountRecovered(ctx sdk.Context, oldAddress, newAddress sdk.AccAddress) sdk.Error {
+	h.recovered[oldAddress.String()] = newAddress
+	return nil
+}
+
+func (h mockAccountHooks) AfterJailExpired(ctx sdk.Context, address sdk.AccAddress) sdk.Error {
+	return nil
+}
+
+func TestRecovery(t *testing.T) {
+	ctx, keeper := mockDB(t)
+	hooks := mockAccountHooks{recovered: make(map[string]sdk.AccAddress)}
+	keeper = *keeper.SetHooks(hooks)
+	registrar := keeper.GetParams(ctx).Registrar
+
+	_, publicKey, primary, coins := getFakeAppAccountParams()
+	_, err := keeper.CreateAppAccount(ctx, primary, coins, publicKey)
+	assert.NoError(t, err)
+	acc := keeper.accountKeeper.GetAccount(ctx, primary)
+	assert.NoError(t, acc.SetCoins(coins))
+	keeper.accountKeeper.SetAccount(ctx, acc)
+	_, phoneKey, phone := getFakeKeyPubAddr()
+	_, err = keeper.AddAccountKey(ctx, primary, phone, phoneKey)
+	assert.NoError(t, err)
+	err = keeper.JailUntil(ctx, primary, time.Now().AddDate(0, 0, 10))
+	assert.NoError(t, err)
+	_, newKey, newAddress := getFakeKeyPubAddr()
+
+	_, err = keeper.RequestRecovery(ctx, primary, newKey, phone)
+	assert.Equal(t, ErrorCodeNotRegistrar, err.Code())
+	_, err = keeper.RequestRecovery(ctx, primary, phoneKey, registrar)
+	assert.Equal(t, ErrorCodeAccountKeyExists, err.Code())
+
+	// the old keys can cancel the recovery during the timelock
+	recovery, err := keeper.RequestRecovery(ctx, phone, newKey, registrar)
+	assert.NoError(t, err)
+	assert.Equal(t, primary, recovery.Address)
+	assert.Equal(t, newAddress, recovery.NewAddress)
+	_, err = keeper.RequestRecovery(ctx, primary, newKey, registrar)
+	assert.Equal(t, ErrorCodeRecoveryExists, err.Code())
+	_, err = keeper.CancelRecovery(ctx, phone)
+	assert.NoError(t, err)
+	_, err = keeper.CancelRecovery(ctx, primary)
+	assert.Equal(t, ErrorCodeRecoveryNotFound, err.Code())
+	EndBlocker(ctx.WithBlockTime(recovery.ExecuteTime), keeper)
+	assert.Empty(t, hooks.recovered)
+
+	recovery, err = keeper.RequestRecovery(ctx, primary, newKey, registrar)
+	assert.NoError(t, err)
+	EndBlocker(ctx.WithBlockTime(recovery.ExecuteTime.Add(-time.Second)), keeper)
+	assert.Empty(t, hooks.recovered)
+	EndBlocker(ctx.WithBlockTime(recovery.ExecuteTime), keeper)
+	assert.Equal(t, newAddress, hooks.recovered[primary.String()])
+	_, ok := keeper.Recovery(ctx, primary)
+	assert.False(t, ok)
+
+	// the account moved to the new key, the lost key is dropped
+	appAccount, ok := keeper.getAppAccount(ctx, phone)
+	assert.True(t, ok)
+	assert.Equal(t, []sdk.AccAddress{newAddress, phone}, appAccount.Addresses)
+	assert.True(t, appAccount.IsJailed)
+	assert.Equal(t, newAddress, keeper.PrimaryAddress(ctx, phone))
+	_, ok = keeper.getAppAccount(ctx, primary)
+	assert.False(t, ok)
+	accounts, _ := keeper.JailedAccountsBefore(ctx, time.Now().AddDate(0, 0, 11))
+	assert.Len(t, accounts, 1)
+	assert.Equal(t, newKey, keeper.accountKeeper.GetAccount(ctx, newAddress).GetPubKey())
+
+	transactions := keeper.bankKeeper.(*bankKeeper).Transactions
+	assert.Equal(t, transaction{primary, coins[0], TransactionRecoveryTransferOut}, transactions[len(transactions)-2])
+	assert.Equal(t, transaction{newAddress, coins[0], TransactionRecoveryTransferIn}, transactions[len(transactions)-1])
+}
+
+func TestAddAdmin_CreatorNotAuthorised(t *testing.T) {
+	ctx, keeper := mockDB(t)
+
+	// the first admin can't be added without authorisation
+	_, _, admin, _ := getFakeAppAccountParams()
+	err := keeper.AddAdmin(ctx, admin, admin)
+	assert.Equal(t, ErrorCodeAddressNotAuthorised, err.Code())
+	assert.Len(t, keeper.GetParams(ctx).AccountAdmins, 0)
+
+	params := keeper.GetParams(ctx)
+	params.AccountAdmins = []sdk.AccAddress{admin}
+	keeper.SetParams(ctx, params)
+
+	_, _, newAdmin, _ := getFakeAppAccountParams()
+	invalidCreator := sdk.AccAddress([]byte{1, 2})
+	err = keeper.AddAdmin(ctx, newAdmin, invalidCreator)
+	assert.Equal(t, ErrorCodeAddressNotAuthorised, err.Code())
+
+	err = keeper.RemoveAdmin(ctx, admin, invalidCreator)
+	assert.Equal(t, ErrorCodeAddressNotAuthorised, err.Code())
+	assert.Equal(t, []sdk.AccAddress{admin}, keeper.GetParams(ctx).AccountAdmins)
+}
+
+func TestUpdateParams(t *testing.T) {
+	ctx, keeper := mockDB(t)
+
+	_, _, admin, _ := getFakeAppAccountParams()
+	params := keeper.GetParams(ctx)
+	params.AccountAdmins = []sdk.AccAddress{admin}
+	keeper.SetParams(ctx, params)
+
+	updates := Params{MaxSlashCount: 10}
+	invalidUpdater := sdk.AccAddress([]byte{1, 2})
+	err := keeper.UpdateParams(ctx, invalidUpdater, updates, []string{"max_slash_count"})
+	assert.Equal(t, ErrorCodeAddressNotAuthorised, err.Code())
+	assert.Equal(t, DefaultParams().MaxSlashCount, keeper.GetParams(ctx).MaxSlashCount)
+
+	err = keeper.UpdateParams(ctx, admin, updates, []string{"max_slash_count"})
+	assert.Nil(t, err)
+	assert.Equal(t, 10, keeper.GetParams(ctx).MaxSlashCount)
+
+	invalidUpdates := []struct {
+		updates Params
+		field   string
+	}{
+		{Params{JailDuration: -time.Hour}, "jail_duration"},
+		{Params{UserGrowthAllocation: sdk.NewDecWithPrec(11, 1)}, "user_growth_allocation"},
+		{Params{StakeholderAllocation: sdk.NewDec(-1)}, "stakeholder_allocation"},
+		{Params{Registrar: nil}, "registrar"},
+		{Params{RecoveryTimelock: -time.Hour}, "recovery_timelock"},
+	}
+	for _, invalid := range invalidUpdates {
+		err = keeper.UpdateParams(ctx, admin, invalid.updates, []string{invalid.field})
+		assert.Equal(t, ErrorCodeInvalidParams, err.Code(), invalid.field)
+	}
+	assert.Equal(t, 10, keeper.GetParams(ctx).MaxSlashCount)
+	assert.NotNil(t, keeper.GetParams(ctx).Registrar)
+}
+
+func TestUsernames(t *testing.T) {
+	ctx, keeper := mockDB(t)
+	ctx = ctx.WithBlockTime(time.Now().UTC())
+
+	_, publicKey, address, coins := getFakeAppAccountParams()
+	_, err := keeper.CreateAppAccount(ctx, address, coins, publicKey)
+	assert.NoError(t, err)
+	_, publicKey, other, coins := getFakeAppAccountParams()
+	_, err = keeper.CreateAppAccount(ctx, other, coins, publicKey)
+	assert.NoError(t, err)
+
+	invalid := []string{"ab", "1alice", "alice!", "alice_in_wonderland_x", "Admin"}
+	for _, username := range invalid {
+		_, err = keeper.SetUsername(ctx, address, username)
+		assert.Equal(t, ErrorCodeInvalidUsername, err.Code(), username)
+	}
+
+	user, err := keeper.SetUsername(ctx, address, "Alice")
+	assert.NoError(t, err)
+	assert.Equal(t, "Alice", user.Username)
+	owner, ok := keeper.UsernameAddress(ctx, "aLiCe")
+	assert.True(t, ok)
+	assert.Equal(t, address, owner)
+
+	// usernames are unique regardless of case
+	_, err = keeper.SetUsername(ctx, other, "alice")
+	assert.Equal(t, ErrorCodeUsernameTaken, err.Code())
+
+	_, err = keeper.SetUsername(ctx, address, "Alicia")
+	assert.Equal(t, ErrorCodeUsernameCooldown, err.Code())
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(keeper.GetParams(ctx).UsernameCooldown))
+	_, err = keeper.SetUsername(ctx, address, "Alicia")
+	assert.NoError(t, err)
+	_, ok = keeper.UsernameAddress(ctx, "alice")
+	assert.False(t, ok)
+
+	// the registrar takes down an impersonation
+	registrar := keeper.GetParams(ctx).Registrar
+	_, err = keeper.SetUsername(ctx, other, "Alice")
+	assert.NoError(t, err)
+	_, err = keeper.OverrideUsername(ctx, other, other, "")
+	assert.Equal(t, ErrorCodeNotRegistrar, err.Code())
+	user, err = keeper.OverrideUsername(ctx, registrar, other, "")
+	assert.NoError(t, err)
+	assert.Equal(t, "", user.Username)
+	_, ok = keeper.UsernameAddress(ctx, "alice")
+	assert.False(t, ok)
+
+	// a taken down username can't be claimed again
+	_, err = keeper.SetUsername(ctx, other, "ALICE")
+	assert.Equal(t, ErrorCodeInvalidUsername, err.Code())
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(keeper.GetParams(ctx).UsernameCooldown))
+	_, err = keeper.SetUsername(ctx, other, "alice")
+	assert.Equal(t, ErrorCodeInvalidUsername, err.Code())
+	assert.Equal(t, []string{"alice"}, keeper.TakenDownUsernames(ctx))
+
+	// the registrar can give reserved usernames
+	_, err = keeper.OverrideUsername(ctx, registrar, other, "admin")
+	assert.NoError(t, err)
+	primaryAccount, err := keeper.PrimaryAccount(ctx, other)
+	assert.NoError(t, err)
+	assert.Equal(t, "admin", primaryAccount.Username)
+
+	// usernames are restored from genesis
+	genesis := ExportGenesis(ctx, keeper)
+	assert.NoError(t, ValidateGenesis(genesis))
+	ctx, keeper = mockDB(t)
+	InitGenesis(ctx, keeper, genesis)
+	owner, ok = keeper.UsernameAddress(ctx, "ALICIA")
+	assert.True(t, ok)
+	assert.Equal(t, address, owner)
+	_, err = keeper.SetUsername(ctx, other, "alice")
+	assert.Equal(t, ErrorCodeInvalidUsername, err.Code())
+
+	// the registrar can give a taken down username back
+	user, err = keeper.OverrideUsername(ctx, registrar, other, "alice")
+	assert.NoError(t, err)
+	assert.Equal(t, "alice", user.Username)
+	assert.Equal(t, []string{"admin"}, keeper.TakenDownUsernames(ctx))
+}
@@ -1,6 +1,7 @@
 package account
 
 import (
+	"strings"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -12,10 +13,31 @@ import (
 // - 0x00<AccAddress>: AppAccount
 //
 // - 0x10<jailEndTime_Bytes><AccAddress>: AccAddress
+//
+// - 0x11<slashTime_Bytes><AccAddress>: AccAddress
+//
+// - 0x20<linked_AccAddress>: primary AccAddress
+//
+// - 0x30<AccAddress>: Recovery
+//
+// - 0x31<executeTime_Bytes><AccAddress>: AccAddress
+//
+// - 0x40<lowercase_username>: primary AccAddress
+//
+// - 0x41<lowercase_username>: empty, for usernames taken down by the registrar
 var (
 	AppAccountKeyPrefix = []byte{0x00}
 
 	JailEndTimeAccountPrefix = []byte{0x10}
+	SlashTimeAccountPrefix   = []byte{0x11}
+
+	LinkedAddressPrefix = []byte{0x20}
+
+	RecoveryKeyPrefix   = []byte{0x30}
+	RecoveryQueuePrefix = []byte{0x31}
+
+	UsernamePrefix          = []byte{0x40}
+	TakenDownUsernamePrefix = []byte{0x41}
 )
 
 func key(addr sdk.AccAddress) []byte {
@@ -29,3 +51,35 @@ func jailEndTimeAccountsKey(endTime time.Time) []byte {
 func jailEndTimeAccountKey(endTime time.Time, addr sdk.AccAddress) []byte {
 	return append(jailEndTimeAccountsKey(endTime), addr.Bytes()...)
 }
+
+func slashTimeAccountsKey(slashTime time.Time) []byte {
+	return append(SlashTimeAccountPrefix, sdk.FormatTimeBytes(slashTime)...)
+}
+
+func slashTimeAccountKey(slashTime time.Time, addr sdk.AccAddress) []byte {
+	return append(slashTimeAccountsKey(slashTime), addr.Bytes()...)
+}
+
+func linkedAddressKey(addr sdk.AccAddress) []byte {
+	return append(LinkedAddressPrefix, addr.Bytes()...)
+}
+
+func recoveryKey(addr sdk.AccAddress) []byte {
+	return append(RecoveryKeyPrefix, addr.Bytes()...)
+}
+
+func recoveryQueueTimeKey(executeTime time.Time) []byte {
+	return append(RecoveryQueuePrefix, sdk.FormatTimeBytes(executeTime)...)
+}
+
+func recoveryQueueKey(executeTime time.Time, addr sdk.AccAddress) []byte {
+	return append(recoveryQueueTimeKey(executeTime), addr.Bytes()...)
+}
+
+func usernameKey(username string) []byte {
+	return append(UsernamePrefix, []byte(strings.ToLower(username))...)
+}
+
+func takenDownUsernameKey(username string) []byte {
+	return append(TakenDownUsernamePrefix, []byte(strings.ToLower(username))...)
+}
@@ -0,0 +1,130 @@
+package account
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/tendermint/tendermint/crypto"
+)
+
+// AddAccountKey links a new address to an AppAccount so the same identity
+// can be used from several keys. Only the primary address can link keys,
+// and MsgAddAccountKey is co-signed by the linked address to prove it's owned by the user.
+func (k Keeper) AddAccountKey(ctx sdk.Context, primary, address sdk.AccAddress, pubKey crypto.PubKey) (AppAccount, sdk.Error) {
+	user, err := k.primaryAppAccount(ctx, primary)
+	if err != nil {
+		return user, err
+	}
+	if _, ok := k.getAppAccount(ctx, address); ok {
+		return user, ErrAccountKeyExists(address)
+	}
+	maxKeys := k.GetParams(ctx).MaxAccountKeys
+	if len(user.Addresses) >= maxKeys {
+		return user, ErrMaxAccountKeysReached(maxKeys)
+	}
+
+	// the linked key needs a base account to sign transactions
+	if k.accountKeeper.GetAccount(ctx, address) == nil {
+		baseAccount := auth.NewBaseAccountWithAddress(address)
+		if pubKey != nil {
+			err := baseAccount.SetPubKey(pubKey)
+			if err != nil {
+				return user, ErrAppAccountCreateFailed(address)
+			}
+		}
+		k.accountKeeper.SetAccount(ctx, &baseAccount)
+	}
+
+	user.Addresses = append(user.Addresses, address)
+	k.setAppAccount(ctx, user)
+	k.setLinkedAddress(ctx, address, primary)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeAccountKeyAdded,
+			sdk.NewAttribute(AttributeKeyUser, primary.String()),
+			sdk.NewAttribute(AttributeKeyAddress, address.String()),
+		),
+	)
+	k.Logger(ctx).Info(fmt.Sprintf("Linked %s to %s", address, primary))
+
+	return user, nil
+}
+
+// RemoveAccountKey unlinks an address from an AppAccount. The primary address can't be removed.
+func (k Keeper) RemoveAccountKey(ctx sdk.Context, primary, address sdk.AccAddress) (AppAccount, sdk.Error) {
+	user, err := k.primaryAppAccount(ctx, primary)
+	if err != nil {
+		return user, err
+	}
+	if address.Equals(primary) {
+		return user, sdk.ErrInvalidAddress("The primary address of an AppAccount can't be removed")
+	}
+	if !user.HasAddress(address) {
+		return user, ErrAccountKeyNotFound(address)
+	}
+
+	for i, addr := range user.Addresses {
+		if addr.Equals(address) {
+			user.Addresses = append(user.Addresses[:i], user.Addresses[i+1:]...)
+			break
+		}
+	}
+	k.setAppAccount(ctx, user)
+	k.store(ctx).Delete(linkedAddressKey(address))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeAccountKeyRemoved,
+			sdk.NewAttribute(AttributeKeyUser, primary.String()),
+			sdk.NewAttribute(AttributeKeyAddress, address.String()),
+		),
+	)
+	k.Logger(ctx).Info(fmt.Sprintf("Unlinked %s from %s", address, primary))
+
+	return user, nil
+}
+
+// PrimaryAddress resolves any address of an AppAccount to its primary address.
+// Addresses without an AppAccount resolve to themselves.
+func (k Keeper) PrimaryAddress(ctx sdk.Context, address sdk.AccAddress) sdk.AccAddress {
+	if primary, ok := k.linkedPrimaryAddress(ctx, address); ok {
+		return primary
+	}
+	return address
+}
+
+// Addresses returns every address of the AppAccount an address belongs to, primary first.
+// Addresses without an AppAccount only return themselves.
+func (k Keeper) Addresses(ctx sdk.Context, address sdk.AccAddress) []sdk.AccAddress {
+	user, ok := k.getAppAccount(ctx, address)
+	if !ok {
+		return []sdk.AccAddress{address}
+	}
+	return user.Addresses
+}
+
+// primaryAppAccount gets an AppAccount, making sure the address is its primary address
+func (k Keeper) primaryAppAccount(ctx sdk.Context, primary sdk.AccAddress) (AppAccount, sdk.Error) {
+	user, ok := k.getAppAccount(ctx, primary)
+	if !ok {
+		return user, ErrAppAccountNotFound(primary)
+	}
+	if !user.PrimaryAddress().Equals(primary) {
+		return user, ErrNotPrimaryAddress(primary)
+	}
+	return user, nil
+}
+
+func (k Keeper) linkedPrimaryAddress(ctx sdk.Context, address sdk.AccAddress) (sdk.AccAddress, bool) {
+	primary := k.store(ctx).Get(linkedAddressKey(address))
+	if primary == nil {
+		return nil, false
+	}
+	return sdk.AccAddress(primary), true
+}
+
+func (k Keeper) setLinkedAddress(ctx sdk.Context, address, primary sdk.AccAddress) {
+	k.store(ctx).Set(linkedAddressKey(address), primary)
+}
@@ -10,8 +10,26 @@ import (
 const (
 	// TypeMsgRegisterKey represents the type of the message for registering the key
 	TypeMsgRegisterKey = "register_key"
+	// TypeMsgRegisterKeys represents the type of the message for registering a batch of keys
+	TypeMsgRegisterKeys = "register_keys"
 	// TypeMsgUpdateParams represents the type of
 	TypeMsgUpdateParams = "update_params"
+	// TypeMsgAddAccountKey represents the type of the message for linking a key to an account
+	TypeMsgAddAccountKey = "add_account_key"
+	// TypeMsgRemoveAccountKey represents the type of the message for unlinking a key from an account
+	TypeMsgRemoveAccountKey = "remove_account_key"
+	// TypeMsgRequestRecovery represents the type of the message for requesting the recovery of an account
+	TypeMsgRequestRecovery = "request_recovery"
+	// TypeMsgCancelRecovery represents the type of the message for cancelling the recovery of an account
+	TypeMsgCancelRecovery = "cancel_recovery"
+	// TypeMsgSetUsername represents the type of the message for setting the username of an account
+	TypeMsgSetUsername = "set_username"
+	// TypeMsgOverrideUsername represents the type of the message for the registrar to change the username of an account
+	TypeMsgOverrideUsername = "override_username"
+	// TypeMsgAddAdmin represents the type of message for adding a new admin
+	TypeMsgAddAdmin = "add_admin"
+	// TypeMsgRemoveAdmin represents the type of message for removing an admin
+	TypeMsgRemoveAdmin = "remove_admin"
 )
 
 // MsgRegisterKey defines the message to register a new key
@@ -64,6 +82,437 @@ func (msg MsgRegisterKey) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Registrar}
 }
 
+// KeyRegistration is a key to register in a MsgRegisterKeys batch
+type KeyRegistration struct {
+	Address    sdk.AccAddress `json:"address"`
+	PubKey     crypto.PubKey  `json:"public_key"`
+	PubKeyAlgo string         `json:"public_key_algo"`
+	Coins      sdk.Coins      `json:"coins"`
+}
+
+// MsgRegisterKeys defines the message to register a batch of new keys in one transaction
+type MsgRegisterKeys struct {
+	Registrar     sdk.AccAddress    `json:"registrar"`
+	Registrations []KeyRegistration `json:"registrations"`
+}
+
+// NewMsgRegisterKeys returns the message to register a batch of new keys
+func NewMsgRegisterKeys(registrar sdk.AccAddress, registrations []KeyRegistration) MsgRegisterKeys {
+	return MsgRegisterKeys{
+		Registrar:     registrar,
+		Registrations: registrations,
+	}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgRegisterKeys) ValidateBasic() sdk.Error {
+	if len(msg.Registrar) == 0 {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Invalid registrar: %s", msg.Registrar.String()))
+	}
+
+	if len(msg.Registrations) == 0 {
+		return sdk.ErrUnknownRequest("Registrations can't be empty")
+	}
+
+	addresses := make(map[string]bool)
+	for _, registration := range msg.Registrations {
+		if len(registration.Address) == 0 {
+			return sdk.ErrInvalidAddress(fmt.Sprintf("Invalid address: %s", registration.Address.String()))
+		}
+		if registration.PubKey == nil {
+			return sdk.ErrInvalidPubKey(fmt.Sprintf("Missing public key for address: %s", registration.Address.String()))
+		}
+		if addresses[registration.Address.String()] {
+			return sdk.ErrInvalidAddress(fmt.Sprintf("Duplicated address: %s", registration.Address.String()))
+		}
+		addresses[registration.Address.String()] = true
+	}
+
+	return nil
+}
+
+// Route implements Msg
+func (msg MsgRegisterKeys) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgRegisterKeys) Type() string { return TypeMsgRegisterKeys }
+
+// GetSignBytes implements Msg
+func (msg MsgRegisterKeys) GetSignBytes() []byte {
+	msgBytes := ModuleCodec.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(msgBytes)
+}
+
+// GetSigners implements Msg. Returns the registrar as the signer.
+func (msg MsgRegisterKeys) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Registrar}
+}
+
+// MsgAddAccountKey defines the message to link a key to an AppAccount
+type MsgAddAccountKey struct {
+	Primary sdk.AccAddress `json:"primary"`
+	Address sdk.AccAddress `json:"address"`
+	PubKey  crypto.PubKey  `json:"public_key"`
+}
+
+// NewMsgAddAccountKey returns the message to link a key to an AppAccount
+func NewMsgAddAccountKey(primary, address sdk.AccAddress, publicKey crypto.PubKey) MsgAddAccountKey {
+	return MsgAddAccountKey{
+		Primary: primary,
+		Address: address,
+		PubKey:  publicKey,
+	}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgAddAccountKey) ValidateBasic() sdk.Error {
+	if len(msg.Primary) == 0 {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Invalid primary address: %s", msg.Primary.String()))
+	}
+
+	if len(msg.Address) == 0 || msg.Address.Equals(msg.Primary) {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Invalid address: %s", msg.Address.String()))
+	}
+
+	if msg.PubKey != nil && !sdk.AccAddress(msg.PubKey.Address()).Equals(msg.Address) {
+		return sdk.ErrInvalidPubKey("Public key doesn't match the address")
+	}
+
+	return nil
+}
+
+// Route implements Msg
+func (msg MsgAddAccountKey) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgAddAccountKey) Type() string { return TypeMsgAddAccountKey }
+
+// GetSignBytes implements Msg
+func (msg MsgAddAccountKey) GetSignBytes() []byte {
+	msgBytes := ModuleCodec.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(msgBytes)
+}
+
+// GetSigners implements Msg. Both the primary address and the linked address sign,
+// so a key can only be linked by the user holding it.
+func (msg MsgAddAccountKey) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Primary, msg.Address}
+}
+
+// MsgRemoveAccountKey defines the message to unlink a key from an AppAccount
+type MsgRemoveAccountKey struct {
+	Primary sdk.AccAddress `json:"primary"`
+	Address sdk.AccAddress `json:"address"`
+}
+
+// NewMsgRemoveAccountKey returns the message to unlink a key from an AppAccount
+func NewMsgRemoveAccountKey(primary, address sdk.AccAddress) MsgRemoveAccountKey {
+	return MsgRemoveAccountKey{
+		Primary: primary,
+		Address: address,
+	}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgRemoveAccountKey) ValidateBasic() sdk.Error {
+	if len(msg.Primary) == 0 {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Invalid primary address: %s", msg.Primary.String()))
+	}
+
+	if len(msg.Address) == 0 || msg.Address.Equals(msg.Primary) {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Invalid address: %s", msg.Address.String()))
+	}
+
+	return nil
+}
+
+// Route implements Msg
+func (msg MsgRemoveAccountKey) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgRemoveAccountKey) Type() string { return TypeMsgRemoveAccountKey }
+
+// GetSignBytes implements Msg
+func (msg MsgRemoveAccountKey) GetSignBytes() []byte {
+	msgBytes := ModuleCodec.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(msgBytes)
+}
+
+// GetSigners implements Msg. Returns the primary address as the signer.
+func (msg MsgRemoveAccountKey) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Primary}
+}
+
+// MsgRequestRecovery defines the message to recover an AppAccount to a new key
+type MsgRequestRecovery struct {
+	Registrar sdk.AccAddress `json:"registrar"`
+	Address   sdk.AccAddress `json:"address"`
+	NewPubKey crypto.PubKey  `json:"new_public_key"`
+}
+
+// NewMsgRequestRecovery returns the message to recover an AppAccount to a new key
+func NewMsgRequestRecovery(registrar, address sdk.AccAddress, newPubKey crypto.PubKey) MsgRequestRecovery {
+	return MsgRequestRecovery{
+		Registrar: registrar,
+		Address:   address,
+		NewPubKey: newPubKey,
+	}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgRequestRecovery) ValidateBasic() sdk.Error {
+	if len(msg.Registrar) == 0 {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Invalid registrar: %s", msg.Registrar.String()))
+	}
+
+	if len(msg.Address) == 0 {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Invalid address: %s", msg.Address.String()))
+	}
+
+	if msg.NewPubKey == nil {
+		return sdk.ErrInvalidPubKey("Missing new public key")
+	}
+
+	if sdk.AccAddress(msg.NewPubKey.Address()).Equals(msg.Address) {
+		return sdk.ErrInvalidPubKey("New public key must be different from the recovered address")
+	}
+
+	return nil
+}
+
+// Route implements Msg
+func (msg MsgRequestRecovery) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgRequestRecovery) Type() string { return TypeMsgRequestRecovery }
+
+// GetSignBytes implements Msg
+func (msg MsgRequestRecovery) GetSignBytes() []byte {
+	msgBytes := ModuleCodec.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(msgBytes)
+}
+
+// GetSigners implements Msg. Returns the registrar as the signer.
+func (msg MsgRequestRecovery) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Registrar}
+}
+
+// MsgCancelRecovery defines the message to cancel the pending recovery of an AppAccount
+type MsgCancelRecovery struct {
+	Address sdk.AccAddress `json:"address"`
+}
+
+// NewMsgCancelRecovery returns the message to cancel the pending recovery of an AppAccount
+func NewMsgCancelRecovery(address sdk.AccAddress) MsgCancelRecovery {
+	return MsgCancelRecovery{
+		Address: address,
+	}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgCancelRecovery) ValidateBasic() sdk.Error {
+	if len(msg.Address) == 0 {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Invalid address: %s", msg.Address.String()))
+	}
+
+	return nil
+}
+
+// Route implements Msg
+func (msg MsgCancelRecovery) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgCancelRecovery) Type() string { return TypeMsgCancelRecovery }
+
+// GetSignBytes implements Msg
+func (msg MsgCancelRecovery) GetSignBytes() []byte {
+	msgBytes := ModuleCodec.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(msgBytes)
+}
+
+// GetSigners implements Msg. Returns the address of the recovered account as the signer.
+func (msg MsgCancelRecovery) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Address}
+}
+
+// MsgSetUsername defines the message to set the username of an AppAccount
+type MsgSetUsername struct {
+	Address  sdk.AccAddress `json:"address"`
+	Username string         `json:"username"`
+}
+
+// NewMsgSetUsername returns the message to set the username of an AppAccount
+func NewMsgSetUsername(address sdk.AccAddress, username string) MsgSetUsername {
+	return MsgSetUsername{
+		Address:  address,
+		Username: username,
+	}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgSetUsername) ValidateBasic() sdk.Error {
+	if len(msg.Address) == 0 {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Invalid address: %s", msg.Address.String()))
+	}
+
+	if len(msg.Username) == 0 {
+		return ErrInvalidUsername("Username can't be empty.")
+	}
+
+	return nil
+}
+
+// Route implements Msg
+func (msg MsgSetUsername) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgSetUsername) Type() string { return TypeMsgSetUsername }
+
+// GetSignBytes implements Msg
+func (msg MsgSetUsername) GetSignBytes() []byte {
+	msgBytes := ModuleCodec.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(msgBytes)
+}
+
+// GetSigners implements Msg. Returns the address of the account as the signer.
+func (msg MsgSetUsername) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Address}
+}
+
+// MsgOverrideUsername defines the message for the registrar to change the username of an AppAccount
+type MsgOverrideUsername struct {
+	Registrar sdk.AccAddress `json:"registrar"`
+	Address   sdk.AccAddress `json:"address"`
+	Username  string         `json:"username"`
+}
+
+// NewMsgOverrideUsername returns the message for the registrar to change the username of an AppAccount
+func NewMsgOverrideUsername(registrar, address sdk.AccAddress, username string) MsgOverrideUsername {
+	return MsgOverrideUsername{
+		Registrar: registrar,
+		Address:   address,
+		Username:  username,
+	}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgOverrideUsername) ValidateBasic() sdk.Error {
+	if len(msg.Registrar) == 0 {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Invalid registrar: %s", msg.Registrar.String()))
+	}
+
+	if len(msg.Address) == 0 {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Invalid address: %s", msg.Address.String()))
+	}
+
+	return nil
+}
+
+// Route implements Msg
+func (msg MsgOverrideUsername) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgOverrideUsername) Type() string { return TypeMsgOverrideUsername }
+
+// GetSignBytes implements Msg
+func (msg MsgOverrideUsername) GetSignBytes() []byte {
+	msgBytes := ModuleCodec.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(msgBytes)
+}
+
+// GetSigners implements Msg. Returns the registrar as the signer.
+func (msg MsgOverrideUsername) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Registrar}
+}
+
+// MsgAddAdmin defines the message to add a new admin
+type MsgAddAdmin struct {
+	Admin   sdk.AccAddress `json:"admin"`
+	Creator sdk.AccAddress `json:"creator"`
+}
+
+// NewMsgAddAdmin returns the messages to add a new admin
+func NewMsgAddAdmin(admin, creator sdk.AccAddress) MsgAddAdmin {
+	return MsgAddAdmin{
+		Admin:   admin,
+		Creator: creator,
+	}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgAddAdmin) ValidateBasic() sdk.Error {
+	if len(msg.Admin) == 0 {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Invalid address: %s", msg.Admin.String()))
+	}
+
+	if len(msg.Creator) == 0 {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Invalid address: %s", msg.Creator.String()))
+	}
+
+	return nil
+}
+
+// Route implements Msg
+func (msg MsgAddAdmin) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgAddAdmin) Type() string { return TypeMsgAddAdmin }
+
+// GetSignBytes implements Msg
+func (msg MsgAddAdmin) GetSignBytes() []byte {
+	msgBytes := ModuleCodec.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(msgBytes)
+}
+
+// GetSigners implements Msg. Returns the creator as the signer.
+func (msg MsgAddAdmin) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Creator)}
+}
+
+// MsgRemoveAdmin defines the message to remove an admin
+type MsgRemoveAdmin struct {
+	Admin   sdk.AccAddress `json:"admin"`
+	Remover sdk.AccAddress `json:"remover"`
+}
+
+// NewMsgRemoveAdmin returns the messages to remove an admin
+func NewMsgRemoveAdmin(admin, remover sdk.AccAddress) MsgRemoveAdmin {
+	return MsgRemoveAdmin{
+		Admin:   admin,
+		Remover: remover,
+	}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgRemoveAdmin) ValidateBasic() sdk.Error {
+	if len(msg.Admin) == 0 {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Invalid address: %s", msg.Admin.String()))
+	}
+
+	if len(msg.Remover) == 0 {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Invalid address: %s", msg.Remover.String()))
+	}
+
+	return nil
+}
+
+// Route implements Msg
+func (msg MsgRemoveAdmin) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgRemoveAdmin) Type() string { return TypeMsgRemoveAdmin }
+
+// GetSignBytes implements Msg
+func (msg MsgRemoveAdmin) GetSignBytes() []byte {
+	msgBytes := ModuleCodec.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(msgBytes)
+}
+
+// GetSigners implements Msg. Returns the remover as the signer.
+func (msg MsgRemoveAdmin) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Remover)}
+}
+
 // MsgUpdateParams defines the message to remove an admin
 type MsgUpdateParams struct {
 	Updates       Params         `json:"updates"`
@@ -82,6 +531,10 @@ func NewMsgUpdateParams(updates Params, updatedFields []string, updater sdk.AccA
 
 // ValidateBasic implements Msg
 func (msg MsgUpdateParams) ValidateBasic() sdk.Error {
+	if len(msg.Updater) == 0 {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Invalid updater: %s", msg.Updater.String()))
+	}
+
 	return nil
 }
 
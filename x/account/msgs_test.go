@@ -21,6 +21,29 @@ func TestMsgRegisterKey_Success(t *testing.T) {
 	assert.Equal(t, TypeMsgRegisterKey, msg.Type())
 }
 
+func TestMsgRegisterKeys(t *testing.T) {
+	ctx, keeper := mockDB(t)
+
+	_, publicKey, address, coins := getFakeAppAccountParams()
+	registrar := keeper.GetParams(ctx).Registrar
+	registration := KeyRegistration{address, publicKey, "secp256k1", coins}
+
+	msg := NewMsgRegisterKeys(registrar, []KeyRegistration{registration})
+	assert.Nil(t, msg.ValidateBasic())
+	assert.Equal(t, ModuleName, msg.Route())
+	assert.Equal(t, TypeMsgRegisterKeys, msg.Type())
+
+	msg = NewMsgRegisterKeys(registrar, nil)
+	assert.Equal(t, sdk.CodeUnknownRequest, msg.ValidateBasic().Code())
+
+	msg = NewMsgRegisterKeys(registrar, []KeyRegistration{registration, registration})
+	assert.Equal(t, sdk.CodeInvalidAddress, msg.ValidateBasic().Code())
+
+	registration.PubKey = nil
+	msg = NewMsgRegisterKeys(registrar, []KeyRegistration{registration})
+	assert.Equal(t, sdk.CodeInvalidPubKey, msg.ValidateBasic().Code())
+}
+
 func TestMsgNewCommunity_InvalidAddress(t *testing.T) {
 	ctx, keeper := mockDB(t)
 
@@ -34,3 +57,49 @@ func TestMsgNewCommunity_InvalidAddress(t *testing.T) {
 	assert.NotNil(t, err)
 	assert.Equal(t, sdk.ErrInvalidAddress("").Code(), err.Code())
 }
+
+func TestMsgAccountKeys(t *testing.T) {
+	_, _, primary := getFakeKeyPubAddr()
+	_, publicKey, address := getFakeKeyPubAddr()
+
+	msg := NewMsgAddAccountKey(primary, address, publicKey)
+	assert.Nil(t, msg.ValidateBasic())
+	assert.Equal(t, ModuleName, msg.Route())
+	assert.Equal(t, TypeMsgAddAccountKey, msg.Type())
+	assert.Equal(t, []sdk.AccAddress{primary, address}, msg.GetSigners())
+
+	msg = NewMsgAddAccountKey(primary, primary, publicKey)
+	assert.Equal(t, sdk.ErrInvalidAddress("").Code(), msg.ValidateBasic().Code())
+	msg = NewMsgAddAccountKey(address, primary, publicKey)
+	assert.Equal(t, sdk.ErrInvalidPubKey("").Code(), msg.ValidateBasic().Code())
+
+	remove := NewMsgRemoveAccountKey(primary, address)
+	assert.Nil(t, remove.ValidateBasic())
+	assert.Equal(t, TypeMsgRemoveAccountKey, remove.Type())
+	remove = NewMsgRemoveAccountKey(primary, nil)
+	assert.Equal(t, sdk.ErrInvalidAddress("").Code(), remove.ValidateBasic().Code())
+}
+
+func TestMsgRecovery(t *testing.T) {
+	_, _, registrar := getFakeKeyPubAddr()
+	_, publicKey, address := getFakeKeyPubAddr()
+	_, newKey, _ := getFakeKeyPubAddr()
+
+	msg := NewMsgRequestRecovery(registrar, address, newKey)
+	assert.Nil(t, msg.ValidateBasic())
+	assert.Equal(t, ModuleName, msg.Route())
+	assert.Equal(t, TypeMsgRequestRecovery, msg.Type())
+	assert.Equal(t, []sdk.AccAddress{registrar}, msg.GetSigners())
+
+	msg = NewMsgRequestRecovery(registrar, address, nil)
+	assert.Equal(t, sdk.ErrInvalidPubKey("").Code(), msg.ValidateBasic().Code())
+	msg = NewMsgRequestRecovery(registrar, address, publicKey)
+	assert.Equal(t, sdk.ErrInvalidPubKey("").Code(), msg.ValidateBasic().Code())
+
+	cancel := NewMsgCancelRecovery(address)
+	assert.Nil(t, cancel.ValidateBasic())
+	assert.Equal(t, TypeMsgCancelRecovery, cancel.Type())
+	assert.Equal(t, []sdk.AccAddress{address}, cancel.GetSigners())
+	cancel = NewMsgCancelRecovery(nil)
+	assert.Equal(t, sdk.ErrInvalidAddress("").Code(), cancel.ValidateBasic().Code())
+}
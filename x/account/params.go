@@ -16,15 +16,33 @@ var (
 	KeyJailDuration          = []byte("jailTime")
 	KeyUserGrowthAllocation  = []byte("userGrowthAllocation")
 	KeyStakeholderAllocation = []byte("stakeholderAllocation")
+	KeyMaxAccountKeys        = []byte("maxAccountKeys")
+	KeyRecoveryTimelock      = []byte("recoveryTimelock")
+	KeyAccountAdmins         = []byte("accountAdmins")
+	KeyMaxJailCount          = []byte("maxJailCount")
+	KeySlashCountWindow      = []byte("slashCountWindow")
+	KeyMinUsernameLength     = []byte("minUsernameLength")
+	KeyMaxUsernameLength     = []byte("maxUsernameLength")
+	KeyUsernameCooldown      = []byte("usernameCooldown")
+	KeyMaxRegisterBatchSize  = []byte("maxRegisterBatchSize")
 )
 
 // Params holds parameters for Auth
 type Params struct {
-	Registrar             sdk.AccAddress `json:"registrar"`
-	MaxSlashCount         int            `json:"max_slash_count"`
-	JailDuration          time.Duration  `json:"jail_duration"`
-	UserGrowthAllocation  sdk.Dec        `json:"user_growth_allocation"`
-	StakeholderAllocation sdk.Dec        `json:"stakeholder_allocation"`
+	Registrar             sdk.AccAddress   `json:"registrar"`
+	MaxSlashCount         int              `json:"max_slash_count"`
+	JailDuration          time.Duration    `json:"jail_duration"`
+	UserGrowthAllocation  sdk.Dec          `json:"user_growth_allocation"`
+	StakeholderAllocation sdk.Dec          `json:"stakeholder_allocation"`
+	MaxAccountKeys        int              `json:"max_account_keys"`
+	RecoveryTimelock      time.Duration    `json:"recovery_timelock"`
+	AccountAdmins         []sdk.AccAddress `json:"account_admins"`
+	MaxJailCount          int              `json:"max_jail_count"`
+	SlashCountWindow      time.Duration    `json:"slash_count_window"`
+	MinUsernameLength     int              `json:"min_username_length"`
+	MaxUsernameLength     int              `json:"max_username_length"`
+	UsernameCooldown      time.Duration    `json:"username_cooldown"`
+	MaxRegisterBatchSize  int              `json:"max_register_batch_size"`
 }
 
 // DefaultParams is the auth params for testing
@@ -35,6 +53,15 @@ func DefaultParams() Params {
 		JailDuration:          24 * time.Hour * 7,
 		UserGrowthAllocation:  sdk.NewDecWithPrec(20, 2),
 		StakeholderAllocation: sdk.NewDecWithPrec(20, 2),
+		MaxAccountKeys:        5,
+		RecoveryTimelock:      24 * time.Hour * 3,
+		AccountAdmins:         []sdk.AccAddress{},
+		MaxJailCount:          3,
+		SlashCountWindow:      24 * time.Hour * 30,
+		MinUsernameLength:     3,
+		MaxUsernameLength:     20,
+		UsernameCooldown:      24 * time.Hour * 30,
+		MaxRegisterBatchSize:  100,
 	}
 }
 
@@ -46,9 +73,80 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		{Key: KeyJailDuration, Value: &p.JailDuration},
 		{Key: KeyUserGrowthAllocation, Value: &p.UserGrowthAllocation},
 		{Key: KeyStakeholderAllocation, Value: &p.StakeholderAllocation},
+		{Key: KeyMaxAccountKeys, Value: &p.MaxAccountKeys},
+		{Key: KeyRecoveryTimelock, Value: &p.RecoveryTimelock},
+		{Key: KeyAccountAdmins, Value: &p.AccountAdmins},
+		{Key: KeyMaxJailCount, Value: &p.MaxJailCount},
+		{Key: KeySlashCountWindow, Value: &p.SlashCountWindow},
+		{Key: KeyMinUsernameLength, Value: &p.MinUsernameLength},
+		{Key: KeyMaxUsernameLength, Value: &p.MaxUsernameLength},
+		{Key: KeyUsernameCooldown, Value: &p.UsernameCooldown},
+		{Key: KeyMaxRegisterBatchSize, Value: &p.MaxRegisterBatchSize},
 	}
 }
 
+// Validate checks that every param has a usable value
+func (p Params) Validate() error {
+	if p.Registrar.Empty() {
+		return fmt.Errorf("Param: Registrar, must be a valid address")
+	}
+
+	if p.MaxSlashCount < 1 {
+		return fmt.Errorf("Param: MaxSlashCount, must have a positive value")
+	}
+
+	if p.JailDuration.Seconds() < 1 {
+		return fmt.Errorf("Param: JailTime, must have a positive value")
+	}
+
+	allocations := []struct {
+		name  string
+		value sdk.Dec
+	}{
+		{"UserGrowthAllocation", p.UserGrowthAllocation},
+		{"StakeholderAllocation", p.StakeholderAllocation},
+	}
+	for _, allocation := range allocations {
+		if allocation.value.IsNil() || allocation.value.IsNegative() || allocation.value.GT(sdk.OneDec()) {
+			return fmt.Errorf("Param: %s, must be between 0 and 1", allocation.name)
+		}
+	}
+
+	if p.MaxAccountKeys < 1 {
+		return fmt.Errorf("Param: MaxAccountKeys, must have a positive value")
+	}
+
+	if p.RecoveryTimelock < 0 {
+		return fmt.Errorf("Param: RecoveryTimelock, can't be negative")
+	}
+
+	if p.MaxJailCount < 0 {
+		return fmt.Errorf("Param: MaxJailCount, can't be negative")
+	}
+
+	if p.SlashCountWindow < 0 {
+		return fmt.Errorf("Param: SlashCountWindow, can't be negative")
+	}
+
+	if p.MinUsernameLength < 1 {
+		return fmt.Errorf("Param: MinUsernameLength, must have a positive value")
+	}
+
+	if p.MaxUsernameLength < p.MinUsernameLength {
+		return fmt.Errorf("Param: MaxUsernameLength, can't be lower than MinUsernameLength")
+	}
+
+	if p.UsernameCooldown < 0 {
+		return fmt.Errorf("Param: UsernameCooldown, can't be negative")
+	}
+
+	if p.MaxRegisterBatchSize < 1 {
+		return fmt.Errorf("Param: MaxRegisterBatchSize, must have a positive value")
+	}
+
+	return nil
+}
+
 // ParamKeyTable for auth module
 func ParamKeyTable() params.KeyTable {
 	return params.NewKeyTable().RegisterParamSet(&Params{})
@@ -69,9 +167,16 @@ func (k Keeper) SetParams(ctx sdk.Context, params Params) {
 }
 
 // UpdateParams updates the required params
-func (k Keeper) UpdateParams(ctx sdk.Context, updates Params, updatedFields []string) sdk.Error {
+func (k Keeper) UpdateParams(ctx sdk.Context, updater sdk.AccAddress, updates Params, updatedFields []string) sdk.Error {
+	if !k.isAdmin(ctx, updater) {
+		return ErrAddressNotAuthorised()
+	}
+
 	current := k.GetParams(ctx)
 	updated := k.getUpdatedParams(current, updates, updatedFields)
+	if err := updated.Validate(); err != nil {
+		return ErrInvalidParams(err.Error())
+	}
 	k.SetParams(ctx, updated)
 
 	return nil
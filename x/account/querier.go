@@ -15,6 +15,9 @@ const (
 	QueryPrimaryAccount  = "primary_account"
 	QueryPrimaryAccounts = "primary_accounts"
 	QueryParams          = "params"
+	QueryRecovery        = "recovery"
+	QueryUsername        = "username"
+	QueryUsernameAccount = "username_account"
 )
 
 // QueryAppAccountParams are params for querying app accounts by address queries
@@ -37,6 +40,21 @@ type QueryPrimaryAccountsParams struct {
 	Addresses []sdk.AccAddress `json:"addresses"`
 }
 
+// QueryRecoveryParams are params for querying the pending recovery of an account
+type QueryRecoveryParams struct {
+	Address sdk.AccAddress `json:"address"`
+}
+
+// QueryUsernameParams are params for querying the username of an account by address
+type QueryUsernameParams struct {
+	Address sdk.AccAddress `json:"address"`
+}
+
+// QueryUsernameAccountParams are params for querying an account by username
+type QueryUsernameAccountParams struct {
+	Username string `json:"username"`
+}
+
 // NewQuerier creates a new querier
 func NewQuerier(keeper Keeper) sdk.Querier {
 	return func(ctx sdk.Context, path []string, request abci.RequestQuery) ([]byte, sdk.Error) {
@@ -51,6 +69,12 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryPrimaryAccounts(ctx, request, keeper)
 		case QueryParams:
 			return queryParams(ctx, keeper)
+		case QueryRecovery:
+			return queryRecovery(ctx, request, keeper)
+		case QueryUsername:
+			return queryUsername(ctx, request, keeper)
+		case QueryUsernameAccount:
+			return queryUsernameAccount(ctx, request, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest(fmt.Sprintf("Unknown truchain query endpoint: auth/%s", path[0]))
 		}
@@ -138,6 +162,67 @@ func queryPrimaryAccounts(ctx sdk.Context, request abci.RequestQuery, k Keeper)
 	return result, nil
 }
 
+func queryRecovery(ctx sdk.Context, request abci.RequestQuery, k Keeper) (result []byte, err sdk.Error) {
+	params := QueryRecoveryParams{}
+	if err = unmarshalQueryParams(request, &params); err != nil {
+		return
+	}
+
+	recovery, ok := k.Recovery(ctx, k.PrimaryAddress(ctx, params.Address))
+	if !ok {
+		return nil, ErrRecoveryNotFound(params.Address)
+	}
+
+	result, jsonErr := codec.MarshalJSONIndent(k.codec, recovery)
+	if jsonErr != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", jsonErr.Error()))
+	}
+
+	return result, nil
+}
+
+func queryUsername(ctx sdk.Context, request abci.RequestQuery, k Keeper) (result []byte, err sdk.Error) {
+	params := QueryUsernameParams{}
+	if err = unmarshalQueryParams(request, &params); err != nil {
+		return
+	}
+
+	appAccount, ok := k.getAppAccount(ctx, params.Address)
+	if !ok {
+		return nil, ErrAppAccountNotFound(params.Address)
+	}
+
+	result, jsonErr := codec.MarshalJSONIndent(k.codec, appAccount.Username)
+	if jsonErr != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", jsonErr.Error()))
+	}
+
+	return result, nil
+}
+
+func queryUsernameAccount(ctx sdk.Context, request abci.RequestQuery, k Keeper) (result []byte, err sdk.Error) {
+	params := QueryUsernameAccountParams{}
+	if err = unmarshalQueryParams(request, &params); err != nil {
+		return
+	}
+
+	address, ok := k.UsernameAddress(ctx, params.Username)
+	if !ok {
+		return nil, ErrUsernameNotFound(params.Username)
+	}
+	primaryAccount, err := k.PrimaryAccount(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+
+	result, jsonErr := codec.MarshalJSONIndent(k.codec, primaryAccount)
+	if jsonErr != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", jsonErr.Error()))
+	}
+
+	return result, nil
+}
+
 func queryParams(ctx sdk.Context, keeper Keeper) (result []byte, err sdk.Error) {
 	params := keeper.GetParams(ctx)
 
@@ -138,3 +138,46 @@ func TestQueryParams_Success(t *testing.T) {
 	assert.Nil(t, sdkErr)
 	assert.Equal(t, returnedParams, onChainParams)
 }
+
+func TestQueryUsernameAccount_Success(t *testing.T) {
+	ctx, keeper := mockDB(t)
+
+	_, publicKey, address, coins := getFakeAppAccountParams()
+	_, err := keeper.CreateAppAccount(ctx, address, coins, publicKey)
+	assert.NoError(t, err)
+	_, err = keeper.SetUsername(ctx, address, "alice")
+	assert.NoError(t, err)
+
+	params, jsonErr := ModuleCodec.MarshalJSON(QueryUsernameAccountParams{
+		Username: "Alice",
+	})
+	assert.Nil(t, jsonErr)
+	query := abci.RequestQuery{
+		Path: fmt.Sprintf("/custom/%s/%s", ModuleName, QueryUsernameAccount),
+		Data: params,
+	}
+	result, sdkErr := queryUsernameAccount(ctx, query, keeper)
+	assert.NoError(t, sdkErr)
+
+	var returnedPrimaryAccount PrimaryAccount
+	jsonErr = keeper.codec.UnmarshalJSON(result, &returnedPrimaryAccount)
+	assert.Nil(t, jsonErr)
+	assert.Equal(t, address, returnedPrimaryAccount.GetAddress())
+	assert.Equal(t, "alice", returnedPrimaryAccount.Username)
+
+	params, jsonErr = ModuleCodec.MarshalJSON(QueryUsernameParams{
+		Address: address,
+	})
+	assert.Nil(t, jsonErr)
+	query = abci.RequestQuery{
+		Path: fmt.Sprintf("/custom/%s/%s", ModuleName, QueryUsername),
+		Data: params,
+	}
+	result, sdkErr = queryUsername(ctx, query, keeper)
+	assert.NoError(t, sdkErr)
+
+	var username string
+	jsonErr = keeper.codec.UnmarshalJSON(result, &username)
+	assert.Nil(t, jsonErr)
+	assert.Equal(t, "alice", username)
+}
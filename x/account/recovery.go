@@ -0,0 +1,223 @@
+package account
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/tendermint/tendermint/crypto"
+)
+
+// Recovery is a pending request to move an AppAccount to a new key.
+// It's executed once ExecuteTime is reached, unless the old key cancels it before.
+type Recovery struct {
+	Address     sdk.AccAddress `json:"address"`
+	NewAddress  sdk.AccAddress `json:"new_address"`
+	NewPubKey   crypto.PubKey  `json:"new_public_key"`
+	Requester   sdk.AccAddress `json:"requester"`
+	CreatedTime time.Time      `json:"created_time"`
+	ExecuteTime time.Time      `json:"execute_time"`
+}
+
+// String implements fmt.Stringer
+func (r Recovery) String() string {
+	return fmt.Sprintf(`Recovery:
+  Address:     %s
+  NewAddress:  %s
+  ExecuteTime: %s`,
+		r.Address, r.NewAddress, r.ExecuteTime.String())
+}
+
+// RequestRecovery starts the recovery of an AppAccount to a new public key. Only the registrar
+// can request a recovery, and it only happens after the recovery timelock.
+func (k Keeper) RequestRecovery(ctx sdk.Context, address sdk.AccAddress,
+	newPubKey crypto.PubKey, requester sdk.AccAddress) (Recovery, sdk.Error) {
+
+	if !k.GetParams(ctx).Registrar.Equals(requester) {
+		return Recovery{}, ErrNotRegistrar(requester)
+	}
+	user, ok := k.getAppAccount(ctx, address)
+	if !ok {
+		return Recovery{}, ErrAppAccountNotFound(address)
+	}
+	primary := user.PrimaryAddress()
+	if _, ok := k.Recovery(ctx, primary); ok {
+		return Recovery{}, ErrRecoveryExists(primary)
+	}
+	newAddress := sdk.AccAddress(newPubKey.Address())
+	if _, ok := k.getAppAccount(ctx, newAddress); ok {
+		return Recovery{}, ErrAccountKeyExists(newAddress)
+	}
+
+	recovery := Recovery{
+		Address:     primary,
+		NewAddress:  newAddress,
+		NewPubKey:   newPubKey,
+		Requester:   requester,
+		CreatedTime: ctx.BlockHeader().Time,
+		ExecuteTime: ctx.BlockHeader().Time.Add(k.GetParams(ctx).RecoveryTimelock),
+	}
+	k.setRecovery(ctx, recovery)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeRecoveryRequested,
+			sdk.NewAttribute(AttributeKeyUser, primary.String()),
+			sdk.NewAttribute(AttributeKeyNewAddress, newAddress.String()),
+			sdk.NewAttribute(AttributeKeyExecuteTime, recovery.ExecuteTime.String()),
+		),
+	)
+	k.Logger(ctx).Info(fmt.Sprintf("Requested %s", recovery.String()))
+
+	return recovery, nil
+}
+
+// CancelRecovery cancels the pending recovery of an AppAccount.
+// address can be any key of the AppAccount still held by the user.
+func (k Keeper) CancelRecovery(ctx sdk.Context, address sdk.AccAddress) (Recovery, sdk.Error) {
+	user, ok := k.getAppAccount(ctx, address)
+	if !ok {
+		return Recovery{}, ErrAppAccountNotFound(address)
+	}
+	recovery, ok := k.Recovery(ctx, user.PrimaryAddress())
+	if !ok {
+		return Recovery{}, ErrRecoveryNotFound(user.PrimaryAddress())
+	}
+	k.deleteRecovery(ctx, recovery)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeRecoveryCancelled,
+			sdk.NewAttribute(AttributeKeyUser, recovery.Address.String()),
+			sdk.NewAttribute(AttributeKeyAddress, address.String()),
+		),
+	)
+	k.Logger(ctx).Info(fmt.Sprintf("Cancelled %s", recovery.String()))
+
+	return recovery, nil
+}
+
+// Recovery returns the pending recovery of an AppAccount by its primary address
+func (k Keeper) Recovery(ctx sdk.Context, address sdk.AccAddress) (recovery Recovery, ok bool) {
+	bz := k.store(ctx).Get(recoveryKey(address))
+	if bz == nil {
+		return
+	}
+	k.codec.MustUnmarshalBinaryLengthPrefixed(bz, &recovery)
+
+	return recovery, true
+}
+
+// Recoveries returns all pending recoveries
+func (k Keeper) Recoveries(ctx sdk.Context) []Recovery {
+	recoveries := make([]Recovery, 0)
+	iterator := sdk.KVStorePrefixIterator(k.store(ctx), RecoveryKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var recovery Recovery
+		k.codec.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &recovery)
+		recoveries = append(recoveries, recovery)
+	}
+
+	return recoveries
+}
+
+// RecoveriesBefore returns all pending recoveries to execute before executeTime
+func (k Keeper) RecoveriesBefore(ctx sdk.Context, executeTime time.Time) []Recovery {
+	recoveries := make([]Recovery, 0)
+	iterator := k.store(ctx).Iterator(RecoveryQueuePrefix, sdk.PrefixEndBytes(recoveryQueueTimeKey(executeTime)))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		recovery, ok := k.Recovery(ctx, iterator.Value())
+		if ok {
+			recoveries = append(recoveries, recovery)
+		}
+	}
+
+	return recoveries
+}
+
+// recoverAccount moves an AppAccount and its coins to the new address of a recovery.
+// The old address is dropped from the AppAccount, linked keys are kept.
+func (k Keeper) recoverAccount(ctx sdk.Context, recovery Recovery) sdk.Error {
+	user, ok := k.getAppAccount(ctx, recovery.Address)
+	if !ok {
+		return ErrAppAccountNotFound(recovery.Address)
+	}
+	oldAddress, newAddress := user.PrimaryAddress(), recovery.NewAddress
+	// the new key could have been registered while the recovery was pending
+	if _, ok := k.getAppAccount(ctx, newAddress); ok {
+		return ErrAccountKeyExists(newAddress)
+	}
+
+	if k.accountKeeper.GetAccount(ctx, newAddress) == nil {
+		baseAccount := auth.NewBaseAccountWithAddress(newAddress)
+		err := baseAccount.SetPubKey(recovery.NewPubKey)
+		if err != nil {
+			return ErrAppAccountCreateFailed(newAddress)
+		}
+		k.accountKeeper.SetAccount(ctx, &baseAccount)
+	}
+
+	var coins sdk.Coins
+	if oldAccount := k.accountKeeper.GetAccount(ctx, oldAddress); oldAccount != nil {
+		coins = oldAccount.GetCoins()
+	}
+	for _, coin := range coins {
+		_, err := k.bankKeeper.SubtractCoin(ctx, oldAddress, coin, 0, TransactionRecoveryTransferOut)
+		if err != nil {
+			return err
+		}
+		_, err = k.bankKeeper.AddCoin(ctx, newAddress, coin, 0, TransactionRecoveryTransferIn)
+		if err != nil {
+			return err
+		}
+	}
+
+	k.store(ctx).Delete(key(oldAddress))
+	if user.IsJailed && !user.IsJailedPermanently() {
+		k.deleteJailEndTimeAccount(ctx, user.JailEndTime, oldAddress)
+		k.setJailEndTimeAccount(ctx, user.JailEndTime, newAddress)
+	}
+	if user.Username != "" {
+		k.setUsernameAddress(ctx, user.Username, newAddress)
+	}
+	for _, slashTime := range user.SlashTimes {
+		k.deleteSlashTimeAccount(ctx, slashTime, oldAddress)
+		k.setSlashTimeAccount(ctx, slashTime, newAddress)
+	}
+	user.Addresses[0] = newAddress
+	k.setAppAccount(ctx, user)
+	for _, linked := range user.Addresses[1:] {
+		k.setLinkedAddress(ctx, linked, newAddress)
+	}
+
+	err := k.afterAccountRecovered(ctx, oldAddress, newAddress)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeAccountRecovered,
+			sdk.NewAttribute(AttributeKeyUser, oldAddress.String()),
+			sdk.NewAttribute(AttributeKeyNewAddress, newAddress.String()),
+		),
+	)
+	k.Logger(ctx).Info(fmt.Sprintf("Recovered %s to %s", oldAddress, newAddress))
+
+	return nil
+}
+
+func (k Keeper) setRecovery(ctx sdk.Context, recovery Recovery) {
+	store := k.store(ctx)
+	store.Set(recoveryKey(recovery.Address), k.codec.MustMarshalBinaryLengthPrefixed(recovery))
+	store.Set(recoveryQueueKey(recovery.ExecuteTime, recovery.Address), recovery.Address)
+}
+
+func (k Keeper) deleteRecovery(ctx sdk.Context, recovery Recovery) {
+	store := k.store(ctx)
+	store.Delete(recoveryKey(recovery.Address))
+	store.Delete(recoveryQueueKey(recovery.ExecuteTime, recovery.Address))
+}
@@ -17,11 +17,25 @@ const (
 
 	EventTypeUnjailedAccount = "unjailed_account"
 	AttributeKeyUser         = "user"
+
+	EventTypeAccountKeyAdded   = "account_key_added"
+	EventTypeAccountKeyRemoved = "account_key_removed"
+	AttributeKeyAddress        = "address"
+
+	EventTypeRecoveryRequested = "account_recovery_requested"
+	EventTypeRecoveryCancelled = "account_recovery_cancelled"
+	EventTypeAccountRecovered  = "account_recovered"
+	AttributeKeyNewAddress     = "new_address"
+	AttributeKeyExecuteTime    = "execute_time"
+
+	EventTypeUsernameSet = "username_set"
+	AttributeKeyUsername = "username"
 )
 
 type PrimaryAccount struct {
 	auth.BaseAccount
 
+	Username    string    `json:"username"`
 	SlashCount  int       `json:"slash_count"`
 	IsJailed    bool      `json:"is_jailed"`
 	JailEndTime time.Time `json:"jail_end_time"`
@@ -32,9 +46,21 @@ type PrimaryAccount struct {
 type AppAccount struct {
 	Addresses   []sdk.AccAddress `json:"addresses"`
 	SlashCount  int              `json:"slash_count"`
+	SlashTimes  []time.Time      `json:"slash_times"`
 	IsJailed    bool             `json:"is_jailed"`
 	JailEndTime time.Time        `json:"jail_end_time"`
+	JailHistory []Jail           `json:"jail_history"`
 	CreatedTime time.Time        `json:"created_time"`
+
+	Username            string    `json:"username"`
+	UsernameUpdatedTime time.Time `json:"username_updated_time"`
+}
+
+// Jail is a past or current jailing of an AppAccount.
+// EndTime is zero for a permanent jailing.
+type Jail struct {
+	StartTime time.Time `json:"start_time"`
+	EndTime   time.Time `json:"end_time"`
 }
 
 func NewAppAccount(address sdk.AccAddress, createdTime time.Time) AppAccount {
@@ -51,15 +77,33 @@ func (acc AppAccount) PrimaryAddress() sdk.AccAddress {
 	return acc.Addresses[0]
 }
 
+// IsJailedPermanently tells whether the account is jailed without an end time
+func (acc AppAccount) IsJailedPermanently() bool {
+	return acc.IsJailed && acc.JailEndTime.IsZero()
+}
+
+// HasAddress tells whether an address is the primary or a linked address of the account
+func (acc AppAccount) HasAddress(address sdk.AccAddress) bool {
+	for _, addr := range acc.Addresses {
+		if addr.Equals(address) {
+			return true
+		}
+	}
+	return false
+}
+
 // String implements fmt.Stringer
 func (acc AppAccount) String() string {
 	return fmt.Sprintf(`
   Address:           %s
+  Username:          %s
   SlashCount:        %d
   IsJailed:          %t
   JailEndTime:       %s
+  JailCount:         %d
   CreatedTime:       %s`,
-		acc.PrimaryAddress().String(), acc.SlashCount, acc.IsJailed, acc.JailEndTime.String(), acc.CreatedTime.String())
+		acc.PrimaryAddress().String(), acc.Username, acc.SlashCount, acc.IsJailed, acc.JailEndTime.String(),
+		len(acc.JailHistory), acc.CreatedTime.String())
 }
 
 // AppAccounts is a slice of AppAccounts
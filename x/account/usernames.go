@@ -0,0 +1,162 @@
+package account
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ReservedUsernames can only be given to an AppAccount by the registrar
+var ReservedUsernames = []string{
+	"admin",
+	"administrator",
+	"moderator",
+	"registrar",
+	"root",
+	"support",
+	"system",
+	"truchain",
+	"trustory",
+}
+
+// usernames start with a letter, followed by letters, digits or underscores
+var usernameFormat = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_]*$`)
+
+// SetUsername sets the username of an AppAccount. Usernames are unique regardless of case,
+// and can only be changed again after the username cooldown.
+func (k Keeper) SetUsername(ctx sdk.Context, address sdk.AccAddress, username string) (AppAccount, sdk.Error) {
+	user, ok := k.getAppAccount(ctx, address)
+	if !ok {
+		return user, ErrAppAccountNotFound(address)
+	}
+	err := k.validateUsername(ctx, username)
+	if err != nil {
+		return user, err
+	}
+	if isReservedUsername(username) {
+		return user, ErrInvalidUsername(fmt.Sprintf("%s is reserved", username))
+	}
+	if k.isTakenDownUsername(ctx, username) {
+		return user, ErrInvalidUsername(fmt.Sprintf("%s was taken down", username))
+	}
+	if user.Username != "" {
+		cooldownEnd := user.UsernameUpdatedTime.Add(k.GetParams(ctx).UsernameCooldown)
+		if ctx.BlockHeader().Time.Before(cooldownEnd) {
+			return user, ErrUsernameCooldown(cooldownEnd)
+		}
+	}
+	if owner, ok := k.UsernameAddress(ctx, username); ok && !owner.Equals(user.PrimaryAddress()) {
+		return user, ErrUsernameTaken(username)
+	}
+
+	return k.setUsername(ctx, user, username), nil
+}
+
+// OverrideUsername lets the registrar change the username of an AppAccount, e.g. to take down an impersonation.
+// An empty username removes the username of the AppAccount. The replaced username is taken down,
+// so it can't be claimed again until the registrar gives it to an AppAccount.
+func (k Keeper) OverrideUsername(ctx sdk.Context, registrar, address sdk.AccAddress, username string) (AppAccount, sdk.Error) {
+	if !k.GetParams(ctx).Registrar.Equals(registrar) {
+		return AppAccount{}, ErrNotRegistrar(registrar)
+	}
+	user, ok := k.getAppAccount(ctx, address)
+	if !ok {
+		return user, ErrAppAccountNotFound(address)
+	}
+	if username != "" {
+		err := k.validateUsername(ctx, username)
+		if err != nil {
+			return user, err
+		}
+		if owner, ok := k.UsernameAddress(ctx, username); ok && !owner.Equals(user.PrimaryAddress()) {
+			return user, ErrUsernameTaken(username)
+		}
+		k.store(ctx).Delete(takenDownUsernameKey(username))
+	}
+	if user.Username != "" && !strings.EqualFold(user.Username, username) {
+		k.setTakenDownUsername(ctx, user.Username)
+	}
+
+	return k.setUsername(ctx, user, username), nil
+}
+
+// TakenDownUsernames returns the usernames taken down by the registrar
+func (k Keeper) TakenDownUsernames(ctx sdk.Context) []string {
+	usernames := make([]string, 0)
+	iterator := sdk.KVStorePrefixIterator(k.store(ctx), TakenDownUsernamePrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		usernames = append(usernames, string(iterator.Key()[len(TakenDownUsernamePrefix):]))
+	}
+
+	return usernames
+}
+
+// UsernameAddress returns the primary address of the AppAccount owning a username
+func (k Keeper) UsernameAddress(ctx sdk.Context, username string) (sdk.AccAddress, bool) {
+	bz := k.store(ctx).Get(usernameKey(username))
+	if bz == nil {
+		return nil, false
+	}
+
+	return bz, true
+}
+
+func (k Keeper) validateUsername(ctx sdk.Context, username string) sdk.Error {
+	params := k.GetParams(ctx)
+	if len(username) < params.MinUsernameLength || len(username) > params.MaxUsernameLength {
+		return ErrInvalidUsername(fmt.Sprintf("Username must be between %d and %d chars.",
+			params.MinUsernameLength, params.MaxUsernameLength))
+	}
+	if !usernameFormat.MatchString(username) {
+		return ErrInvalidUsername("Username must start with a letter and only have letters, digits and underscores.")
+	}
+
+	return nil
+}
+
+func (k Keeper) setUsername(ctx sdk.Context, user AppAccount, username string) AppAccount {
+	if user.Username != "" {
+		k.store(ctx).Delete(usernameKey(user.Username))
+	}
+	if username != "" {
+		k.setUsernameAddress(ctx, username, user.PrimaryAddress())
+	}
+	user.Username = username
+	user.UsernameUpdatedTime = ctx.BlockHeader().Time
+	k.setAppAccount(ctx, user)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeUsernameSet,
+			sdk.NewAttribute(AttributeKeyUser, user.PrimaryAddress().String()),
+			sdk.NewAttribute(AttributeKeyUsername, username),
+		),
+	)
+	k.Logger(ctx).Info(fmt.Sprintf("Set username of %s to %s", user.PrimaryAddress(), username))
+
+	return user
+}
+
+func (k Keeper) setUsernameAddress(ctx sdk.Context, username string, primary sdk.AccAddress) {
+	k.store(ctx).Set(usernameKey(username), primary)
+}
+
+func (k Keeper) setTakenDownUsername(ctx sdk.Context, username string) {
+	k.store(ctx).Set(takenDownUsernameKey(username), []byte{})
+}
+
+func (k Keeper) isTakenDownUsername(ctx sdk.Context, username string) bool {
+	return k.store(ctx).Has(takenDownUsernameKey(username))
+}
+
+func isReservedUsername(username string) bool {
+	for _, reserved := range ReservedUsernames {
+		if strings.EqualFold(reserved, username) {
+			return true
+		}
+	}
+	return false
+}
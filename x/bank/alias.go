@@ -28,11 +28,21 @@ const (
 	TransactionStakeCreatorSlashed             = exported.TransactionStakeCreatorSlashed
 	TransactionStakeCuratorSlashed             = exported.TransactionStakeCuratorSlashed
 
-	TransactionCuratorReward = exported.TransactionCuratorReward
+	TransactionCuratorReward   = exported.TransactionCuratorReward
+	TransactionTreasuryDeposit = exported.TransactionTreasuryDeposit
+	TransactionTreasurySpend   = exported.TransactionTreasurySpend
+
+	TransactionRecoveryTransferOut = exported.TransactionRecoveryTransferOut
+	TransactionRecoveryTransferIn  = exported.TransactionRecoveryTransferIn
+
+	TransactionJailAppealStake          = exported.TransactionJailAppealStake
+	TransactionJailAppealStakeReturned  = exported.TransactionJailAppealStakeReturned
+	TransactionJailAppealStakeForfeited = exported.TransactionJailAppealStakeForfeited
 
 	SortAsc                    = exported.SortAsc
 	SortDesc                   = exported.SortDesc
 	QueryTransactionsByAddress = exported.QueryTransactionsByAddress
+	QueryTreasuryTransactions  = exported.QueryTreasuryTransactions
 	QueryParams                = exported.QueryParams
 	RouterKey                  = exported.RouterKey
 )
@@ -45,6 +55,8 @@ var (
 	Offset                  = exported.Offset
 	FromModuleAccount       = exported.FromModuleAccount
 	ToModuleAccount         = exported.ToModuleAccount
+	WithCommunityID         = exported.WithCommunityID
+	TreasuryTransactions    = exported.TreasuryTransactions
 	ModuleCodec             = types.ModuleCodec
 )
 
@@ -55,4 +67,5 @@ type (
 	SortOrderType                    = exported.SortOrderType
 	Transaction                      = exported.Transaction
 	QueryTransactionsByAddressParams = exported.QueryTransactionsByAddressParams
+	QueryTreasuryTransactionsParams  = exported.QueryTreasuryTransactionsParams
 )
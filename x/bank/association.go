@@ -13,6 +13,24 @@ func (k Keeper) setUserTransaction(ctx sdk.Context, creator sdk.AccAddress, crea
 	k.store(ctx).Set(userTransactionKey(creator, creationTime, transactionID), bz)
 }
 
+// setTreasuryTransaction sets a community treasury <-> transaction association in the store
+func (k Keeper) setTreasuryTransaction(ctx sdk.Context, communityID string, creationTime time.Time, transactionID uint64) {
+	bz := k.codec.MustMarshalBinaryBare(transactionID)
+	k.store(ctx).Set(treasuryTransactionKey(communityID, creationTime, transactionID), bz)
+}
+
+// setTransactionAssociations indexes a transaction by the accounts it moved coins for.
+// Transfers between module accounts don't touch the balance of the user and are only
+// indexed by treasury.
+func (k Keeper) setTransactionAssociations(ctx sdk.Context, tx Transaction) {
+	if !tx.Type.AllowedForModuleTransfer() {
+		k.setUserTransaction(ctx, tx.AppAccountAddress, tx.CreatedTime, tx.ID)
+	}
+	if tx.Type.OneOf(TreasuryTransactions) {
+		k.setTreasuryTransaction(ctx, tx.CommunityID, tx.CreatedTime, tx.ID)
+	}
+}
+
 func (k Keeper) IterateUserTransactions(ctx sdk.Context, creator sdk.AccAddress, reverse bool, cb func(transaction Transaction) (stop bool)) {
 	var iterator sdk.Iterator
 	prefix := userTransactionsPrefix(creator)
@@ -37,3 +55,29 @@ func (k Keeper) IterateUserTransactions(ctx sdk.Context, creator sdk.AccAddress,
 		}
 	}
 }
+
+// IterateTreasuryTransactions iterates the transactions of a community treasury
+func (k Keeper) IterateTreasuryTransactions(ctx sdk.Context, communityID string, reverse bool, cb func(transaction Transaction) (stop bool)) {
+	var iterator sdk.Iterator
+	prefix := treasuryTransactionsPrefix(communityID)
+	if !reverse {
+		iterator = sdk.KVStorePrefixIterator(k.store(ctx), prefix)
+	}
+
+	if reverse {
+		iterator = sdk.KVStoreReversePrefixIterator(k.store(ctx), prefix)
+	}
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var transactionID uint64
+		k.codec.MustUnmarshalBinaryBare(iterator.Value(), &transactionID)
+		transaction, ok := k.getTransaction(ctx, transactionID)
+		if !ok {
+			panic(fmt.Sprintf("unable to retrieve transaction with id %d", transactionID))
+		}
+		if cb(transaction) {
+			break
+		}
+	}
+}
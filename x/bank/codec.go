@@ -6,6 +6,8 @@ import "github.com/cosmos/cosmos-sdk/codec"
 func RegisterCodec(c *codec.Codec) {
 	c.RegisterConcrete(MsgSendGift{}, "truchain/MsgSendGift", nil)
 	c.RegisterConcrete(MsgUpdateParams{}, "bank/MsgUpdateParams", nil)
+	c.RegisterConcrete(MsgAddAdmin{}, "bank/MsgAddAdmin", nil)
+	c.RegisterConcrete(MsgRemoveAdmin{}, "bank/MsgRemoveAdmin", nil)
 
 	c.RegisterConcrete(Transaction{}, "truchain/Transaction", nil)
 }
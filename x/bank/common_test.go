@@ -3,6 +3,7 @@ package bank
 import (
 	app "github.com/TruStory/truchain/types"
 	"github.com/TruStory/truchain/x/account"
+	"github.com/TruStory/truchain/x/distribution"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -52,7 +53,8 @@ func mockDB() (sdk.Context, Keeper, auth.AccountKeeper) {
 	)
 
 	maccPerms := map[string][]string{
-		account.UserGrowthPoolName: {supply.Burner, supply.Staking},
+		account.UserGrowthPoolName:      {supply.Burner, supply.Staking},
+		distribution.UserRewardPoolName: nil,
 	}
 	supplyKeeper := supply.NewKeeper(cdc, supplyKey, accKeeper, bankKeeper, maccPerms)
 	userGrowthAcc := supply.NewEmptyModuleAccount(account.UserGrowthPoolName, supply.Burner, supply.Staking)
@@ -14,6 +14,8 @@ const (
 	ErrorCodeInvalidRewardBrokerAddress sdk.CodeType = 402
 	ErrorCodeInvalidQueryParams         sdk.CodeType = 403
 	ErrorCodeUnknownTransaction         sdk.CodeType = 404
+	ErrorCodeAddressNotAuthorised       sdk.CodeType = 405
+	ErrorCodeInvalidParams              sdk.CodeType = 406
 )
 
 // ErrInvalidRewardBrokerAddress throws an error when the address doesn't match with genesis param address.
@@ -47,3 +49,19 @@ func ErrCodeUnknownTransaction(transactionID uint64) sdk.Error {
 		fmt.Sprintf("Unknown transaction id %d", transactionID),
 	)
 }
+
+// ErrAddressNotAuthorised throws an error when the address is not admin
+func ErrAddressNotAuthorised() sdk.Error {
+	return sdk.NewError(DefaultCodespace,
+		ErrorCodeAddressNotAuthorised,
+		"This address is not authorised to perform this action.",
+	)
+}
+
+// ErrInvalidParams throws an error when updated params have unusable values
+func ErrInvalidParams(message string) sdk.Error {
+	return sdk.NewError(DefaultCodespace,
+		ErrorCodeInvalidParams,
+		fmt.Sprintf("Invalid params: %s", message),
+	)
+}
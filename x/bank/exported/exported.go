@@ -43,6 +43,17 @@ const (
 	TransactionStakeCreatorSlashed
 	TransactionStakeCuratorSlashed
 	TransactionCuratorReward
+	TransactionVerdictBonus
+	TransactionClaimCreation
+	TransactionClaimCreationReturned
+	TransactionInterestClaimCreation
+	TransactionTreasuryDeposit
+	TransactionTreasurySpend
+	TransactionRecoveryTransferOut
+	TransactionRecoveryTransferIn
+	TransactionJailAppealStake
+	TransactionJailAppealStakeReturned
+	TransactionJailAppealStakeForfeited
 )
 
 var TransactionTypeName = []string{
@@ -62,6 +73,18 @@ var TransactionTypeName = []string{
 	TransactionInterestUpvoteGivenSlashed:      "TransactionInterestUpvoteGivenSlashed",
 	TransactionStakeCreatorSlashed:             "TransactionStakeCreatorSlashed",
 	TransactionStakeCuratorSlashed:             "TransactionStakeCuratorSlashed",
+	TransactionCuratorReward:                   "TransactionCuratorReward",
+	TransactionVerdictBonus:                    "TransactionVerdictBonus",
+	TransactionClaimCreation:                   "TransactionClaimCreation",
+	TransactionClaimCreationReturned:           "TransactionClaimCreationReturned",
+	TransactionInterestClaimCreation:           "TransactionInterestClaimCreation",
+	TransactionTreasuryDeposit:                 "TransactionTreasuryDeposit",
+	TransactionTreasurySpend:                   "TransactionTreasurySpend",
+	TransactionRecoveryTransferOut:             "TransactionRecoveryTransferOut",
+	TransactionRecoveryTransferIn:              "TransactionRecoveryTransferIn",
+	TransactionJailAppealStake:                 "TransactionJailAppealStake",
+	TransactionJailAppealStakeReturned:         "TransactionJailAppealStakeReturned",
+	TransactionJailAppealStakeForfeited:        "TransactionJailAppealStakeForfeited",
 }
 
 func (t TransactionType) String() string {
@@ -81,12 +104,20 @@ var AllowedTransactionsForAddition = []TransactionType{
 	TransactionInterestUpvoteGiven,
 	TransactionRewardPayout,
 	TransactionCuratorReward,
+	TransactionVerdictBonus,
+	TransactionClaimCreationReturned,
+	TransactionInterestClaimCreation,
+	TransactionTreasurySpend,
+	TransactionRecoveryTransferIn,
+	TransactionJailAppealStakeReturned,
 }
 
 var AllowedTransactionsForEarning = []TransactionType{
 	TransactionInterestArgumentCreation,
 	TransactionInterestUpvoteReceived,
 	TransactionInterestUpvoteGiven,
+	TransactionVerdictBonus,
+	TransactionInterestClaimCreation,
 }
 
 var AllowedTransactionsForEarningDeduction = []TransactionType{
@@ -104,6 +135,21 @@ var AllowedTransactionsForDeduction = []TransactionType{
 	TransactionInterestUpvoteGivenSlashed,
 	TransactionStakeCreatorSlashed,
 	TransactionStakeCuratorSlashed,
+	TransactionClaimCreation,
+	TransactionRecoveryTransferOut,
+	TransactionJailAppealStake,
+}
+
+// AllowedTransactionsForModuleTransfer are only moving coins between module accounts
+var AllowedTransactionsForModuleTransfer = []TransactionType{
+	TransactionTreasuryDeposit,
+	TransactionJailAppealStakeForfeited,
+}
+
+// TreasuryTransactions are the transactions moving coins in or out of a community treasury
+var TreasuryTransactions = []TransactionType{
+	TransactionTreasuryDeposit,
+	TransactionTreasurySpend,
 }
 
 func (t TransactionType) AllowedForAddition() bool {
@@ -114,6 +160,10 @@ func (t TransactionType) AllowedForDeduction() bool {
 	return t.OneOf(AllowedTransactionsForDeduction)
 }
 
+func (t TransactionType) AllowedForModuleTransfer() bool {
+	return t.OneOf(AllowedTransactionsForModuleTransfer)
+}
+
 func (t TransactionType) OneOf(types []TransactionType) bool {
 	for _, tType := range types {
 		if tType == t {
@@ -212,6 +262,7 @@ func GetFilters(filterSetters ...Filter) Filters {
 // Defines bank module constants
 const (
 	QueryTransactionsByAddress = "transactions_by_address"
+	QueryTreasuryTransactions  = "treasury_transactions"
 	QueryParams                = "params"
 	ModuleName                 = types.ModuleName
 	StoreKey                   = ModuleName
@@ -228,3 +279,11 @@ type QueryTransactionsByAddressParams struct {
 	Limit     int               `json:"limit,omitempty"`
 	Offset    int               `json:"offset,omitempty"`
 }
+
+// QueryTreasuryTransactionsParams query transactions params for a community treasury.
+type QueryTreasuryTransactionsParams struct {
+	CommunityID string        `json:"community_id"`
+	SortOrder   SortOrderType `json:"sort_order,omitempty"`
+	Limit       int           `json:"limit,omitempty"`
+	Offset      int           `json:"offset,omitempty"`
+}
@@ -1,8 +1,6 @@
 package bank
 
 import (
-	"fmt"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -33,7 +31,7 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
 	keeper.SetParams(ctx, data.Params)
 	for _, tx := range data.Transactions {
 		keeper.setTransaction(ctx, tx)
-		keeper.setUserTransaction(ctx, tx.AppAccountAddress, tx.CreatedTime, tx.ID)
+		keeper.setTransactionAssociations(ctx, tx)
 	}
 	keeper.setTransactionID(ctx, uint64(len(data.Transactions)+1))
 }
@@ -48,8 +46,5 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
 
 // ValidateGenesis validates the genesis state data
 func ValidateGenesis(data GenesisState) error {
-	if data.Params.RewardBrokerAddress.Empty() {
-		return fmt.Errorf("param: RewardBrokerAddress, a valid address must be provided")
-	}
-	return nil
+	return data.Params.Validate()
 }
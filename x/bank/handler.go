@@ -13,6 +13,10 @@ func NewHandler(keeper Keeper) sdk.Handler {
 		switch msg := msg.(type) {
 		case MsgSendGift:
 			return handleMsgSendGift(ctx, keeper, msg)
+		case MsgAddAdmin:
+			return handleMsgAddAdmin(ctx, keeper, msg)
+		case MsgRemoveAdmin:
+			return handleMsgRemoveAdmin(ctx, keeper, msg)
 		case MsgUpdateParams:
 			return handleMsgUpdateParams(ctx, keeper, msg)
 		default:
@@ -35,12 +39,52 @@ func handleMsgSendGift(ctx sdk.Context, keeper Keeper, msg MsgSendGift) sdk.Resu
 	return sdk.Result{}
 }
 
+func handleMsgAddAdmin(ctx sdk.Context, k Keeper, msg MsgAddAdmin) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	err := k.AddAdmin(ctx, msg.Admin, msg.Creator)
+	if err != nil {
+		return err.Result()
+	}
+
+	res, jsonErr := json.Marshal(true)
+	if jsonErr != nil {
+		return sdk.ErrInternal(fmt.Sprintf("Marshal result error: %s", jsonErr)).Result()
+	}
+
+	return sdk.Result{
+		Data: res,
+	}
+}
+
+func handleMsgRemoveAdmin(ctx sdk.Context, k Keeper, msg MsgRemoveAdmin) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	err := k.RemoveAdmin(ctx, msg.Admin, msg.Remover)
+	if err != nil {
+		return err.Result()
+	}
+
+	res, jsonErr := json.Marshal(true)
+	if jsonErr != nil {
+		return sdk.ErrInternal(fmt.Sprintf("Marshal result error: %s", jsonErr)).Result()
+	}
+
+	return sdk.Result{
+		Data: res,
+	}
+}
+
 func handleMsgUpdateParams(ctx sdk.Context, k Keeper, msg MsgUpdateParams) sdk.Result {
 	if err := msg.ValidateBasic(); err != nil {
 		return err.Result()
 	}
 
-	err := k.UpdateParams(ctx, msg.Updates, msg.UpdatedFields)
+	err := k.UpdateParams(ctx, msg.Updater, msg.Updates, msg.UpdatedFields)
 	if err != nil {
 		return err.Result()
 	}
@@ -74,7 +74,7 @@ func (k Keeper) AddCoin(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coin,
 
 	k.setTransaction(ctx, tx)
 	k.setTransactionID(ctx, transactionID+1)
-	k.setUserTransaction(ctx, addr, tx.CreatedTime, tx.ID)
+	k.setTransactionAssociations(ctx, tx)
 	return coins, nil
 }
 
@@ -114,7 +114,7 @@ func (k Keeper) SubtractCoin(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coin,
 
 	k.setTransaction(ctx, tx)
 	k.setTransactionID(ctx, transactionID+1)
-	k.setUserTransaction(ctx, addr, tx.CreatedTime, tx.ID)
+	k.setTransactionAssociations(ctx, tx)
 	return coins, nil
 }
 
@@ -144,6 +144,43 @@ func (k Keeper) SafeSubtractCoin(ctx sdk.Context, addr sdk.AccAddress, amt sdk.C
 	}
 }
 
+// SendCoinBetweenModules moves a coin between two module accounts and adds the transaction
+// to the association list. addr is the account whose coins originally funded the transfer.
+func (k Keeper) SendCoinBetweenModules(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coin,
+	referenceID uint64, txType TransactionType, txSetters ...TransactionSetter) sdk.Error {
+	tx := Transaction{}
+	for _, setter := range txSetters {
+		setter(&tx)
+	}
+	if !txType.AllowedForModuleTransfer() {
+		return ErrInvalidTransactionType(txType)
+	}
+	if tx.FromModuleAccount == "" || tx.ToModuleAccount == "" {
+		return sdk.ErrInternal("module transfers require a sender and a recipient module account")
+	}
+	err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, tx.FromModuleAccount, tx.ToModuleAccount, sdk.Coins{amt})
+	if err != nil {
+		return err
+	}
+
+	transactionID, err := k.transactionID(ctx)
+	if err != nil {
+		return err
+	}
+
+	tx.ID = transactionID
+	tx.Type = txType
+	tx.ReferenceID = referenceID
+	tx.Amount = amt
+	tx.AppAccountAddress = addr
+	tx.CreatedTime = ctx.BlockHeader().Time
+
+	k.setTransaction(ctx, tx)
+	k.setTransactionID(ctx, transactionID+1)
+	k.setTransactionAssociations(ctx, tx)
+	return nil
+}
+
 func (k Keeper) GetCoins(ctx sdk.Context, address sdk.AccAddress) sdk.Coins {
 	return k.bankKeeper.GetCoins(ctx, address)
 }
@@ -172,6 +209,55 @@ func (k Keeper) sendGift(ctx sdk.Context,
 	return nil
 }
 
+// AddAdmin adds a new admin. The first admins are set in the genesis params.
+func (k Keeper) AddAdmin(ctx sdk.Context, admin, creator sdk.AccAddress) sdk.Error {
+	if !k.isAdmin(ctx, creator) {
+		return ErrAddressNotAuthorised()
+	}
+	params := k.GetParams(ctx)
+
+	// if already present, don't add again
+	for _, currentAdmin := range params.BankAdmins {
+		if currentAdmin.Equals(admin) {
+			return nil
+		}
+	}
+
+	params.BankAdmins = append(params.BankAdmins, admin)
+
+	k.SetParams(ctx, params)
+
+	return nil
+}
+
+// RemoveAdmin removes an admin
+func (k Keeper) RemoveAdmin(ctx sdk.Context, admin, remover sdk.AccAddress) sdk.Error {
+	if !k.isAdmin(ctx, remover) {
+		return ErrAddressNotAuthorised()
+	}
+
+	params := k.GetParams(ctx)
+	for i, currentAdmin := range params.BankAdmins {
+		if currentAdmin.Equals(admin) {
+			params.BankAdmins = append(params.BankAdmins[:i], params.BankAdmins[i+1:]...)
+			break
+		}
+	}
+
+	k.SetParams(ctx, params)
+
+	return nil
+}
+
+func (k Keeper) isAdmin(ctx sdk.Context, address sdk.AccAddress) bool {
+	for _, admin := range k.GetParams(ctx).BankAdmins {
+		if address.Equals(admin) {
+			return true
+		}
+	}
+	return false
+}
+
 // Transactions gets all the transactions
 func (k Keeper) Transactions(ctx sdk.Context) []Transaction {
 	transactions := make([]Transaction, 0)
@@ -212,6 +298,27 @@ func (k Keeper) TransactionsByAddress(ctx sdk.Context, address sdk.AccAddress, f
 	return transactions
 }
 
+// TreasuryTransactions gets the transactions of a community treasury and applies sent filters.
+func (k Keeper) TreasuryTransactions(ctx sdk.Context, communityID string, filterSetters ...Filter) []Transaction {
+	filters := GetFilters(filterSetters...)
+	transactions := make([]Transaction, 0)
+
+	offsetCount := filters.Offset
+	callbackFunc := func(tx Transaction) bool {
+		if offsetCount > 0 {
+			offsetCount = offsetCount - 1
+			return false
+		}
+		if filters.Limit > 0 && len(transactions) == filters.Limit {
+			return true
+		}
+		transactions = append(transactions, tx)
+		return false
+	}
+	k.IterateTreasuryTransactions(ctx, communityID, filters.SortOrder == SortDesc, callbackFunc)
+	return transactions
+}
+
 func (k Keeper) transactionID(ctx sdk.Context) (uint64, sdk.Error) {
 	id, err := k.getID(ctx, TransactionIDKey)
 	if err != nil {
@@ -7,7 +7,9 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	app "github.com/TruStory/truchain/types"
+	"github.com/TruStory/truchain/x/account"
 	"github.com/TruStory/truchain/x/bank/exported"
+	"github.com/TruStory/truchain/x/distribution"
 )
 
 func TestKeeper_AddCoin(t *testing.T) {
@@ -226,3 +228,74 @@ func TestKeeper_TransactionsByAddress(t *testing.T) {
 		txTypes)
 
 }
+
+func TestKeeper_SendCoinBetweenModules(t *testing.T) {
+	ctx, k, _ := mockDB()
+	_, _, addr := keyPubAddr()
+	amount := sdk.NewCoin(app.StakeDenom, sdk.NewInt(app.Shanev*5))
+
+	err := k.SendCoinBetweenModules(ctx, addr, amount, 10, TransactionCuratorReward,
+		FromModuleAccount(account.UserGrowthPoolName),
+		ToModuleAccount(distribution.UserRewardPoolName),
+	)
+	assert.Equal(t, ErrorCodeInvalidTransactionType, err.Code())
+
+	err = k.SendCoinBetweenModules(ctx, addr, amount, 10, TransactionTreasuryDeposit,
+		WithCommunityID("crypto"),
+		FromModuleAccount(account.UserGrowthPoolName),
+		ToModuleAccount(distribution.UserRewardPoolName),
+	)
+	assert.NoError(t, err)
+	rewardPool := k.supplyKeeper.GetModuleAccount(ctx, distribution.UserRewardPoolName)
+	assert.Equal(t, amount.Amount, rewardPool.GetCoins().AmountOf(app.StakeDenom))
+
+	// module transfers don't show up in the user history
+	assert.Len(t, k.TransactionsByAddress(ctx, addr), 0)
+
+	_, err = k.AddCoin(ctx, addr, amount, 0, TransactionTreasurySpend,
+		WithCommunityID("crypto"),
+		FromModuleAccount(distribution.UserRewardPoolName),
+	)
+	assert.NoError(t, err)
+	assert.Len(t, k.TransactionsByAddress(ctx, addr), 1)
+
+	txs := k.TreasuryTransactions(ctx, "crypto", SortOrder(SortDesc))
+	assert.Len(t, txs, 2)
+	assert.Equal(t, TransactionTreasurySpend, txs[0].Type)
+	assert.Equal(t, TransactionTreasuryDeposit, txs[1].Type)
+	assert.Equal(t, distribution.UserRewardPoolName, txs[1].ToModuleAccount)
+	assert.Equal(t, addr, txs[1].AppAccountAddress)
+	assert.Len(t, k.TreasuryTransactions(ctx, "crypto", Limit(1), Offset(1)), 1)
+	assert.Len(t, k.TreasuryTransactions(ctx, "crypt"), 0)
+}
+
+func TestKeeper_UpdateParams(t *testing.T) {
+	ctx, keeper, _ := mockDB()
+
+	// the first admin can't be added without authorisation
+	_, _, admin := keyPubAddr()
+	err := keeper.AddAdmin(ctx, admin, admin)
+	assert.Equal(t, ErrorCodeAddressNotAuthorised, err.Code())
+	assert.Len(t, keeper.GetParams(ctx).BankAdmins, 0)
+
+	params := keeper.GetParams(ctx)
+	params.BankAdmins = []sdk.AccAddress{admin}
+	keeper.SetParams(ctx, params)
+
+	_, _, broker := keyPubAddr()
+	updates := Params{RewardBrokerAddress: broker}
+	invalidUpdater := sdk.AccAddress([]byte{1, 2})
+	err = keeper.UpdateParams(ctx, invalidUpdater, updates, []string{"reward_broker_address"})
+	assert.Equal(t, ErrorCodeAddressNotAuthorised, err.Code())
+
+	err = keeper.AddAdmin(ctx, invalidUpdater, invalidUpdater)
+	assert.Equal(t, ErrorCodeAddressNotAuthorised, err.Code())
+
+	err = keeper.UpdateParams(ctx, admin, updates, []string{"reward_broker_address"})
+	assert.NoError(t, err)
+	assert.Equal(t, broker, keeper.GetParams(ctx).RewardBrokerAddress)
+
+	err = keeper.UpdateParams(ctx, admin, Params{}, []string{"reward_broker_address"})
+	assert.Equal(t, ErrorCodeInvalidParams, err.Code())
+	assert.Equal(t, broker, keeper.GetParams(ctx).RewardBrokerAddress)
+}
@@ -14,7 +14,8 @@ var (
 	TransactionIDKey = []byte{0x10}
 
 	// AssociationKeys
-	UserTransactionKeyPrefix = []byte{0x20}
+	UserTransactionKeyPrefix     = []byte{0x20}
+	TreasuryTransactionKeyPrefix = []byte{0x30}
 )
 
 // stakeKey gets a key for a stake.
@@ -37,3 +38,17 @@ func userTransactionKey(creator sdk.AccAddress, createdTime time.Time, transacti
 	timeBz := sdk.FormatTimeBytes(createdTime)
 	return append(userTransactionsPrefix(creator), append(timeBz, bz...)...)
 }
+
+// treasuryTransactionsPrefix
+// 0x30<community_id_length><community_id>
+func treasuryTransactionsPrefix(communityID string) []byte {
+	return append(append(TreasuryTransactionKeyPrefix, byte(len(communityID))), []byte(communityID)...)
+}
+
+// treasuryTransactionKey builds the key for community treasury->transaction association
+// 0x30<community_id_length><community_id><created_time><transaction_id>
+func treasuryTransactionKey(communityID string, createdTime time.Time, transactionID uint64) []byte {
+	bz := sdk.Uint64ToBigEndian(transactionID)
+	timeBz := sdk.FormatTimeBytes(createdTime)
+	return append(treasuryTransactionsPrefix(communityID), append(timeBz, bz...)...)
+}
@@ -1,12 +1,16 @@
 package bank
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 const (
 	TypeMsgSendGift     = "send_gift"
 	TypeMsgUpdateParams = "update_params"
+	TypeMsgAddAdmin     = "add_admin"
+	TypeMsgRemoveAdmin  = "remove_admin"
 )
 
 var (
@@ -55,6 +59,94 @@ func (msg MsgSendGift) GetSignBytes() []byte {
 	return sdk.MustSortJSON(bz)
 }
 
+// MsgAddAdmin defines the message to add a new admin
+type MsgAddAdmin struct {
+	Admin   sdk.AccAddress `json:"admin"`
+	Creator sdk.AccAddress `json:"creator"`
+}
+
+// NewMsgAddAdmin returns the messages to add a new admin
+func NewMsgAddAdmin(admin, creator sdk.AccAddress) MsgAddAdmin {
+	return MsgAddAdmin{
+		Admin:   admin,
+		Creator: creator,
+	}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgAddAdmin) ValidateBasic() sdk.Error {
+	if len(msg.Admin) == 0 {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Invalid address: %s", msg.Admin.String()))
+	}
+
+	if len(msg.Creator) == 0 {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Invalid address: %s", msg.Creator.String()))
+	}
+
+	return nil
+}
+
+// Route implements Msg
+func (msg MsgAddAdmin) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgAddAdmin) Type() string { return TypeMsgAddAdmin }
+
+// GetSignBytes implements Msg
+func (msg MsgAddAdmin) GetSignBytes() []byte {
+	msgBytes := ModuleCodec.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(msgBytes)
+}
+
+// GetSigners implements Msg. Returns the creator as the signer.
+func (msg MsgAddAdmin) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Creator)}
+}
+
+// MsgRemoveAdmin defines the message to remove an admin
+type MsgRemoveAdmin struct {
+	Admin   sdk.AccAddress `json:"admin"`
+	Remover sdk.AccAddress `json:"remover"`
+}
+
+// NewMsgRemoveAdmin returns the messages to remove an admin
+func NewMsgRemoveAdmin(admin, remover sdk.AccAddress) MsgRemoveAdmin {
+	return MsgRemoveAdmin{
+		Admin:   admin,
+		Remover: remover,
+	}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgRemoveAdmin) ValidateBasic() sdk.Error {
+	if len(msg.Admin) == 0 {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Invalid address: %s", msg.Admin.String()))
+	}
+
+	if len(msg.Remover) == 0 {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Invalid address: %s", msg.Remover.String()))
+	}
+
+	return nil
+}
+
+// Route implements Msg
+func (msg MsgRemoveAdmin) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgRemoveAdmin) Type() string { return TypeMsgRemoveAdmin }
+
+// GetSignBytes implements Msg
+func (msg MsgRemoveAdmin) GetSignBytes() []byte {
+	msgBytes := ModuleCodec.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(msgBytes)
+}
+
+// GetSigners implements Msg. Returns the remover as the signer.
+func (msg MsgRemoveAdmin) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Remover)}
+}
+
 // MsgUpdateParams defines the message to remove an admin
 type MsgUpdateParams struct {
 	Updates       Params         `json:"updates"`
@@ -73,6 +165,10 @@ func NewMsgUpdateParams(updates Params, updatedFields []string, updater sdk.AccA
 
 // ValidateBasic implements Msg
 func (msg MsgUpdateParams) ValidateBasic() sdk.Error {
+	if len(msg.Updater) == 0 {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Invalid updater: %s", msg.Updater.String()))
+	}
+
 	return nil
 }
 
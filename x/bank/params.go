@@ -1,6 +1,7 @@
 package bank
 
 import (
+	"fmt"
 	"reflect"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -9,20 +10,34 @@ import (
 
 var (
 	ParamKeyRewardBrokerAddress = []byte("rewardBrokerAddress")
+	ParamKeyBankAdmins          = []byte("bankAdmins")
 )
 
 type Params struct {
-	RewardBrokerAddress sdk.AccAddress `json:"reward_broker_address"`
+	RewardBrokerAddress sdk.AccAddress   `json:"reward_broker_address"`
+	BankAdmins          []sdk.AccAddress `json:"bank_admins"`
 }
 
 func DefaultParams() Params {
-	return Params{RewardBrokerAddress: nil}
+	return Params{
+		RewardBrokerAddress: nil,
+		BankAdmins:          []sdk.AccAddress{},
+	}
 }
 
 func (p *Params) ParamSetPairs() params.ParamSetPairs {
 	return params.ParamSetPairs{
 		{Key: ParamKeyRewardBrokerAddress, Value: &p.RewardBrokerAddress},
+		{Key: ParamKeyBankAdmins, Value: &p.BankAdmins},
+	}
+}
+
+// Validate checks that every param has a usable value
+func (p Params) Validate() error {
+	if p.RewardBrokerAddress.Empty() {
+		return fmt.Errorf("param: RewardBrokerAddress, a valid address must be provided")
 	}
+	return nil
 }
 
 // ParamKeyTable for bank module
@@ -43,9 +58,16 @@ func (k Keeper) SetParams(ctx sdk.Context, params Params) {
 }
 
 // UpdateParams updates the required params
-func (k Keeper) UpdateParams(ctx sdk.Context, updates Params, updatedFields []string) sdk.Error {
+func (k Keeper) UpdateParams(ctx sdk.Context, updater sdk.AccAddress, updates Params, updatedFields []string) sdk.Error {
+	if !k.isAdmin(ctx, updater) {
+		return ErrAddressNotAuthorised()
+	}
+
 	current := k.GetParams(ctx)
 	updated := k.getUpdatedParams(current, updates, updatedFields)
+	if err := updated.Validate(); err != nil {
+		return ErrInvalidParams(err.Error())
+	}
 	k.SetParams(ctx, updated)
 
 	return nil
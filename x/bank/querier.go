@@ -11,6 +11,8 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 		switch path[0] {
 		case QueryTransactionsByAddress:
 			return queryTransactionsByAddress(ctx, req, keeper)
+		case QueryTreasuryTransactions:
+			return queryTreasuryTransactions(ctx, req, keeper)
 		case QueryParams:
 			return queryParams(ctx, keeper)
 		default:
@@ -39,6 +41,25 @@ func queryTransactionsByAddress(ctx sdk.Context, req abci.RequestQuery, keeper K
 	return keeper.codec.MustMarshalJSON(transactions), nil
 }
 
+func queryTreasuryTransactions(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params QueryTreasuryTransactionsParams
+	err := keeper.codec.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, ErrInvalidQueryParams(err)
+	}
+	sortOrder := SortAsc
+	if params.SortOrder.Valid() {
+		sortOrder = params.SortOrder
+	}
+	transactions := keeper.TreasuryTransactions(ctx,
+		params.CommunityID,
+		SortOrder(sortOrder),
+		Limit(params.Limit),
+		Offset(params.Offset),
+	)
+	return keeper.codec.MustMarshalJSON(transactions), nil
+}
+
 func queryParams(ctx sdk.Context, keeper Keeper) (result []byte, err sdk.Error) {
 	params := keeper.GetParams(ctx)
 
@@ -0,0 +1,95 @@
+package claim
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker called every block, process closing and archiving claims
+func EndBlocker(ctx sdk.Context, keeper Keeper) {
+	keeper.closeExpiredClaims(ctx)
+	keeper.archiveClosedClaims(ctx)
+}
+
+func (k Keeper) closeExpiredClaims(ctx sdk.Context) {
+	blockTime := ctx.BlockHeader().Time
+	claimIDs := k.queuedClaimIDs(k.closingClaimQueueIterator(ctx, blockTime))
+	if len(claimIDs) == 0 {
+		return
+	}
+
+	for _, id := range claimIDs {
+		claim, ok := k.Claim(ctx, id)
+		if !ok {
+			panic(fmt.Sprintf("unable to retrieve claim with id %d", id))
+		}
+		k.removeFromClosingClaimQueue(ctx, claim.ClosingTime, id)
+		claim = k.closeClaim(ctx, claim)
+		claim = k.resolveClaim(ctx, claim)
+		// a failed refund must not halt the chain, the stake stays on the claim instead
+		cacheCtx, write := ctx.CacheContext()
+		refunded, err := k.refundCreationStake(cacheCtx, claim, k.GetParams(ctx).ClaimFeeShare)
+		if err != nil {
+			logger(ctx).Error(fmt.Sprintf("Failed refunding the creation stake of claim %d: %s", id, err))
+		} else {
+			write()
+			claim = refunded
+		}
+		err = k.afterClaimClosed(ctx, claim)
+		if err != nil {
+			panic(err)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				EventTypeClaimClosed,
+				sdk.NewAttribute(AttributeKeyClaimID, fmt.Sprintf("%d", id)),
+				sdk.NewAttribute(AttributeKeyVerdict, claim.Result.Verdict.String()),
+			),
+		)
+
+		logger(ctx).Info(fmt.Sprintf("Closed claim %d with verdict %s", id, claim.Result.Verdict))
+	}
+}
+
+func (k Keeper) archiveClosedClaims(ctx sdk.Context) {
+	blockTime := ctx.BlockHeader().Time
+	claimIDs := k.queuedClaimIDs(k.archivingClaimQueueIterator(ctx, blockTime))
+	if len(claimIDs) == 0 {
+		return
+	}
+
+	for _, id := range claimIDs {
+		claim, ok := k.Claim(ctx, id)
+		if !ok {
+			panic(fmt.Sprintf("unable to retrieve claim with id %d", id))
+		}
+		k.removeFromArchivingClaimQueue(ctx, claim.ArchiveTime, id)
+		k.archiveClaim(ctx, claim)
+	}
+
+	b, err := k.codec.MarshalJSON(claimIDs)
+	if err != nil {
+		panic(err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeClaimArchived,
+			sdk.NewAttribute(AttributeKeyClaimIDs, string(b)),
+		),
+	)
+}
+
+// queuedClaimIDs drains an iterator over a claim queue into a list of claim IDs
+func (k Keeper) queuedClaimIDs(iterator sdk.Iterator) (claimIDs []uint64) {
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var claimID uint64
+		k.codec.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &claimID)
+		claimIDs = append(claimIDs, claimID)
+	}
+
+	return
+}
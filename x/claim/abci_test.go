@@ -0,0 +1,175 @@
+package claim
+
+import (
+	"testing"
+	"time"
+
+	app "github.com/TruStory/truchain/types"
+	bankexported "github.com/TruStory/truchain/x/bank/exported"
+	"github.com/TruStory/truchain/x/community"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEndBlocker_ClosesAndArchivesClaims(t *testing.T) {
+	ctx, keeper := mockDB()
+	claim := createFakeClaim(ctx, keeper)
+	params := keeper.GetParams(ctx)
+	assert.Equal(t, StatusOpen, claim.Status)
+	assert.Equal(t, claim.CreatedTime.Add(params.ClaimDuration), claim.ClosingTime)
+
+	// nothing happens before the closing time
+	EndBlocker(ctx.WithBlockTime(claim.ClosingTime.Add(-time.Second)), keeper)
+	claim, _ = keeper.Claim(ctx, claim.ID)
+	assert.Equal(t, StatusOpen, claim.Status)
+
+	EndBlocker(ctx.WithBlockTime(claim.ClosingTime), keeper)
+	claim, _ = keeper.Claim(ctx, claim.ID)
+	assert.Equal(t, StatusClosed, claim.Status)
+	assert.Equal(t, claim.ClosingTime.Add(params.ArchiveDuration), claim.ArchiveTime)
+	assert.Len(t, keeper.ClaimsByStatus(ctx, StatusOpen), 0)
+	assert.Len(t, keeper.ClaimsByStatus(ctx, StatusClosed), 1)
+
+	EndBlocker(ctx.WithBlockTime(claim.ArchiveTime), keeper)
+	claim, _ = keeper.Claim(ctx, claim.ID)
+	assert.Equal(t, StatusArchived, claim.Status)
+	assert.Len(t, keeper.ClaimsByStatus(ctx, StatusClosed), 0)
+	assert.Len(t, keeper.ClaimsByStatus(ctx, StatusArchived), 1)
+}
+
+func TestEndBlocker_ReopenedClaimNotArchived(t *testing.T) {
+	ctx, keeper := mockDB()
+	claim := createFakeClaim(ctx, keeper)
+	admin := keeper.GetParams(ctx).ClaimAdmins[0]
+
+	ctx = ctx.WithBlockTime(claim.ClosingTime)
+	EndBlocker(ctx, keeper)
+	closed, _ := keeper.Claim(ctx, claim.ID)
+
+	reopened, err := keeper.ReopenClaim(ctx, claim.ID, admin)
+	assert.NoError(t, err)
+
+	// the stale archive time must not affect the reopened claim
+	EndBlocker(ctx.WithBlockTime(closed.ArchiveTime), keeper)
+	claim, _ = keeper.Claim(ctx, claim.ID)
+	assert.Equal(t, StatusClosed, claim.Status)
+	assert.Equal(t, reopened.ClosingTime.Add(keeper.GetParams(ctx).ArchiveDuration), claim.ArchiveTime)
+}
+
+func TestEndBlocker_ResolvesVerdict(t *testing.T) {
+	tests := []struct {
+		name       string
+		backed     int64
+		challenged int64
+		verdict    Verdict
+	}{
+		{"no stakes", 0, 0, VerdictMajorityNotReached},
+		{"backers supermajority", 70, 30, VerdictBackersWin},
+		{"challengers supermajority", 20, 80, VerdictChallengersWin},
+		{"below threshold", 60, 40, VerdictMajorityNotReached},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, keeper := mockDB()
+			claim := createFakeClaim(ctx, keeper)
+			if tt.backed > 0 {
+				keeper.AddBackingStake(ctx, claim.ID, sdk.NewInt64Coin(app.StakeDenom, tt.backed*app.Shanev))
+			}
+			if tt.challenged > 0 {
+				keeper.AddChallengeStake(ctx, claim.ID, sdk.NewInt64Coin(app.StakeDenom, tt.challenged*app.Shanev))
+			}
+
+			EndBlocker(ctx.WithBlockTime(claim.ClosingTime), keeper)
+			claim, _ = keeper.Claim(ctx, claim.ID)
+			assert.NotNil(t, claim.Result)
+			assert.Equal(t, tt.verdict, claim.Result.Verdict)
+			assert.Equal(t, claim.ClosingTime, claim.Result.ResolvedTime)
+		})
+	}
+}
+
+type mockHooks struct {
+	closed  []Claim
+	deleted []Claim
+	edited  []Claim
+}
+
+func (h *mockHooks) AfterClaimClosed(ctx sdk.Context, claim Claim) sdk.Error {
+	h.closed = append(h.closed, claim)
+	return nil
+}
+
+func (h *mockHooks) AfterClaimDeleted(ctx sdk.Context, claim Claim) sdk.Error {
+	h.deleted = append(h.deleted, claim)
+	return nil
+}
+
+func (h *mockHooks) AfterClaimEdited(ctx sdk.Context, claim Claim) sdk.Error {
+	h.edited = append(h.edited, claim)
+	return nil
+}
+
+func TestEndBlocker_CallsHooks(t *testing.T) {
+	ctx, keeper := mockDB()
+	hooks := &mockHooks{}
+	keeper.SetHooks(hooks)
+	claim := createFakeClaim(ctx, keeper)
+
+	EndBlocker(ctx.WithBlockTime(claim.ClosingTime), keeper)
+	assert.Len(t, hooks.closed, 1)
+	assert.Equal(t, claim.ID, hooks.closed[0].ID)
+	assert.NotNil(t, hooks.closed[0].Result)
+}
+
+func TestEndBlocker_RefundsCreationStake(t *testing.T) {
+	ctx, keeper, bankKeeper := mockDBWithBank()
+	claim := createFakeClaim(ctx, keeper)
+	creationStake := keeper.GetParams(ctx).ClaimCreationStake
+	assert.Equal(t, creationStake, claim.CreationStake)
+	assert.Len(t, bankKeeper.Transactions, 1)
+	assert.Equal(t, TransactionClaimCreation, bankKeeper.Transactions[0].Type)
+	assert.Equal(t, ClaimStakesPoolName, bankKeeper.Transactions[0].ToModuleAccount)
+
+	ctx = ctx.WithBlockTime(claim.ClosingTime)
+	EndBlocker(ctx, keeper)
+	assert.Len(t, bankKeeper.Transactions, 3)
+	fee := sdk.NewCoin(creationStake.Denom,
+		creationStake.Amount.ToDec().Mul(keeper.GetParams(ctx).ClaimFeeShare).TruncateInt())
+	assert.True(t, fee.IsPositive())
+	refund := bankKeeper.Transactions[1]
+	assert.Equal(t, TransactionClaimCreationReturned, refund.Type)
+	assert.Equal(t, claim.Creator, refund.AppAccountAddress)
+	assert.Equal(t, creationStake.Sub(fee), refund.Amount)
+	assert.Equal(t, ClaimStakesPoolName, refund.FromModuleAccount)
+
+	// the claim fee funds the treasury of the claim community
+	deposit := bankKeeper.Transactions[2]
+	assert.Equal(t, bankexported.TransactionTreasuryDeposit, deposit.Type)
+	assert.Equal(t, fee, deposit.Amount)
+	assert.Equal(t, ClaimStakesPoolName, deposit.FromModuleAccount)
+	assert.Equal(t, community.TreasuryPoolName, deposit.ToModuleAccount)
+	assert.Equal(t, fee, keeper.communityKeeper.Treasury(ctx, claim.CommunityID).Balance)
+
+	// reopened claims don't refund twice
+	admin := keeper.GetParams(ctx).ClaimAdmins[0]
+	reopened, err := keeper.ReopenClaim(ctx, claim.ID, admin)
+	assert.NoError(t, err)
+	EndBlocker(ctx.WithBlockTime(reopened.ClosingTime), keeper)
+	assert.Len(t, bankKeeper.Transactions, 3)
+}
+
+func TestEndBlocker_RefundFailureClosesClaim(t *testing.T) {
+	ctx, keeper, bankKeeper := mockDBWithBank()
+	claim := createFakeClaim(ctx, keeper)
+	bankKeeper.AddCoinErr = sdk.ErrInsufficientCoins("empty pool")
+
+	ctx = ctx.WithBlockTime(claim.ClosingTime)
+	assert.NotPanics(t, func() { EndBlocker(ctx, keeper) })
+	closed, ok := keeper.Claim(ctx, claim.ID)
+	assert.True(t, ok)
+	assert.Equal(t, StatusClosed, closed.Status)
+	// the stake stays on the claim and the fee isn't deposited
+	assert.Equal(t, claim.CreationStake, closed.CreationStake)
+	assert.Len(t, bankKeeper.Transactions, 1)
+	assert.True(t, keeper.communityKeeper.Treasury(ctx, claim.CommunityID).Balance.IsZero())
+}
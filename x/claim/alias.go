@@ -1 +1,22 @@
 package claim
+
+import (
+	"github.com/TruStory/truchain/x/bank/exported"
+)
+
+// Aliased constants
+const (
+	TransactionClaimCreation         = exported.TransactionClaimCreation
+	TransactionClaimCreationReturned = exported.TransactionClaimCreationReturned
+)
+
+type (
+	TransactionType = exported.TransactionType
+)
+
+// Transaction setters
+var (
+	WithCommunityID   = exported.WithCommunityID
+	FromModuleAccount = exported.FromModuleAccount
+	ToModuleAccount   = exported.ToModuleAccount
+)
@@ -10,6 +10,15 @@ func RegisterCodec(c *codec.Codec) {
 	c.RegisterConcrete(MsgAddAdmin{}, "claim/MsgAddAdmin", nil)
 	c.RegisterConcrete(MsgRemoveAdmin{}, "claim/MsgRemoveAdmin", nil)
 	c.RegisterConcrete(MsgUpdateParams{}, "claim/MsgUpdateParams", nil)
+	c.RegisterConcrete(MsgReopenClaim{}, "claim/MsgReopenClaim", nil)
+	c.RegisterConcrete(MsgProposeClaimEdit{}, "claim/MsgProposeClaimEdit", nil)
+	c.RegisterConcrete(MsgReviewClaimEdit{}, "claim/MsgReviewClaimEdit", nil)
+	c.RegisterConcrete(MsgAddClaimTag{}, "claim/MsgAddClaimTag", nil)
+	c.RegisterConcrete(MsgRemoveClaimTag{}, "claim/MsgRemoveClaimTag", nil)
+	c.RegisterConcrete(MsgFlagClaim{}, "claim/MsgFlagClaim", nil)
+	c.RegisterConcrete(MsgResolveClaimFlags{}, "claim/MsgResolveClaimFlags", nil)
+	c.RegisterConcrete(MsgDismissClaimFlags{}, "claim/MsgDismissClaimFlags", nil)
+	c.RegisterConcrete(MsgEditClaimEvidence{}, "claim/MsgEditClaimEvidence", nil)
 
 	c.RegisterConcrete(Claim{}, "truchain/Claim", nil)
 }
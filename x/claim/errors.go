@@ -23,6 +23,28 @@ const (
 	ErrorCodeCreatorJailed               CodeType = 108
 	ErrorCodeAddressNotAuthorised        CodeType = 109
 	ErrorCodeJSONParsing                 CodeType = 110
+	ErrorCodeClaimNotClosed              CodeType = 111
+	ErrorCodeInvalidStatus               CodeType = 112
+	ErrorCodeClaimNotResolved            CodeType = 113
+	ErrorCodeDuplicateClaim              CodeType = 114
+	ErrorCodeClaimDeleted                CodeType = 115
+	ErrorCodeInvalidDeletionReason       CodeType = 116
+	ErrorCodeUnknownEditProposal         CodeType = 117
+	ErrorCodeEditProposalNotPending      CodeType = 118
+	ErrorCodeClaimTagExists              CodeType = 119
+	ErrorCodeClaimTagNotFound            CodeType = 120
+	ErrorCodeTooManyClaimTags            CodeType = 121
+	ErrorCodeInvalidSortKey              CodeType = 122
+	ErrorCodeInvalidFlagReason           CodeType = 123
+	ErrorCodeAlreadyFlagged              CodeType = 124
+	ErrorCodeNotEnoughEarnedStake        CodeType = 125
+	ErrorCodeClaimNotFlagged             CodeType = 126
+	ErrorCodeClaimHidden                 CodeType = 127
+	ErrorCodeInvalidEvidence             CodeType = 128
+	ErrorCodeCommunityArchived           CodeType = 129
+	ErrorCodeInvalidPageLimit            CodeType = 130
+	ErrorCodeEditProposalAlreadyPending  CodeType = 131
+	ErrorCodeEditProposalStale           CodeType = 132
 )
 
 // ErrInvalidBodyTooShort throws an error on invalid claim body
@@ -88,3 +110,179 @@ func ErrJSONParse(err error) sdk.Error {
 		ErrorCodeJSONParsing,
 		"JSON parsing error: "+err.Error())
 }
+
+// ErrClaimNotClosed throws an error when reopening a claim that isn't closed
+func ErrClaimNotClosed(id uint64) sdk.Error {
+	return sdk.NewError(
+		DefaultCodespace,
+		ErrorCodeClaimNotClosed,
+		fmt.Sprintf("Claim %d is not closed", id))
+}
+
+// ErrInvalidStatus throws an error on an unknown claim status
+func ErrInvalidStatus(status Status) sdk.Error {
+	return sdk.NewError(
+		DefaultCodespace,
+		ErrorCodeInvalidStatus,
+		fmt.Sprintf("Invalid claim status: %d", status))
+}
+
+// ErrClaimNotResolved throws an error when a claim has no verdict yet
+func ErrClaimNotResolved(id uint64) sdk.Error {
+	return sdk.NewError(
+		DefaultCodespace,
+		ErrorCodeClaimNotResolved,
+		fmt.Sprintf("Claim %d has not been resolved", id))
+}
+
+// ErrDuplicateClaim throws an error when a claim already exists in the community
+func ErrDuplicateClaim(existingID uint64) sdk.Error {
+	return sdk.NewError(
+		DefaultCodespace,
+		ErrorCodeDuplicateClaim,
+		fmt.Sprintf("Duplicate of existing claim id: %d", existingID))
+}
+
+// ErrClaimDeleted throws an error when acting on a deleted claim
+func ErrClaimDeleted(id uint64) sdk.Error {
+	return sdk.NewError(
+		DefaultCodespace,
+		ErrorCodeClaimDeleted,
+		fmt.Sprintf("Claim %d has been deleted", id))
+}
+
+// ErrInvalidDeletionReason throws an error when a claim is deleted without a reason
+func ErrInvalidDeletionReason() sdk.Error {
+	return sdk.NewError(
+		DefaultCodespace,
+		ErrorCodeInvalidDeletionReason,
+		"A reason is required to delete a claim")
+}
+
+// ErrUnknownEditProposal throws an error on an unknown claim edit proposal
+func ErrUnknownEditProposal(id uint64) sdk.Error {
+	return sdk.NewError(
+		DefaultCodespace,
+		ErrorCodeUnknownEditProposal,
+		fmt.Sprintf("Unknown claim edit proposal id: %d", id))
+}
+
+// ErrEditProposalNotPending throws an error when reviewing an already reviewed proposal
+func ErrEditProposalNotPending(id uint64) sdk.Error {
+	return sdk.NewError(
+		DefaultCodespace,
+		ErrorCodeEditProposalNotPending,
+		fmt.Sprintf("Claim edit proposal %d has already been reviewed", id))
+}
+
+// ErrClaimTagExists throws an error when a claim already belongs to a community
+func ErrClaimTagExists(id uint64, communityID string) sdk.Error {
+	return sdk.NewError(
+		DefaultCodespace,
+		ErrorCodeClaimTagExists,
+		fmt.Sprintf("Claim %d already belongs to community %s", id, communityID))
+}
+
+// ErrClaimTagNotFound throws an error when a claim isn't tagged with a community
+func ErrClaimTagNotFound(id uint64, communityID string) sdk.Error {
+	return sdk.NewError(
+		DefaultCodespace,
+		ErrorCodeClaimTagNotFound,
+		fmt.Sprintf("Claim %d is not tagged with community %s", id, communityID))
+}
+
+// ErrTooManyClaimTags throws an error when a claim reached the maximum number of tags
+func ErrTooManyClaimTags(max int) sdk.Error {
+	return sdk.NewError(
+		DefaultCodespace,
+		ErrorCodeTooManyClaimTags,
+		fmt.Sprintf("A claim can be tagged with at most %d communities", max))
+}
+
+// ErrInvalidSortKey throws an error on an unknown claim sort key
+func ErrInvalidSortKey(sortKey SortKey) sdk.Error {
+	return sdk.NewError(
+		DefaultCodespace,
+		ErrorCodeInvalidSortKey,
+		fmt.Sprintf("Invalid sort key: %s", sortKey))
+}
+
+// ErrInvalidFlagReason throws an error on an unknown flag reason
+func ErrInvalidFlagReason(reason FlagReason) sdk.Error {
+	return sdk.NewError(
+		DefaultCodespace,
+		ErrorCodeInvalidFlagReason,
+		fmt.Sprintf("Invalid flag reason: %d", reason))
+}
+
+// ErrAlreadyFlagged throws an error when a user flags the same claim twice
+func ErrAlreadyFlagged(id uint64) sdk.Error {
+	return sdk.NewError(
+		DefaultCodespace,
+		ErrorCodeAlreadyFlagged,
+		fmt.Sprintf("Claim %d has already been flagged by this user", id))
+}
+
+// ErrNotEnoughEarnedStake throws an error when the creator hasn't earned enough to flag claims
+func ErrNotEnoughEarnedStake(address sdk.AccAddress) sdk.Error {
+	return sdk.NewError(
+		DefaultCodespace,
+		ErrorCodeNotEnoughEarnedStake,
+		fmt.Sprintf("Creator %s does not have enough earned stake", address))
+}
+
+// ErrClaimNotFlagged throws an error when there are no pending flags to moderate
+func ErrClaimNotFlagged(id uint64) sdk.Error {
+	return sdk.NewError(
+		DefaultCodespace,
+		ErrorCodeClaimNotFlagged,
+		fmt.Sprintf("Claim %d has no flags awaiting moderation", id))
+}
+
+// ErrClaimHidden throws an error when flagging a hidden claim
+func ErrClaimHidden(id uint64) sdk.Error {
+	return sdk.NewError(
+		DefaultCodespace,
+		ErrorCodeClaimHidden,
+		fmt.Sprintf("Claim %d is hidden", id))
+}
+
+// ErrInvalidEvidence throws an error when the evidence of a claim is invalid
+func ErrInvalidEvidence(err error) sdk.Error {
+	return sdk.NewError(
+		DefaultCodespace,
+		ErrorCodeInvalidEvidence,
+		fmt.Sprintf("Invalid evidence: %s", err))
+}
+
+// ErrCommunityArchived throws an error when adding a claim to an archived community
+func ErrCommunityArchived(id string) sdk.Error {
+	return sdk.NewError(
+		DefaultCodespace,
+		ErrorCodeCommunityArchived,
+		fmt.Sprintf("Community %s is archived and does not accept new claims", id))
+}
+
+// ErrInvalidPageLimit throws an error on a negative page size
+func ErrInvalidPageLimit(limit int) sdk.Error {
+	return sdk.NewError(
+		DefaultCodespace,
+		ErrorCodeInvalidPageLimit,
+		fmt.Sprintf("Invalid page limit: %d", limit))
+}
+
+// ErrEditProposalAlreadyPending throws an error when a user proposes another edit before the first is reviewed
+func ErrEditProposalAlreadyPending(claimID uint64) sdk.Error {
+	return sdk.NewError(
+		DefaultCodespace,
+		ErrorCodeEditProposalAlreadyPending,
+		fmt.Sprintf("An edit proposal for claim %d is already awaiting review", claimID))
+}
+
+// ErrEditProposalStale throws an error when approving a proposal made before the latest claim edit
+func ErrEditProposalStale(id uint64) sdk.Error {
+	return sdk.NewError(
+		DefaultCodespace,
+		ErrorCodeEditProposalStale,
+		fmt.Sprintf("Claim edit proposal %d was made against an older revision of the claim", id))
+}
@@ -0,0 +1,87 @@
+package claim
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"mime"
+	"net/url"
+)
+
+// EvidenceKind enum
+type EvidenceKind int8
+
+const (
+	// EvidenceLink represents a link to a live web page
+	EvidenceLink EvidenceKind = iota
+	// EvidenceDocument represents a document stored off-chain
+	EvidenceDocument
+	// EvidenceSnapshot represents an archived snapshot of a web page
+	EvidenceSnapshot
+)
+
+// EvidenceKindName is the name of the evidence kind
+var EvidenceKindName = []string{
+	EvidenceLink:     "Link",
+	EvidenceDocument: "Document",
+	EvidenceSnapshot: "Snapshot",
+}
+
+// Valid returns true if the evidence kind is a known kind
+func (k EvidenceKind) Valid() bool {
+	return k >= EvidenceLink && int(k) < len(EvidenceKindName)
+}
+
+func (k EvidenceKind) String() string {
+	if !k.Valid() {
+		return "Unknown"
+	}
+	return EvidenceKindName[k]
+}
+
+// Evidence is a piece of evidence attached to a claim or an argument.
+// Digest is the hex encoded SHA-256 of the content at URI, so off-chain
+// archives can be verified against the chain.
+type Evidence struct {
+	Kind     EvidenceKind `json:"kind"`
+	URI      string       `json:"uri"`
+	Digest   string       `json:"digest,omitempty"`
+	MimeType string       `json:"mime_type,omitempty"`
+}
+
+// ValidateEvidence checks a list of evidence against the count and URI length limits.
+// Documents and snapshots must carry a digest, links may omit it.
+func ValidateEvidence(evidence []Evidence, maxCount, maxURILength int) error {
+	if len(evidence) > maxCount {
+		return fmt.Errorf("at most %d pieces of evidence are allowed", maxCount)
+	}
+	for i, e := range evidence {
+		if !e.Kind.Valid() {
+			return fmt.Errorf("evidence %d has an invalid kind %d", i, e.Kind)
+		}
+		if len(e.URI) == 0 || len(e.URI) > maxURILength {
+			return fmt.Errorf("evidence %d URI must be between 1 and %d characters", i, maxURILength)
+		}
+		uri, err := url.Parse(e.URI)
+		if err != nil || uri.Scheme == "" {
+			return fmt.Errorf("evidence %d has an invalid URI %s", i, e.URI)
+		}
+		if e.Digest == "" && e.Kind != EvidenceLink {
+			return fmt.Errorf("evidence %d must have a digest", i)
+		}
+		if e.Digest != "" {
+			digest, err := hex.DecodeString(e.Digest)
+			if err != nil || len(digest) != sha256.Size {
+				return fmt.Errorf("evidence %d digest must be a hex encoded SHA-256 hash", i)
+			}
+		}
+		if e.MimeType != "" {
+			_, _, err := mime.ParseMediaType(e.MimeType)
+			if err != nil {
+				return fmt.Errorf("evidence %d has an invalid MIME type %s", i, e.MimeType)
+			}
+		}
+	}
+
+	return nil
+}
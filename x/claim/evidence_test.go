@@ -0,0 +1,38 @@
+package claim
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateEvidence(t *testing.T) {
+	digest := strings.Repeat("ab", 32)
+	tests := []struct {
+		name     string
+		evidence []Evidence
+		valid    bool
+	}{
+		{"empty", nil, true},
+		{"link without digest", []Evidence{{Kind: EvidenceLink, URI: "https://trustory.io"}}, true},
+		{"document", []Evidence{{Kind: EvidenceDocument, URI: "ipfs://Qm123", Digest: digest, MimeType: "application/pdf"}}, true},
+		{"snapshot without digest", []Evidence{{Kind: EvidenceSnapshot, URI: "https://archive.org/web/1"}}, false},
+		{"unknown kind", []Evidence{{Kind: EvidenceKind(9), URI: "https://trustory.io"}}, false},
+		{"missing scheme", []Evidence{{Kind: EvidenceLink, URI: "trustory.io"}}, false},
+		{"uri too long", []Evidence{{Kind: EvidenceLink, URI: "https://" + strings.Repeat("a", 40)}}, false},
+		{"short digest", []Evidence{{Kind: EvidenceDocument, URI: "ipfs://Qm123", Digest: "abcd"}}, false},
+		{"non hex digest", []Evidence{{Kind: EvidenceDocument, URI: "ipfs://Qm123", Digest: strings.Repeat("zz", 32)}}, false},
+		{"bad mime type", []Evidence{{Kind: EvidenceLink, URI: "https://trustory.io", MimeType: "text/"}}, false},
+		{"too many", []Evidence{
+			{Kind: EvidenceLink, URI: "https://trustory.io/1"},
+			{Kind: EvidenceLink, URI: "https://trustory.io/2"},
+			{Kind: EvidenceLink, URI: "https://trustory.io/3"},
+		}, false},
+	}
+
+	for _, test := range tests {
+		err := ValidateEvidence(test.evidence, 2, 40)
+		assert.Equal(t, test.valid, err == nil, test.name)
+	}
+}
@@ -1,10 +1,44 @@
 package claim
 
 import (
+	bankexported "github.com/TruStory/truchain/x/bank/exported"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	supplyexported "github.com/cosmos/cosmos-sdk/x/supply/exported"
 )
 
 // AccountKeeper is the expected account keeper interface for this module
 type AccountKeeper interface {
 	IsJailed(ctx sdk.Context, addr sdk.AccAddress) (bool, sdk.Error)
 }
+
+// BankKeeper is the expected bank keeper interface for this module
+type BankKeeper interface {
+	AddCoin(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coin,
+		referenceID uint64, txType bankexported.TransactionType, setters ...bankexported.TransactionSetter) (sdk.Coins, sdk.Error)
+	SubtractCoin(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coin,
+		referenceID uint64, txType bankexported.TransactionType, setters ...bankexported.TransactionSetter) (sdk.Coins, sdk.Error)
+}
+
+// StakingKeeper is the expected staking keeper interface for this module
+type StakingKeeper interface {
+	TotalEarnedCoins(ctx sdk.Context, creator sdk.AccAddress) sdk.Int
+}
+
+// SearchKeeper is the expected search keeper interface for this module
+type SearchKeeper interface {
+	IndexClaim(ctx sdk.Context, id uint64, body string)
+	RemoveClaim(ctx sdk.Context, id uint64)
+}
+
+// SupplyKeeper is the expected supply keeper interface for this module
+type SupplyKeeper interface {
+	GetModuleAccount(ctx sdk.Context, moduleName string) supplyexported.ModuleAccountI
+	MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) sdk.Error
+}
+
+// ClaimHooks event hooks for other modules to react to claim lifecycle changes
+type ClaimHooks interface {
+	AfterClaimClosed(ctx sdk.Context, claim Claim) sdk.Error
+	AfterClaimDeleted(ctx sdk.Context, claim Claim) sdk.Error
+	AfterClaimEdited(ctx sdk.Context, claim Claim) sdk.Error
+}
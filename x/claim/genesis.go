@@ -3,13 +3,17 @@ package claim
 import (
 	"fmt"
 
+	app "github.com/TruStory/truchain/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // GenesisState defines genesis data for the module
 type GenesisState struct {
-	Claims []Claim `json:"claims"`
-	Params Params  `json:"params"`
+	Claims        []Claim             `json:"claims"`
+	Revisions     []ClaimRevision     `json:"revisions"`
+	EditProposals []ClaimEditProposal `json:"edit_proposals"`
+	Flags         []ClaimFlag         `json:"flags"`
+	Params        Params              `json:"params"`
 }
 
 // NewGenesisState creates a new genesis state.
@@ -25,21 +29,83 @@ func DefaultGenesisState() GenesisState { return NewGenesisState() }
 
 // InitGenesis initializes story state from genesis file
 func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
+	mintStakesPool := k.supplyKeeper.GetModuleAccount(ctx, ClaimStakesPoolName).GetCoins().Empty()
 	for _, c := range data.Claims {
+		// claims exported before the lifecycle existed have no closing time
+		if c.Status == StatusOpen && c.ClosingTime.IsZero() {
+			c.ClosingTime = c.CreatedTime.Add(data.Params.ClaimDuration)
+		}
+		// nor a creation stake
+		if c.CreationStake.Denom == "" {
+			c.CreationStake = sdk.NewCoin(app.StakeDenom, sdk.ZeroInt())
+		}
 		k.setClaim(ctx, c)
+		k.setStatusClaim(ctx, c.Status, c.ID)
+		// creation stakes not refunded yet are held in the claim stakes pool
+		if mintStakesPool && c.CreationStake.IsPositive() {
+			err := k.supplyKeeper.MintCoins(ctx, ClaimStakesPoolName, sdk.NewCoins(c.CreationStake))
+			if err != nil {
+				panic(err)
+			}
+		}
+		// deleted claims are only kept as tombstones
+		if c.IsDeleted() {
+			continue
+		}
 		k.setCommunityClaim(ctx, c.CommunityID, c.ID)
+		for _, tag := range c.Tags {
+			k.setTagClaim(ctx, tag, c.ID)
+		}
 		k.setCreatorClaim(ctx, c.Creator, c.ID)
 		k.setCreatedTimeClaim(ctx, c.CreatedTime, c.ID)
+		k.setSourceClaim(ctx, c.CommunityID, c.Source, c.ID)
+		k.setBodyClaim(ctx, c.CommunityID, c.Body, c.ID)
+		k.setActivityClaim(ctx, c)
+		if c.FlagCount > 0 {
+			k.insertModerationQueue(ctx, c.FlagCount, c.ID)
+		}
+		// hidden claims are kept out of search results
+		if !c.Hidden {
+			k.searchKeeper.IndexClaim(ctx, c.ID, c.Body)
+		}
+		switch c.Status {
+		case StatusOpen:
+			k.insertClosingClaimQueue(ctx, c.ClosingTime, c.ID)
+		case StatusClosed:
+			k.insertArchivingClaimQueue(ctx, c.ArchiveTime, c.ID)
+		}
 	}
 	k.setClaimID(ctx, uint64(len(data.Claims)+1))
+	for _, r := range data.Revisions {
+		k.setClaimRevision(ctx, r)
+	}
+	for _, p := range data.EditProposals {
+		k.setEditProposal(ctx, p)
+		k.setClaimEditProposal(ctx, p.ClaimID, p.ID)
+	}
+	k.setEditProposalID(ctx, uint64(len(data.EditProposals)+1))
+	for _, f := range data.Flags {
+		k.setClaimFlag(ctx, f)
+	}
 	k.SetParams(ctx, data.Params)
+
+	// rebuild the claim counts, so genesis files without community stats still pass the invariants
+	counts := k.communityClaimCounts(ctx)
+	for _, community := range k.communityKeeper.Communities(ctx) {
+		stats := k.communityKeeper.Stats(ctx, community.ID)
+		stats.ClaimCount = counts[community.ID]
+		k.communityKeeper.SetStats(ctx, stats)
+	}
 }
 
 // ExportGenesis exports the genesis state
 func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
 	return GenesisState{
-		Claims: k.Claims(ctx),
-		Params: k.GetParams(ctx),
+		Claims:        k.claimsWithTombstones(ctx),
+		Revisions:     k.iterateRevisions(ctx, ClaimRevisionsPrefix),
+		EditProposals: k.editProposals(ctx),
+		Flags:         k.claimFlags(ctx, ClaimFlagsPrefix),
+		Params:        k.GetParams(ctx),
 	}
 }
 
@@ -51,6 +117,51 @@ func ValidateGenesis(data GenesisState) error {
 	if data.Params.MaxClaimLength < 1 {
 		return fmt.Errorf("Param: MaxClaimLength must have a positive value")
 	}
+	if data.Params.ClaimDuration <= 0 {
+		return fmt.Errorf("Param: ClaimDuration must have a positive value")
+	}
+	if data.Params.ArchiveDuration <= 0 {
+		return fmt.Errorf("Param: ArchiveDuration must have a positive value")
+	}
+	if data.Params.VerdictThreshold.LTE(sdk.NewDecWithPrec(5, 1)) || data.Params.VerdictThreshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("Param: VerdictThreshold must be greater than 0.5 and at most 1")
+	}
+	if data.Params.ClaimCreationStake.Denom != app.StakeDenom || data.Params.ClaimCreationStake.IsNegative() {
+		return fmt.Errorf("Param: ClaimCreationStake must be a non-negative %s amount", app.StakeDenom)
+	}
+	if data.Params.MaxCommunityTags < 0 {
+		return fmt.Errorf("Param: MaxCommunityTags must not be negative")
+	}
+	if data.Params.FlagMinEarnedStake.Denom != app.StakeDenom || data.Params.FlagMinEarnedStake.IsNegative() {
+		return fmt.Errorf("Param: FlagMinEarnedStake must be a non-negative %s amount", app.StakeDenom)
+	}
+	if data.Params.FlagHideThreshold < 1 {
+		return fmt.Errorf("Param: FlagHideThreshold must have a positive value")
+	}
+	if data.Params.MaxEvidenceCount < 0 {
+		return fmt.Errorf("Param: MaxEvidenceCount must not be negative")
+	}
+	if data.Params.MaxEvidenceURILength < 1 {
+		return fmt.Errorf("Param: MaxEvidenceURILength must have a positive value")
+	}
+	if data.Params.ClaimFeeShare.IsNil() || data.Params.ClaimFeeShare.IsNegative() || data.Params.ClaimFeeShare.GT(sdk.OneDec()) {
+		return fmt.Errorf("Param: ClaimFeeShare must be between 0 and 1")
+	}
+	for _, c := range data.Claims {
+		if !c.Status.Valid() {
+			return fmt.Errorf("Claim %d has an invalid status %d", c.ID, c.Status)
+		}
+	}
+	for _, p := range data.EditProposals {
+		if !p.Status.Valid() {
+			return fmt.Errorf("Claim edit proposal %d has an invalid status %d", p.ID, p.Status)
+		}
+	}
+	for _, f := range data.Flags {
+		if !f.Reason.Valid() {
+			return fmt.Errorf("Claim %d has a flag with an invalid reason %d", f.ClaimID, f.Reason)
+		}
+	}
 
 	return nil
 }
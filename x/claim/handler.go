@@ -21,6 +21,26 @@ func NewHandler(keeper Keeper) sdk.Handler {
 			return handleMsgRemoveAdmin(ctx, keeper, msg)
 		case MsgUpdateParams:
 			return handleMsgUpdateParams(ctx, keeper, msg)
+		case MsgReopenClaim:
+			return handleMsgReopenClaim(ctx, keeper, msg)
+		case MsgDeleteClaim:
+			return handleMsgDeleteClaim(ctx, keeper, msg)
+		case MsgProposeClaimEdit:
+			return handleMsgProposeClaimEdit(ctx, keeper, msg)
+		case MsgReviewClaimEdit:
+			return handleMsgReviewClaimEdit(ctx, keeper, msg)
+		case MsgAddClaimTag:
+			return handleMsgAddClaimTag(ctx, keeper, msg)
+		case MsgRemoveClaimTag:
+			return handleMsgRemoveClaimTag(ctx, keeper, msg)
+		case MsgFlagClaim:
+			return handleMsgFlagClaim(ctx, keeper, msg)
+		case MsgResolveClaimFlags:
+			return handleMsgResolveClaimFlags(ctx, keeper, msg)
+		case MsgDismissClaimFlags:
+			return handleMsgDismissClaimFlags(ctx, keeper, msg)
+		case MsgEditClaimEvidence:
+			return handleMsgEditClaimEvidence(ctx, keeper, msg)
 		default:
 			errMsg := fmt.Sprintf("Unrecognized claim message type: %T", msg)
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -39,9 +59,20 @@ func handleMsgCreateClaim(ctx sdk.Context, keeper Keeper, msg MsgCreateClaim) sd
 		return ErrInvalidSourceURL(msg.Source).Result()
 	}
 
-	claim, err := keeper.SubmitClaim(ctx, msg.Body, msg.CommunityID, msg.Creator, *sourceURL)
+	var claim Claim
+	var err sdk.Error
+	if msg.AllowDuplicate {
+		claim, err = keeper.SubmitDuplicateClaim(ctx, msg.Body, msg.CommunityID, msg.Creator, *sourceURL, msg.Evidence...)
+	} else {
+		claim, err = keeper.SubmitClaim(ctx, msg.Body, msg.CommunityID, msg.Creator, *sourceURL, msg.Evidence...)
+	}
 	if err != nil {
-		return err.Result()
+		result := err.Result()
+		// return the existing claim ID so clients can link to it
+		if err.Code() == ErrorCodeDuplicateClaim {
+			result.Data, _ = ModuleCodec.MarshalJSON(claim.ID)
+		}
+		return result
 	}
 
 	res, codecErr := ModuleCodec.MarshalJSON(claim)
@@ -74,6 +105,146 @@ func handleMsgEditClaim(ctx sdk.Context, keeper Keeper, msg MsgEditClaim) sdk.Re
 	}
 }
 
+func handleMsgEditClaimEvidence(ctx sdk.Context, keeper Keeper, msg MsgEditClaimEvidence) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	claim, err := keeper.EditClaimEvidence(ctx, msg.ID, msg.Evidence, msg.Editor)
+	if err != nil {
+		return err.Result()
+	}
+
+	res, codecErr := ModuleCodec.MarshalJSON(claim)
+	if codecErr != nil {
+		return sdk.ErrInternal(fmt.Sprintf("Marshal result error: %s", codecErr)).Result()
+	}
+
+	return sdk.Result{
+		Data: res,
+	}
+}
+
+func handleMsgReopenClaim(ctx sdk.Context, keeper Keeper, msg MsgReopenClaim) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	claim, err := keeper.ReopenClaim(ctx, msg.ID, msg.Admin)
+	if err != nil {
+		return err.Result()
+	}
+
+	res, codecErr := ModuleCodec.MarshalJSON(claim)
+	if codecErr != nil {
+		return sdk.ErrInternal(fmt.Sprintf("Marshal result error: %s", codecErr)).Result()
+	}
+
+	return sdk.Result{
+		Data: res,
+	}
+}
+
+func handleMsgDeleteClaim(ctx sdk.Context, keeper Keeper, msg MsgDeleteClaim) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	claim, err := keeper.DeleteClaim(ctx, msg.ID, msg.Reason, msg.Admin)
+	if err != nil {
+		return err.Result()
+	}
+
+	res, codecErr := ModuleCodec.MarshalJSON(claim)
+	if codecErr != nil {
+		return sdk.ErrInternal(fmt.Sprintf("Marshal result error: %s", codecErr)).Result()
+	}
+
+	return sdk.Result{
+		Data: res,
+	}
+}
+
+func handleMsgProposeClaimEdit(ctx sdk.Context, keeper Keeper, msg MsgProposeClaimEdit) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	proposal, err := keeper.ProposeClaimEdit(ctx, msg.ClaimID, msg.Body, msg.Proposer)
+	if err != nil {
+		return err.Result()
+	}
+
+	res, codecErr := ModuleCodec.MarshalJSON(proposal)
+	if codecErr != nil {
+		return sdk.ErrInternal(fmt.Sprintf("Marshal result error: %s", codecErr)).Result()
+	}
+
+	return sdk.Result{
+		Data: res,
+	}
+}
+
+func handleMsgReviewClaimEdit(ctx sdk.Context, keeper Keeper, msg MsgReviewClaimEdit) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	proposal, err := keeper.ReviewClaimEdit(ctx, msg.ProposalID, msg.Approve, msg.Admin)
+	if err != nil {
+		return err.Result()
+	}
+
+	res, codecErr := ModuleCodec.MarshalJSON(proposal)
+	if codecErr != nil {
+		return sdk.ErrInternal(fmt.Sprintf("Marshal result error: %s", codecErr)).Result()
+	}
+
+	return sdk.Result{
+		Data: res,
+	}
+}
+
+func handleMsgAddClaimTag(ctx sdk.Context, keeper Keeper, msg MsgAddClaimTag) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	claim, err := keeper.AddClaimTag(ctx, msg.ClaimID, msg.CommunityID, msg.Admin)
+	if err != nil {
+		return err.Result()
+	}
+
+	res, codecErr := ModuleCodec.MarshalJSON(claim)
+	if codecErr != nil {
+		return sdk.ErrInternal(fmt.Sprintf("Marshal result error: %s", codecErr)).Result()
+	}
+
+	return sdk.Result{
+		Data: res,
+	}
+}
+
+func handleMsgRemoveClaimTag(ctx sdk.Context, keeper Keeper, msg MsgRemoveClaimTag) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	claim, err := keeper.RemoveClaimTag(ctx, msg.ClaimID, msg.CommunityID, msg.Admin)
+	if err != nil {
+		return err.Result()
+	}
+
+	res, codecErr := ModuleCodec.MarshalJSON(claim)
+	if codecErr != nil {
+		return sdk.ErrInternal(fmt.Sprintf("Marshal result error: %s", codecErr)).Result()
+	}
+
+	return sdk.Result{
+		Data: res,
+	}
+}
+
 func handleMsgAddAdmin(ctx sdk.Context, k Keeper, msg MsgAddAdmin) sdk.Result {
 	if err := msg.ValidateBasic(); err != nil {
 		return err.Result()
@@ -133,3 +304,63 @@ func handleMsgUpdateParams(ctx sdk.Context, k Keeper, msg MsgUpdateParams) sdk.R
 		Data: res,
 	}
 }
+
+func handleMsgFlagClaim(ctx sdk.Context, keeper Keeper, msg MsgFlagClaim) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	claim, err := keeper.FlagClaim(ctx, msg.ClaimID, msg.Reason, msg.Creator)
+	if err != nil {
+		return err.Result()
+	}
+
+	res, codecErr := ModuleCodec.MarshalJSON(claim)
+	if codecErr != nil {
+		return sdk.ErrInternal(fmt.Sprintf("Marshal result error: %s", codecErr)).Result()
+	}
+
+	return sdk.Result{
+		Data: res,
+	}
+}
+
+func handleMsgResolveClaimFlags(ctx sdk.Context, keeper Keeper, msg MsgResolveClaimFlags) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	claim, err := keeper.ResolveClaimFlags(ctx, msg.ClaimID, msg.Admin)
+	if err != nil {
+		return err.Result()
+	}
+
+	res, codecErr := ModuleCodec.MarshalJSON(claim)
+	if codecErr != nil {
+		return sdk.ErrInternal(fmt.Sprintf("Marshal result error: %s", codecErr)).Result()
+	}
+
+	return sdk.Result{
+		Data: res,
+	}
+}
+
+func handleMsgDismissClaimFlags(ctx sdk.Context, keeper Keeper, msg MsgDismissClaimFlags) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	claim, err := keeper.DismissClaimFlags(ctx, msg.ClaimID, msg.Admin)
+	if err != nil {
+		return err.Result()
+	}
+
+	res, codecErr := ModuleCodec.MarshalJSON(claim)
+	if codecErr != nil {
+		return sdk.ErrInternal(fmt.Sprintf("Marshal result error: %s", codecErr)).Result()
+	}
+
+	return sdk.Result{
+		Data: res,
+	}
+}
@@ -87,3 +87,91 @@ func TestMsgEditClaim(t *testing.T) {
 	assert.Equal(t, updated.ID, claim.ID)
 	assert.Equal(t, updated.Body, updatedBody)
 }
+
+func TestMsgReopenClaim(t *testing.T) {
+	ctx, keeper := mockDB()
+
+	handler := NewHandler(keeper)
+	assert.NotNil(t, handler)
+
+	claim := createFakeClaim(ctx, keeper)
+	ctx = ctx.WithBlockTime(claim.ClosingTime)
+	EndBlocker(ctx, keeper)
+	admin := keeper.GetParams(ctx).ClaimAdmins[0]
+
+	msg := NewMsgReopenClaim(claim.ID, admin)
+	res := handler(ctx, msg)
+	assert.True(t, res.IsOK())
+
+	var reopened Claim
+	ModuleCodec.UnmarshalJSON(res.Data, &reopened)
+	assert.Equal(t, claim.ID, reopened.ID)
+	assert.Equal(t, StatusOpen, reopened.Status)
+}
+
+func TestMsgDeleteClaim(t *testing.T) {
+	ctx, keeper := mockDB()
+	handler := NewHandler(keeper)
+
+	claim := createFakeClaim(ctx, keeper)
+	admin := keeper.GetParams(ctx).ClaimAdmins[0]
+
+	res := handler(ctx, NewMsgDeleteClaim(claim.ID, "", admin))
+	assert.False(t, res.IsOK())
+	assert.Equal(t, ErrorCodeInvalidDeletionReason, res.Code)
+
+	res = handler(ctx, NewMsgDeleteClaim(claim.ID, "off topic", admin))
+	assert.True(t, res.IsOK())
+
+	var deleted Claim
+	ModuleCodec.UnmarshalJSON(res.Data, &deleted)
+	assert.Equal(t, claim.ID, deleted.ID)
+	tombstone, _ := keeper.Claim(ctx, claim.ID)
+	assert.Equal(t, StatusDeleted, tombstone.Status)
+}
+
+func TestMsgCreateClaim_Duplicate(t *testing.T) {
+	ctx, keeper := mockDB()
+	handler := NewHandler(keeper)
+
+	body := "fake story body with minimum length"
+	creator := sdk.AccAddress([]byte{1, 2})
+	res := handler(ctx, NewMsgCreateClaim("crypto", body, creator, ""))
+	assert.True(t, res.IsOK())
+
+	res = handler(ctx, NewMsgCreateClaim("crypto", body, creator, ""))
+	assert.False(t, res.IsOK())
+	assert.Equal(t, ErrorCodeDuplicateClaim, res.Code)
+	var existingID uint64
+	err := ModuleCodec.UnmarshalJSON(res.Data, &existingID)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), existingID)
+
+	admin := keeper.GetParams(ctx).ClaimAdmins[0]
+	msg := NewMsgCreateClaim("crypto", body, admin, "")
+	msg.AllowDuplicate = true
+	res = handler(ctx, msg)
+	assert.True(t, res.IsOK())
+}
+
+func TestMsgProposeReviewClaimEdit(t *testing.T) {
+	ctx, keeper := mockDB()
+	handler := NewHandler(keeper)
+
+	claim := createFakeClaim(ctx, keeper)
+	proposer := sdk.AccAddress([]byte{3, 4})
+	correctedBody := "This is the corrected claim body proposed by a user."
+	res := handler(ctx, NewMsgProposeClaimEdit(claim.ID, correctedBody, proposer))
+	assert.True(t, res.IsOK())
+
+	var proposal ClaimEditProposal
+	ModuleCodec.UnmarshalJSON(res.Data, &proposal)
+	assert.Equal(t, uint64(1), proposal.ID)
+
+	admin := keeper.GetParams(ctx).ClaimAdmins[0]
+	res = handler(ctx, NewMsgReviewClaimEdit(proposal.ID, true, admin))
+	assert.True(t, res.IsOK())
+
+	updated, _ := keeper.Claim(ctx, claim.ID)
+	assert.Equal(t, correctedBody, updated.Body)
+}
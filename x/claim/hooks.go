@@ -0,0 +1,64 @@
+package claim
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// afterClaimClosed calls the registered hooks once a claim is closed and resolved
+func (k Keeper) afterClaimClosed(ctx sdk.Context, claim Claim) sdk.Error {
+	if k.hooks == nil {
+		return nil
+	}
+	return k.hooks.AfterClaimClosed(ctx, claim)
+}
+
+// afterClaimDeleted calls the registered hooks once a claim is deleted
+func (k Keeper) afterClaimDeleted(ctx sdk.Context, claim Claim) sdk.Error {
+	if k.hooks == nil {
+		return nil
+	}
+	return k.hooks.AfterClaimDeleted(ctx, claim)
+}
+
+// afterClaimEdited calls the registered hooks once a claim body is edited
+func (k Keeper) afterClaimEdited(ctx sdk.Context, claim Claim) sdk.Error {
+	if k.hooks == nil {
+		return nil
+	}
+	return k.hooks.AfterClaimEdited(ctx, claim)
+}
+
+// Hooks wrapper struct for the claim keeper
+type Hooks struct {
+	k Keeper
+}
+
+// Hooks returns the account hooks implemented by the claim keeper
+func (k Keeper) Hooks() Hooks { return Hooks{k} }
+
+// AfterAccountRecovered moves the claims of a recovered account to its new address,
+// so creation stake refunds and claim creator rewards are paid to the new key
+func (h Hooks) AfterAccountRecovered(ctx sdk.Context, oldAddress, newAddress sdk.AccAddress) sdk.Error {
+	h.k.migrateCreator(ctx, oldAddress, newAddress)
+	return nil
+}
+
+// AfterJailExpired is a no-op for the claim keeper
+func (h Hooks) AfterJailExpired(ctx sdk.Context, address sdk.AccAddress) sdk.Error {
+	return nil
+}
+
+// migrateCreator changes the creator of every claim created by a user
+func (k Keeper) migrateCreator(ctx sdk.Context, oldAddress, newAddress sdk.AccAddress) {
+	claims := k.CreatorClaims(ctx, oldAddress)
+	for _, claim := range claims {
+		k.deleteCreatorClaim(ctx, oldAddress, claim.ID)
+		claim.Creator = newAddress
+		k.setClaim(ctx, claim)
+		k.setCreatorClaim(ctx, newAddress, claim.ID)
+	}
+
+	logger(ctx).Info(fmt.Sprintf("Moved %d claims from %s to %s", len(claims), oldAddress, newAddress))
+}
@@ -0,0 +1,45 @@
+package claim
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterInvariants registers the claim module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(ModuleName, "community-stats", CommunityStatsInvariant(k))
+}
+
+// CommunityStatsInvariant checks the claim counts of the community stats by recounting claims
+func CommunityStatsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		counts := k.communityClaimCounts(ctx)
+
+		var msg string
+		broken := false
+		for _, community := range k.communityKeeper.Communities(ctx) {
+			stats := k.communityKeeper.Stats(ctx, community.ID)
+			if stats.ClaimCount != counts[community.ID] {
+				broken = true
+				msg += fmt.Sprintf("\tcommunity %s has %d claims, stats count %d\n",
+					community.ID, counts[community.ID], stats.ClaimCount)
+			}
+		}
+
+		return sdk.FormatInvariant(ModuleName, "community stats", msg), broken
+	}
+}
+
+// communityClaimCounts recounts the claims of every community, skipping deleted claims
+func (k Keeper) communityClaimCounts(ctx sdk.Context) map[string]uint64 {
+	counts := make(map[string]uint64)
+	for _, claim := range k.Claims(ctx) {
+		if claim.IsDeleted() {
+			continue
+		}
+		counts[claim.CommunityID]++
+	}
+
+	return counts
+}
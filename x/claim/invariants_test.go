@@ -0,0 +1,36 @@
+package claim
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCommunityStatsInvariant(t *testing.T) {
+	ctx, keeper := mockDB()
+
+	claim := fakeClaim(ctx, keeper, "crypto")
+	fakeClaim(ctx, keeper, "crypto")
+	fakeClaim(ctx, keeper, "meme")
+	assert.Equal(t, uint64(2), keeper.communityKeeper.Stats(ctx, "crypto").ClaimCount)
+
+	admin := keeper.GetParams(ctx).ClaimAdmins[0]
+	_, err := keeper.DeleteClaim(ctx, claim.ID, "spam", admin)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), keeper.communityKeeper.Stats(ctx, "crypto").ClaimCount)
+
+	invariant := CommunityStatsInvariant(keeper)
+	_, broken := invariant(ctx)
+	assert.False(t, broken)
+
+	stats := keeper.communityKeeper.Stats(ctx, "meme")
+	stats.ClaimCount++
+	keeper.communityKeeper.SetStats(ctx, stats)
+	_, broken = invariant(ctx)
+	assert.True(t, broken)
+
+	// InitGenesis rebuilds the stats, e.g. for genesis files exported without them
+	InitGenesis(ctx, keeper, ExportGenesis(ctx, keeper))
+	_, broken = invariant(ctx)
+	assert.False(t, broken)
+}
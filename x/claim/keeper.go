@@ -1,7 +1,10 @@
 package claim
 
 import (
+	"fmt"
+	"math"
 	"net/url"
+	"sort"
 	"time"
 
 	app "github.com/TruStory/truchain/types"
@@ -20,25 +23,80 @@ type Keeper struct {
 	paramStore params.Subspace
 
 	accountKeeper   AccountKeeper
+	bankKeeper      BankKeeper
 	communityKeeper community.Keeper
+	searchKeeper    SearchKeeper
+	supplyKeeper    SupplyKeeper
+	stakingKeeper   StakingKeeper
+	hooks           ClaimHooks
 }
 
 // NewKeeper creates a new claim keeper
-func NewKeeper(storeKey sdk.StoreKey, paramStore params.Subspace, codec *codec.Codec, accountKeeper AccountKeeper, communityKeeper community.Keeper) Keeper {
+func NewKeeper(storeKey sdk.StoreKey, paramStore params.Subspace, codec *codec.Codec,
+	accountKeeper AccountKeeper, bankKeeper BankKeeper, communityKeeper community.Keeper,
+	searchKeeper SearchKeeper, supplyKeeper SupplyKeeper) Keeper {
 	return Keeper{
 		storeKey,
 		codec,
 		paramStore.WithKeyTable(ParamKeyTable()),
 		accountKeeper,
+		bankKeeper,
 		communityKeeper,
+		searchKeeper,
+		supplyKeeper,
+		nil,
+		nil,
 	}
 }
 
-// SubmitClaim creates a new claim in the claim key-value store
+// SetHooks sets the claim lifecycle hooks
+func (k *Keeper) SetHooks(hooks ClaimHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set claim hooks twice")
+	}
+	k.hooks = hooks
+	return k
+}
+
+// SetStakingKeeper sets the staking keeper used to check the earned coins of users.
+// The staking keeper is created after the claim keeper, so it can't be passed to NewKeeper.
+func (k *Keeper) SetStakingKeeper(stakingKeeper StakingKeeper) *Keeper {
+	if k.stakingKeeper != nil {
+		panic("cannot set claim staking keeper twice")
+	}
+	k.stakingKeeper = stakingKeeper
+	return k
+}
+
+// SubmitClaim creates a new claim in the claim key-value store.
+// If the claim duplicates an existing claim in the same community,
+// the existing claim is returned along with ErrDuplicateClaim.
 func (k Keeper) SubmitClaim(ctx sdk.Context, body, communityID string,
-	creator sdk.AccAddress, source url.URL) (claim Claim, err sdk.Error) {
+	creator sdk.AccAddress, source url.URL, evidence ...Evidence) (claim Claim, err sdk.Error) {
+
+	return k.submitClaim(ctx, body, communityID, creator, source, false, evidence)
+}
+
+// SubmitDuplicateClaim allows admins to create a claim even if it duplicates an existing one
+func (k Keeper) SubmitDuplicateClaim(ctx sdk.Context, body, communityID string,
+	creator sdk.AccAddress, source url.URL, evidence ...Evidence) (claim Claim, err sdk.Error) {
+
+	if !k.isAdmin(ctx, creator) {
+		err = ErrAddressNotAuthorised()
+		return
+	}
+
+	return k.submitClaim(ctx, body, communityID, creator, source, true, evidence)
+}
+
+func (k Keeper) submitClaim(ctx sdk.Context, body, communityID string,
+	creator sdk.AccAddress, source url.URL, allowDuplicate bool, evidence []Evidence) (claim Claim, err sdk.Error) {
 
-	err = k.validateLength(ctx, body)
+	err = k.validateLength(ctx, communityID, body)
+	if err != nil {
+		return
+	}
+	err = k.validateEvidence(ctx, evidence)
 	if err != nil {
 		return
 	}
@@ -53,14 +111,40 @@ func (k Keeper) SubmitClaim(ctx sdk.Context, body, communityID string,
 	if err != nil {
 		return claim, ErrInvalidCommunityID(community.ID)
 	}
+	if community.IsArchived() {
+		return claim, ErrCommunityArchived(communityID)
+	}
+	if !allowDuplicate {
+		existingID, ok := k.duplicateClaimID(ctx, communityID, body, source)
+		if ok {
+			claim, _ = k.Claim(ctx, existingID)
+			return claim, ErrDuplicateClaim(existingID)
+		}
+	}
 
 	claimID, err := k.claimID(ctx)
 	if err != nil {
 		return
 	}
+	params := k.GetParams(ctx)
+	creationStake := params.ClaimCreationStake
+	if creationStake.IsPositive() {
+		_, err = k.bankKeeper.SubtractCoin(ctx, creator, creationStake, claimID,
+			TransactionClaimCreation, WithCommunityID(communityID),
+			ToModuleAccount(ClaimStakesPoolName),
+		)
+		if err != nil {
+			return
+		}
+	}
+
+	createdTime := ctx.BlockHeader().Time
+	closingTime := createdTime.Add(params.ClaimDuration)
 	claim = NewClaim(claimID, communityID, body, creator, source,
-		ctx.BlockHeader().Time,
+		createdTime, closingTime,
 	)
+	claim.CreationStake = creationStake
+	claim.Evidence = evidence
 
 	// persist claim
 	k.setClaim(ctx, claim)
@@ -71,34 +155,565 @@ func (k Keeper) SubmitClaim(ctx sdk.Context, body, communityID string,
 	k.setCommunityClaim(ctx, claim.CommunityID, claimID)
 	k.setCreatorClaim(ctx, claim.Creator, claimID)
 	k.setCreatedTimeClaim(ctx, claim.CreatedTime, claimID)
+	k.setStatusClaim(ctx, claim.Status, claimID)
+	k.insertClosingClaimQueue(ctx, claim.ClosingTime, claimID)
+	k.setSourceClaim(ctx, claim.CommunityID, claim.Source, claimID)
+	k.setBodyClaim(ctx, claim.CommunityID, claim.Body, claimID)
+	k.setActivityClaim(ctx, claim)
+	k.searchKeeper.IndexClaim(ctx, claimID, claim.Body)
+
+	stats := k.communityKeeper.Stats(ctx, claim.CommunityID)
+	stats.ClaimCount++
+	k.communityKeeper.SetStats(ctx, stats)
 
 	logger(ctx).Info("Submitted " + claim.String())
 
 	return claim, nil
 }
 
-// EditClaim allows admins to edit the body of a claim
+// EditClaim allows admins and community moderators to edit the body of a claim
 func (k Keeper) EditClaim(ctx sdk.Context, id uint64, body string, editor sdk.AccAddress) (claim Claim, err sdk.Error) {
-	if !k.isAdmin(ctx, editor) {
+	claim, ok := k.Claim(ctx, id)
+	if !ok {
+		err = ErrUnknownClaim(id)
+		return
+	}
+	if !k.isModerator(ctx, editor, claim.CommunityID) {
 		err = ErrAddressNotAuthorised()
 		return
 	}
 
-	err = k.validateLength(ctx, body)
+	err = k.validateLength(ctx, claim.CommunityID, body)
 	if err != nil {
 		return
 	}
+	if claim.IsDeleted() {
+		err = ErrClaimDeleted(id)
+		return
+	}
+
+	return k.editClaim(ctx, claim, body, editor, 0)
+}
 
+// EditClaimEvidence allows admins and community moderators to replace the evidence attached to a claim
+func (k Keeper) EditClaimEvidence(ctx sdk.Context, id uint64, evidence []Evidence, editor sdk.AccAddress) (claim Claim, err sdk.Error) {
 	claim, ok := k.Claim(ctx, id)
 	if !ok {
 		err = ErrUnknownClaim(id)
 		return
 	}
+	if !k.isModerator(ctx, editor, claim.CommunityID) {
+		err = ErrAddressNotAuthorised()
+		return
+	}
+
+	err = k.validateEvidence(ctx, evidence)
+	if err != nil {
+		return
+	}
+	if claim.IsDeleted() {
+		err = ErrClaimDeleted(id)
+		return
+	}
+
+	claim.Evidence = evidence
+	k.setClaim(ctx, claim)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeClaimEvidenceEdited,
+			sdk.NewAttribute(AttributeKeyClaimID, fmt.Sprintf("%d", claim.ID)),
+		),
+	)
+
+	return claim, nil
+}
 
+// ProposeClaimEdit lets any user propose a correction to a claim body for claim admins to review
+func (k Keeper) ProposeClaimEdit(ctx sdk.Context, claimID uint64, body string,
+	proposer sdk.AccAddress) (proposal ClaimEditProposal, err sdk.Error) {
+
+	jailed, err := k.accountKeeper.IsJailed(ctx, proposer)
+	if err != nil {
+		return
+	}
+	if jailed {
+		return proposal, ErrCreatorJailed(proposer)
+	}
+	claim, ok := k.Claim(ctx, claimID)
+	if !ok {
+		return proposal, ErrUnknownClaim(claimID)
+	}
+	if claim.IsDeleted() {
+		return proposal, ErrClaimDeleted(claimID)
+	}
+	err = k.validateLength(ctx, claim.CommunityID, body)
+	if err != nil {
+		return
+	}
+	// a proposer can only have one proposal awaiting review per claim
+	for _, pending := range k.ClaimEditProposals(ctx, claimID) {
+		if pending.Status == EditProposalPending && pending.Proposer.Equals(proposer) {
+			return proposal, ErrEditProposalAlreadyPending(claimID)
+		}
+	}
+
+	proposalID := k.editProposalID(ctx)
+	proposal = ClaimEditProposal{
+		ID:           proposalID,
+		ClaimID:      claimID,
+		Body:         body,
+		BaseRevision: claim.Revisions,
+		Proposer:     proposer,
+		Status:       EditProposalPending,
+		CreatedTime:  ctx.BlockHeader().Time,
+	}
+	k.setEditProposal(ctx, proposal)
+	k.setEditProposalID(ctx, proposalID+1)
+	k.setClaimEditProposal(ctx, claimID, proposalID)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeClaimEditProposed,
+			sdk.NewAttribute(AttributeKeyClaimID, fmt.Sprintf("%d", claimID)),
+			sdk.NewAttribute(AttributeKeyEditProposalID, fmt.Sprintf("%d", proposalID)),
+		),
+	)
+
+	return proposal, nil
+}
+
+// ReviewClaimEdit allows admins and community moderators to approve or reject a pending claim edit proposal.
+// Approved proposals are applied to the claim as a new revision. Proposals made before the
+// latest revision can only be rejected.
+func (k Keeper) ReviewClaimEdit(ctx sdk.Context, proposalID uint64, approve bool,
+	admin sdk.AccAddress) (proposal ClaimEditProposal, err sdk.Error) {
+
+	proposal, ok := k.EditProposal(ctx, proposalID)
+	if !ok {
+		return proposal, ErrUnknownEditProposal(proposalID)
+	}
+	claim, ok := k.Claim(ctx, proposal.ClaimID)
+	if !ok {
+		return proposal, ErrUnknownClaim(proposal.ClaimID)
+	}
+	if !k.isModerator(ctx, admin, claim.CommunityID) {
+		return proposal, ErrAddressNotAuthorised()
+	}
+	if proposal.Status != EditProposalPending {
+		return proposal, ErrEditProposalNotPending(proposalID)
+	}
+
+	proposal.Reviewer = admin
+	proposal.ReviewedTime = ctx.BlockHeader().Time
+	if !approve {
+		proposal.Status = EditProposalRejected
+		k.setEditProposal(ctx, proposal)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				EventTypeClaimEditRejected,
+				sdk.NewAttribute(AttributeKeyClaimID, fmt.Sprintf("%d", proposal.ClaimID)),
+				sdk.NewAttribute(AttributeKeyEditProposalID, fmt.Sprintf("%d", proposalID)),
+			),
+		)
+
+		return proposal, nil
+	}
+
+	if claim.IsDeleted() {
+		return proposal, ErrClaimDeleted(proposal.ClaimID)
+	}
+	// the claim was edited after the proposal was made, so approving it would overwrite that edit
+	if claim.Revisions != proposal.BaseRevision {
+		return proposal, ErrEditProposalStale(proposalID)
+	}
+	proposal.Status = EditProposalApproved
+	k.setEditProposal(ctx, proposal)
+	_, err = k.editClaim(ctx, claim, proposal.Body, proposal.Proposer, proposal.ID)
+	if err != nil {
+		return
+	}
+
+	return proposal, nil
+}
+
+// editClaim replaces the body of a claim and records the edit as a new revision
+func (k Keeper) editClaim(ctx sdk.Context, claim Claim, body string,
+	editor sdk.AccAddress, proposalID uint64) (Claim, sdk.Error) {
+
+	revision := ClaimRevision{
+		ClaimID:      claim.ID,
+		Revision:     claim.Revisions + 1,
+		Body:         body,
+		PreviousBody: claim.Body,
+		Editor:       editor,
+		ProposalID:   proposalID,
+		EditedTime:   ctx.BlockHeader().Time,
+	}
+
+	k.deleteBodyClaim(ctx, claim.CommunityID, claim.Body, claim.ID)
 	claim.Body = body
+	claim.Revisions = revision.Revision
 	k.setClaim(ctx, claim)
+	k.setBodyClaim(ctx, claim.CommunityID, claim.Body, claim.ID)
+	k.setClaimRevision(ctx, revision)
+	if !claim.Hidden {
+		k.searchKeeper.IndexClaim(ctx, claim.ID, claim.Body)
+	}
 
-	return
+	err := k.afterClaimEdited(ctx, claim)
+	if err != nil {
+		return claim, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeClaimEdited,
+			sdk.NewAttribute(AttributeKeyClaimID, fmt.Sprintf("%d", claim.ID)),
+			sdk.NewAttribute(AttributeKeyRevision, fmt.Sprintf("%d", revision.Revision)),
+		),
+	)
+
+	return claim, nil
+}
+
+// ReopenClaim allows admins to reopen a closed or archived claim.
+// The claim stays open for another claim duration from the current block time.
+func (k Keeper) ReopenClaim(ctx sdk.Context, id uint64, admin sdk.AccAddress) (claim Claim, err sdk.Error) {
+	if !k.isAdmin(ctx, admin) {
+		err = ErrAddressNotAuthorised()
+		return
+	}
+
+	claim, ok := k.Claim(ctx, id)
+	if !ok {
+		err = ErrUnknownClaim(id)
+		return
+	}
+	if claim.IsDeleted() {
+		err = ErrClaimDeleted(id)
+		return
+	}
+	if claim.IsOpen() {
+		err = ErrClaimNotClosed(id)
+		return
+	}
+
+	if claim.Status == StatusClosed {
+		k.removeFromArchivingClaimQueue(ctx, claim.ArchiveTime, id)
+	}
+	k.deleteStatusClaim(ctx, claim.Status, id)
+
+	claim.Status = StatusOpen
+	claim.ClosingTime = ctx.BlockHeader().Time.Add(k.GetParams(ctx).ClaimDuration)
+	claim.ArchiveTime = time.Time{}
+	claim.Result = nil
+	k.setClaim(ctx, claim)
+	k.setStatusClaim(ctx, claim.Status, id)
+	k.insertClosingClaimQueue(ctx, claim.ClosingTime, id)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeClaimReopened,
+			sdk.NewAttribute(AttributeKeyClaimID, fmt.Sprintf("%d", id)),
+		),
+	)
+
+	logger(ctx).Info(fmt.Sprintf("Reopened claim %d", id))
+
+	return claim, nil
+}
+
+// DeleteClaim allows admins and community moderators to delete a claim. The claim is kept as a tombstone,
+// removed from the community, creator and created time indexes, and its
+// creation stake is refunded. Hooks refund the stakes on its arguments.
+func (k Keeper) DeleteClaim(ctx sdk.Context, id uint64, reason string, admin sdk.AccAddress) (claim Claim, err sdk.Error) {
+	claim, ok := k.Claim(ctx, id)
+	if !ok {
+		err = ErrUnknownClaim(id)
+		return
+	}
+	if !k.isModerator(ctx, admin, claim.CommunityID) {
+		err = ErrAddressNotAuthorised()
+		return
+	}
+	if claim.IsDeleted() {
+		err = ErrClaimDeleted(id)
+		return
+	}
+
+	switch claim.Status {
+	case StatusOpen:
+		k.removeFromClosingClaimQueue(ctx, claim.ClosingTime, id)
+	case StatusClosed:
+		k.removeFromArchivingClaimQueue(ctx, claim.ArchiveTime, id)
+	}
+	k.deleteStatusClaim(ctx, claim.Status, id)
+	k.deleteCommunityClaim(ctx, claim.CommunityID, id)
+	for _, tag := range claim.Tags {
+		k.deleteTagClaim(ctx, tag, id)
+	}
+	k.deleteCreatorClaim(ctx, claim.Creator, id)
+	k.deleteCreatedTimeClaim(ctx, claim.CreatedTime, id)
+	k.deleteSourceClaim(ctx, claim.CommunityID, claim.Source, id)
+	k.deleteBodyClaim(ctx, claim.CommunityID, claim.Body, id)
+	k.deleteActivityClaim(ctx, claim)
+	if claim.FlagCount > 0 {
+		k.removeFromModerationQueue(ctx, claim.FlagCount, id)
+	}
+	k.searchKeeper.RemoveClaim(ctx, id)
+
+	claim.Status = StatusDeleted
+	claim.DeletedTime = ctx.BlockHeader().Time
+	claim.DeletionReason = reason
+	k.setClaim(ctx, claim)
+	k.setStatusClaim(ctx, claim.Status, id)
+
+	stats := k.communityKeeper.Stats(ctx, claim.CommunityID)
+	stats.ClaimCount--
+	k.communityKeeper.SetStats(ctx, stats)
+
+	// deleted claims are refunded in full
+	claim, err = k.refundCreationStake(ctx, claim, sdk.ZeroDec())
+	if err != nil {
+		return
+	}
+	err = k.afterClaimDeleted(ctx, claim)
+	if err != nil {
+		return
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeClaimDeleted,
+			sdk.NewAttribute(AttributeKeyClaimID, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(AttributeKeyReason, reason),
+		),
+	)
+
+	logger(ctx).Info(fmt.Sprintf("Deleted claim %d: %s", id, reason))
+
+	return claim, nil
+}
+
+// AddClaimTag allows admins to tag a claim with a secondary community
+func (k Keeper) AddClaimTag(ctx sdk.Context, id uint64, communityID string, admin sdk.AccAddress) (claim Claim, err sdk.Error) {
+	if !k.isAdmin(ctx, admin) {
+		err = ErrAddressNotAuthorised()
+		return
+	}
+	claim, ok := k.Claim(ctx, id)
+	if !ok {
+		err = ErrUnknownClaim(id)
+		return
+	}
+	if claim.IsDeleted() {
+		err = ErrClaimDeleted(id)
+		return
+	}
+	community, err := k.communityKeeper.Community(ctx, communityID)
+	if err != nil {
+		return claim, ErrInvalidCommunityID(communityID)
+	}
+	if community.IsArchived() {
+		return claim, ErrCommunityArchived(communityID)
+	}
+	if claim.HasCommunity(communityID) {
+		return claim, ErrClaimTagExists(id, communityID)
+	}
+	maxTags := k.GetParams(ctx).MaxCommunityTags
+	if len(claim.Tags) >= maxTags {
+		return claim, ErrTooManyClaimTags(maxTags)
+	}
+
+	claim.Tags = append(claim.Tags, communityID)
+	k.setClaim(ctx, claim)
+	k.setTagClaim(ctx, communityID, id)
+
+	return claim, nil
+}
+
+// RemoveClaimTag allows admins to remove a secondary community from a claim
+func (k Keeper) RemoveClaimTag(ctx sdk.Context, id uint64, communityID string, admin sdk.AccAddress) (claim Claim, err sdk.Error) {
+	if !k.isAdmin(ctx, admin) {
+		err = ErrAddressNotAuthorised()
+		return
+	}
+	claim, ok := k.Claim(ctx, id)
+	if !ok {
+		err = ErrUnknownClaim(id)
+		return
+	}
+	if claim.IsDeleted() {
+		err = ErrClaimDeleted(id)
+		return
+	}
+
+	tags := make([]string, 0, len(claim.Tags))
+	for _, tag := range claim.Tags {
+		if tag != communityID {
+			tags = append(tags, tag)
+		}
+	}
+	if len(tags) == len(claim.Tags) {
+		return claim, ErrClaimTagNotFound(id, communityID)
+	}
+
+	claim.Tags = tags
+	k.setClaim(ctx, claim)
+	k.deleteTagClaim(ctx, communityID, id)
+
+	return claim, nil
+}
+
+// FlagClaim lets users with enough earned stake report a bad claim.
+// The claim is hidden once it reaches the flag threshold.
+func (k Keeper) FlagClaim(ctx sdk.Context, id uint64, reason FlagReason, creator sdk.AccAddress) (claim Claim, err sdk.Error) {
+	jailed, err := k.accountKeeper.IsJailed(ctx, creator)
+	if err != nil {
+		return
+	}
+	if jailed {
+		return claim, ErrCreatorJailed(creator)
+	}
+	claim, ok := k.Claim(ctx, id)
+	if !ok {
+		return claim, ErrUnknownClaim(id)
+	}
+	if claim.IsDeleted() {
+		return claim, ErrClaimDeleted(id)
+	}
+	if claim.Hidden {
+		return claim, ErrClaimHidden(id)
+	}
+	if k.store(ctx).Has(claimFlagKey(id, creator)) {
+		return claim, ErrAlreadyFlagged(id)
+	}
+	params := k.GetParams(ctx)
+	if !k.isAdmin(ctx, creator) && k.stakingKeeper.TotalEarnedCoins(ctx, creator).LT(params.FlagMinEarnedStake.Amount) {
+		return claim, ErrNotEnoughEarnedStake(creator)
+	}
+
+	flag := ClaimFlag{
+		ClaimID:     id,
+		Reason:      reason,
+		Creator:     creator,
+		CreatedTime: ctx.BlockHeader().Time,
+	}
+	k.setClaimFlag(ctx, flag)
+
+	if claim.FlagCount > 0 {
+		k.removeFromModerationQueue(ctx, claim.FlagCount, id)
+	}
+	claim.FlagCount++
+	k.insertModerationQueue(ctx, claim.FlagCount, id)
+
+	hidden := claim.FlagCount >= uint64(params.FlagHideThreshold)
+	if hidden {
+		claim.Hidden = true
+		k.searchKeeper.RemoveClaim(ctx, id)
+	}
+	k.setClaim(ctx, claim)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeClaimFlagged,
+			sdk.NewAttribute(AttributeKeyClaimID, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(AttributeKeyFlagReason, reason.String()),
+			sdk.NewAttribute(AttributeKeyFlagCount, fmt.Sprintf("%d", claim.FlagCount)),
+		),
+	)
+	if hidden {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				EventTypeClaimHidden,
+				sdk.NewAttribute(AttributeKeyClaimID, fmt.Sprintf("%d", id)),
+			),
+		)
+	}
+
+	return claim, nil
+}
+
+// ResolveClaimFlags allows admins and community moderators to uphold the flags on a claim.
+// The claim is hidden and leaves the moderation queue, its flags are kept.
+func (k Keeper) ResolveClaimFlags(ctx sdk.Context, id uint64, admin sdk.AccAddress) (claim Claim, err sdk.Error) {
+	claim, err = k.flaggedClaim(ctx, id, admin)
+	if err != nil {
+		return
+	}
+
+	k.removeFromModerationQueue(ctx, claim.FlagCount, id)
+	claim.FlagCount = 0
+	claim.Hidden = true
+	k.setClaim(ctx, claim)
+	k.searchKeeper.RemoveClaim(ctx, id)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeClaimFlagsResolved,
+			sdk.NewAttribute(AttributeKeyClaimID, fmt.Sprintf("%d", id)),
+		),
+	)
+
+	return claim, nil
+}
+
+// DismissClaimFlags allows admins and community moderators to reject the flags on a claim.
+// The claim is shown again and its flags are cleared so it can be flagged again.
+func (k Keeper) DismissClaimFlags(ctx sdk.Context, id uint64, admin sdk.AccAddress) (claim Claim, err sdk.Error) {
+	claim, err = k.flaggedClaim(ctx, id, admin)
+	if err != nil {
+		return
+	}
+
+	k.removeFromModerationQueue(ctx, claim.FlagCount, id)
+	for _, flag := range k.ClaimFlags(ctx, id) {
+		k.store(ctx).Delete(claimFlagKey(id, flag.Creator))
+	}
+	claim.FlagCount = 0
+	claim.Hidden = false
+	k.setClaim(ctx, claim)
+	k.searchKeeper.IndexClaim(ctx, id, claim.Body)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeClaimFlagsDismissed,
+			sdk.NewAttribute(AttributeKeyClaimID, fmt.Sprintf("%d", id)),
+		),
+	)
+
+	return claim, nil
+}
+
+// flaggedClaim gets a claim with flags awaiting moderation
+func (k Keeper) flaggedClaim(ctx sdk.Context, id uint64, admin sdk.AccAddress) (claim Claim, err sdk.Error) {
+	claim, ok := k.Claim(ctx, id)
+	if !ok {
+		return claim, ErrUnknownClaim(id)
+	}
+	if !k.isModerator(ctx, admin, claim.CommunityID) {
+		return claim, ErrAddressNotAuthorised()
+	}
+	if claim.IsDeleted() {
+		return claim, ErrClaimDeleted(id)
+	}
+	if claim.FlagCount == 0 {
+		return claim, ErrClaimNotFlagged(id)
+	}
+
+	return claim, nil
+}
+
+// ClaimFlags gets all the flags on a claim
+func (k Keeper) ClaimFlags(ctx sdk.Context, id uint64) []ClaimFlag {
+	return k.claimFlags(ctx, claimFlagsKey(id))
+}
+
+// ModerationQueue gets the claims with flags awaiting moderation, most flagged first
+func (k Keeper) ModerationQueue(ctx sdk.Context) (claims Claims) {
+	return k.associatedClaims(ctx, ModerationQueuePrefix)
 }
 
 // Claim gets a single claim by its ID
@@ -113,19 +728,36 @@ func (k Keeper) Claim(ctx sdk.Context, id uint64) (claim Claim, ok bool) {
 	return claim, true
 }
 
-// Claims gets all the claims in reverse order
+// Claims gets all the claims in reverse order, excluding deleted claims
 func (k Keeper) Claims(ctx sdk.Context) (claims Claims) {
+	for _, claim := range k.claimsWithTombstones(ctx) {
+		if !claim.IsDeleted() {
+			claims = append(claims, claim)
+		}
+	}
+
+	return
+}
+
+// claimsWithTombstones gets all the claims in reverse order, including deleted claims
+func (k Keeper) claimsWithTombstones(ctx sdk.Context) (claims Claims) {
 	store := k.store(ctx)
 	iterator := sdk.KVStoreReversePrefixIterator(store, ClaimsKeyPrefix)
 
 	return k.iterate(iterator)
 }
 
-// ClaimsBetweenIDs gets all claims between startClaimID to endClaimID
+// ClaimsBetweenIDs gets all claims between startClaimID to endClaimID, excluding deleted claims
 func (k Keeper) ClaimsBetweenIDs(ctx sdk.Context, startClaimID, endClaimID uint64) (claims Claims) {
 	iterator := k.claimsIterator(ctx, startClaimID, endClaimID)
 
-	return k.iterate(iterator)
+	for _, claim := range k.iterate(iterator) {
+		if !claim.IsDeleted() {
+			claims = append(claims, claim)
+		}
+	}
+
+	return
 }
 
 // ClaimsBetweenTimes gets all claims between startTime and endTime
@@ -149,9 +781,164 @@ func (k Keeper) ClaimsAfterTime(ctx sdk.Context, createdTime time.Time) (claims
 	return k.iterateAssociated(ctx, iterator)
 }
 
-// CommunityClaims gets all the claims for a given community
+// ClaimRevisions gets all the revisions of a claim, oldest first
+func (k Keeper) ClaimRevisions(ctx sdk.Context, claimID uint64) (revisions []ClaimRevision) {
+	return k.iterateRevisions(ctx, claimRevisionsKey(claimID))
+}
+
+// EditProposal gets a single claim edit proposal by its ID
+func (k Keeper) EditProposal(ctx sdk.Context, id uint64) (proposal ClaimEditProposal, ok bool) {
+	bz := k.store(ctx).Get(editProposalKey(id))
+	if bz == nil {
+		return proposal, false
+	}
+	k.codec.MustUnmarshalBinaryLengthPrefixed(bz, &proposal)
+
+	return proposal, true
+}
+
+// ClaimEditProposals gets all the edit proposals for a claim, oldest first
+func (k Keeper) ClaimEditProposals(ctx sdk.Context, claimID uint64) (proposals []ClaimEditProposal) {
+	iterator := sdk.KVStorePrefixIterator(k.store(ctx), claimEditProposalsKey(claimID))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var proposalID uint64
+		k.codec.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &proposalID)
+		proposal, ok := k.EditProposal(ctx, proposalID)
+		if ok {
+			proposals = append(proposals, proposal)
+		}
+	}
+
+	return
+}
+
+// CommunityClaims gets all the claims for a given community, including claims tagged with it
 func (k Keeper) CommunityClaims(ctx sdk.Context, communityID string) (claims Claims) {
-	return k.associatedClaims(ctx, communityClaimsKey(communityID))
+	claims = k.associatedClaims(ctx, communityClaimsKey(communityID))
+	tagged := k.associatedClaims(ctx, tagClaimsKey(communityID))
+	if len(tagged) == 0 {
+		return claims
+	}
+	claims = append(claims, tagged...)
+	// keep the reverse ID order of the primary index
+	sort.Slice(claims, func(i, j int) bool { return claims[i].ID > claims[j].ID })
+
+	return claims
+}
+
+// CommunityTreeClaims gets all the claims for a community and every community nested under it
+func (k Keeper) CommunityTreeClaims(ctx sdk.Context, communityID string) (claims Claims) {
+	claims = k.CommunityClaims(ctx, communityID)
+	descendants := k.communityKeeper.Descendants(ctx, communityID)
+	if len(descendants) == 0 {
+		return claims
+	}
+	// a claim tagged with several communities in the tree is only returned once
+	seen := make(map[uint64]bool)
+	for _, claim := range claims {
+		seen[claim.ID] = true
+	}
+	for _, descendant := range descendants {
+		for _, claim := range k.CommunityClaims(ctx, descendant) {
+			if seen[claim.ID] {
+				continue
+			}
+			seen[claim.ID] = true
+			claims = append(claims, claim)
+		}
+	}
+	sort.Slice(claims, func(i, j int) bool { return claims[i].ID > claims[j].ID })
+
+	return claims
+}
+
+// ClaimsByStatus gets all the claims with a given status
+func (k Keeper) ClaimsByStatus(ctx sdk.Context, status Status) (claims Claims) {
+	return k.associatedClaims(ctx, statusClaimsKey(status))
+}
+
+// ClaimsSorted gets a page of visible claims ordered by the sort key, highest first.
+// An empty communityID includes every community, otherwise claims tagged with the community are included.
+// A limit of 0 returns every claim.
+func (k Keeper) ClaimsSorted(ctx sdk.Context, sortKey SortKey, communityID string, page, limit int) (claims Claims) {
+	if page < 1 {
+		page = 1
+	}
+	claims = make(Claims, 0)
+	// bound the page before multiplying so a huge page can't overflow
+	if limit < 0 || (limit > 0 && page-1 > math.MaxInt64/limit) {
+		return claims
+	}
+	skip := (page - 1) * limit
+
+	iterator := sdk.KVStoreReversePrefixIterator(k.store(ctx), sortedClaimsPrefix(sortKey))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if limit > 0 && len(claims) == limit {
+			break
+		}
+		var claimID uint64
+		k.codec.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &claimID)
+		claim, ok := k.Claim(ctx, claimID)
+		if !ok {
+			continue
+		}
+		if claim.Hidden || (communityID != "" && !claim.HasCommunity(communityID)) {
+			continue
+		}
+		if skip > 0 {
+			skip--
+			continue
+		}
+		claims = append(claims, claim)
+	}
+
+	return claims
+}
+
+// FollowedClaims gets a page of visible claims from the communities a user follows, newest first.
+// A limit of 0 returns every claim.
+func (k Keeper) FollowedClaims(ctx sdk.Context, follower sdk.AccAddress, page, limit int) Claims {
+	if page < 1 {
+		page = 1
+	}
+
+	seen := make(map[uint64]bool)
+	feed := make(Claims, 0)
+	for _, communityID := range k.communityKeeper.FollowedCommunities(ctx, follower) {
+		for _, claim := range k.CommunityClaims(ctx, communityID) {
+			if claim.Hidden || seen[claim.ID] {
+				continue
+			}
+			seen[claim.ID] = true
+			feed = append(feed, claim)
+		}
+	}
+	sort.Slice(feed, func(i, j int) bool {
+		if feed[i].CreatedTime.Equal(feed[j].CreatedTime) {
+			return feed[i].ID > feed[j].ID
+		}
+		return feed[i].CreatedTime.After(feed[j].CreatedTime)
+	})
+	if limit == 0 {
+		return feed
+	}
+	// bound the page before multiplying so a huge page can't overflow
+	if limit < 0 || page-1 > len(feed)/limit {
+		return make(Claims, 0)
+	}
+
+	skip := (page - 1) * limit
+	if skip >= len(feed) {
+		return make(Claims, 0)
+	}
+	end := skip + limit
+	if end > len(feed) {
+		end = len(feed)
+	}
+
+	return feed[skip:end]
 }
 
 // CreatorClaims gets all the claims for a given creator
@@ -165,9 +952,11 @@ func (k Keeper) AddBackingStake(ctx sdk.Context, id uint64, stake sdk.Coin) sdk.
 	if !ok {
 		return ErrUnknownClaim(id)
 	}
+	k.deleteActivityClaim(ctx, claim)
 	claim.TotalBacked = claim.TotalBacked.Add(stake)
 	claim.TotalStakers++
 	k.setClaim(ctx, claim)
+	k.setActivityClaim(ctx, claim)
 
 	return nil
 }
@@ -178,9 +967,11 @@ func (k Keeper) AddChallengeStake(ctx sdk.Context, id uint64, stake sdk.Coin) sd
 	if !ok {
 		return ErrUnknownClaim(id)
 	}
+	k.deleteActivityClaim(ctx, claim)
 	claim.TotalChallenged = claim.TotalChallenged.Add(stake)
 	claim.TotalStakers++
 	k.setClaim(ctx, claim)
+	k.setActivityClaim(ctx, claim)
 
 	return nil
 }
@@ -191,8 +982,10 @@ func (k Keeper) SubtractBackingStake(ctx sdk.Context, id uint64, stake sdk.Coin)
 	if !ok {
 		return ErrUnknownClaim(id)
 	}
+	k.deleteActivityClaim(ctx, claim)
 	claim.TotalBacked = claim.TotalBacked.Sub(stake)
 	k.setClaim(ctx, claim)
+	k.setActivityClaim(ctx, claim)
 
 	return nil
 }
@@ -203,8 +996,10 @@ func (k Keeper) SubtractChallengeStake(ctx sdk.Context, id uint64, stake sdk.Coi
 	if !ok {
 		return ErrUnknownClaim(id)
 	}
+	k.deleteActivityClaim(ctx, claim)
 	claim.TotalChallenged = claim.TotalChallenged.Sub(stake)
 	k.setClaim(ctx, claim)
+	k.setActivityClaim(ctx, claim)
 
 	return nil
 }
@@ -215,8 +1010,10 @@ func (k Keeper) SetFirstArgumentTime(ctx sdk.Context, id uint64, firstArgumentTi
 	if !ok {
 		return ErrUnknownClaim(id)
 	}
+	k.deleteActivityClaim(ctx, claim)
 	claim.FirstArgumentTime = firstArgumentTime
 	k.setClaim(ctx, claim)
+	k.setActivityClaim(ctx, claim)
 
 	return nil
 }
@@ -262,6 +1059,89 @@ func (k Keeper) RemoveAdmin(ctx sdk.Context, admin, remover sdk.AccAddress) (err
 	return
 }
 
+// closeClaim moves an open claim to the closed state and queues it for archiving
+func (k Keeper) closeClaim(ctx sdk.Context, claim Claim) Claim {
+	k.deleteStatusClaim(ctx, claim.Status, claim.ID)
+	claim.Status = StatusClosed
+	claim.ArchiveTime = claim.ClosingTime.Add(k.GetParams(ctx).ArchiveDuration)
+	k.setClaim(ctx, claim)
+	k.setStatusClaim(ctx, claim.Status, claim.ID)
+	k.insertArchivingClaimQueue(ctx, claim.ArchiveTime, claim.ID)
+
+	return claim
+}
+
+// refundCreationStake returns the claim creation stake to the claim creator. The feeShare
+// of the creation stake is kept as a claim fee and deposited to the treasury of the claim community.
+func (k Keeper) refundCreationStake(ctx sdk.Context, claim Claim, feeShare sdk.Dec) (Claim, sdk.Error) {
+	if !claim.CreationStake.IsPositive() {
+		return claim, nil
+	}
+	fee := sdk.NewCoin(claim.CreationStake.Denom, claim.CreationStake.Amount.ToDec().Mul(feeShare).TruncateInt())
+	refund := claim.CreationStake.Sub(fee)
+	if refund.IsPositive() {
+		_, err := k.bankKeeper.AddCoin(ctx, claim.Creator, refund, claim.ID,
+			TransactionClaimCreationReturned, WithCommunityID(claim.CommunityID),
+			FromModuleAccount(ClaimStakesPoolName),
+		)
+		if err != nil {
+			return claim, err
+		}
+	}
+	if fee.IsPositive() {
+		err := k.communityKeeper.DepositToTreasury(ctx, claim.CommunityID, fee,
+			claim.Creator, claim.ID, ClaimStakesPoolName)
+		if err != nil {
+			return claim, err
+		}
+	}
+	// a refunded stake is zeroed so that reopened claims don't refund twice
+	claim.CreationStake = sdk.NewCoin(claim.CreationStake.Denom, sdk.ZeroInt())
+	k.setClaim(ctx, claim)
+
+	return claim, nil
+}
+
+// resolveClaim decides the verdict of a closed claim from its stake totals
+func (k Keeper) resolveClaim(ctx sdk.Context, claim Claim) Claim {
+	threshold := k.GetParams(ctx).VerdictThreshold
+	result := ClaimResult{
+		ClaimID:         claim.ID,
+		Verdict:         VerdictMajorityNotReached,
+		TotalBacked:     claim.TotalBacked,
+		TotalChallenged: claim.TotalChallenged,
+		Threshold:       threshold,
+		ResolvedTime:    ctx.BlockHeader().Time,
+	}
+
+	total := claim.TotalBacked.Amount.Add(claim.TotalChallenged.Amount)
+	if total.IsPositive() {
+		backedShare := claim.TotalBacked.Amount.ToDec().Quo(total.ToDec())
+		challengedShare := claim.TotalChallenged.Amount.ToDec().Quo(total.ToDec())
+		switch {
+		case backedShare.GTE(threshold):
+			result.Verdict = VerdictBackersWin
+		case challengedShare.GTE(threshold):
+			result.Verdict = VerdictChallengersWin
+		}
+	}
+
+	claim.Result = &result
+	k.setClaim(ctx, claim)
+
+	return claim
+}
+
+// archiveClaim moves a closed claim to the archived state
+func (k Keeper) archiveClaim(ctx sdk.Context, claim Claim) Claim {
+	k.deleteStatusClaim(ctx, claim.Status, claim.ID)
+	claim.Status = StatusArchived
+	k.setClaim(ctx, claim)
+	k.setStatusClaim(ctx, claim.Status, claim.ID)
+
+	return claim
+}
+
 func (k Keeper) isAdmin(ctx sdk.Context, address sdk.AccAddress) bool {
 	for _, admin := range k.GetParams(ctx).ClaimAdmins {
 		if address.Equals(admin) {
@@ -271,24 +1151,35 @@ func (k Keeper) isAdmin(ctx sdk.Context, address sdk.AccAddress) bool {
 	return false
 }
 
-func (k Keeper) validateLength(ctx sdk.Context, body string) sdk.Error {
-	var minClaimLength int
-	var maxClaimLength int
+// isModerator returns true for claim admins and the moderators of the community
+func (k Keeper) isModerator(ctx sdk.Context, address sdk.AccAddress, communityID string) bool {
+	return k.isAdmin(ctx, address) || k.communityKeeper.IsModerator(ctx, communityID, address)
+}
 
-	k.paramStore.Get(ctx, KeyMinClaimLength, &minClaimLength)
-	k.paramStore.Get(ctx, KeyMaxClaimLength, &maxClaimLength)
+func (k Keeper) validateLength(ctx sdk.Context, communityID, body string) sdk.Error {
+	params := k.EffectiveParams(ctx, communityID)
 
 	len := len([]rune(body))
-	if len < minClaimLength {
+	if len < params.MinClaimLength {
 		return ErrInvalidBodyTooShort(body)
 	}
-	if len > maxClaimLength {
+	if len > params.MaxClaimLength {
 		return ErrInvalidBodyTooLong()
 	}
 
 	return nil
 }
 
+func (k Keeper) validateEvidence(ctx sdk.Context, evidence []Evidence) sdk.Error {
+	params := k.GetParams(ctx)
+	err := ValidateEvidence(evidence, params.MaxEvidenceCount, params.MaxEvidenceURILength)
+	if err != nil {
+		return ErrInvalidEvidence(err)
+	}
+
+	return nil
+}
+
 // claimID gets the highest claim ID
 func (k Keeper) claimID(ctx sdk.Context) (claimID uint64, err sdk.Error) {
 	store := k.store(ctx)
@@ -314,6 +1205,61 @@ func (k Keeper) setClaim(ctx sdk.Context, claim Claim) {
 	store.Set(key(claim.ID), bz)
 }
 
+// editProposalID gets the next claim edit proposal ID
+func (k Keeper) editProposalID(ctx sdk.Context) (proposalID uint64) {
+	bz := k.store(ctx).Get(EditProposalIDKey)
+	if bz == nil {
+		return 1
+	}
+	k.codec.MustUnmarshalBinaryLengthPrefixed(bz, &proposalID)
+	return proposalID
+}
+
+func (k Keeper) setEditProposalID(ctx sdk.Context, proposalID uint64) {
+	bz := k.codec.MustMarshalBinaryLengthPrefixed(proposalID)
+	k.store(ctx).Set(EditProposalIDKey, bz)
+}
+
+func (k Keeper) setEditProposal(ctx sdk.Context, proposal ClaimEditProposal) {
+	bz := k.codec.MustMarshalBinaryLengthPrefixed(proposal)
+	k.store(ctx).Set(editProposalKey(proposal.ID), bz)
+}
+
+func (k Keeper) setClaimEditProposal(ctx sdk.Context, claimID, proposalID uint64) {
+	bz := k.codec.MustMarshalBinaryLengthPrefixed(proposalID)
+	k.store(ctx).Set(claimEditProposalKey(claimID, proposalID), bz)
+}
+
+func (k Keeper) setClaimRevision(ctx sdk.Context, revision ClaimRevision) {
+	bz := k.codec.MustMarshalBinaryLengthPrefixed(revision)
+	k.store(ctx).Set(claimRevisionKey(revision.ClaimID, revision.Revision), bz)
+}
+
+// editProposals gets all the claim edit proposals
+func (k Keeper) editProposals(ctx sdk.Context) (proposals []ClaimEditProposal) {
+	iterator := sdk.KVStorePrefixIterator(k.store(ctx), EditProposalsPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var proposal ClaimEditProposal
+		k.codec.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &proposal)
+		proposals = append(proposals, proposal)
+	}
+
+	return
+}
+
+func (k Keeper) iterateRevisions(ctx sdk.Context, prefix []byte) (revisions []ClaimRevision) {
+	iterator := sdk.KVStorePrefixIterator(k.store(ctx), prefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var revision ClaimRevision
+		k.codec.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &revision)
+		revisions = append(revisions, revision)
+	}
+
+	return
+}
+
 // setCommunityClaim sets a community <-> claim association in store
 func (k Keeper) setCommunityClaim(ctx sdk.Context, communityID string, claimID uint64) {
 	store := k.store(ctx)
@@ -333,6 +1279,198 @@ func (k Keeper) setCreatedTimeClaim(ctx sdk.Context, createdTime time.Time, clai
 	store.Set(createdTimeClaimKey(createdTime, claimID), bz)
 }
 
+func (k Keeper) setTagClaim(ctx sdk.Context, communityID string, claimID uint64) {
+	bz := k.codec.MustMarshalBinaryLengthPrefixed(claimID)
+	k.store(ctx).Set(tagClaimKey(communityID, claimID), bz)
+}
+
+func (k Keeper) deleteTagClaim(ctx sdk.Context, communityID string, claimID uint64) {
+	k.store(ctx).Delete(tagClaimKey(communityID, claimID))
+}
+
+func (k Keeper) deleteCommunityClaim(ctx sdk.Context, communityID string, claimID uint64) {
+	k.store(ctx).Delete(communityClaimKey(communityID, claimID))
+}
+
+func (k Keeper) deleteCreatorClaim(ctx sdk.Context, creator sdk.AccAddress, claimID uint64) {
+	k.store(ctx).Delete(creatorClaimKey(creator, claimID))
+}
+
+func (k Keeper) deleteCreatedTimeClaim(ctx sdk.Context, createdTime time.Time, claimID uint64) {
+	k.store(ctx).Delete(createdTimeClaimKey(createdTime, claimID))
+}
+
+// duplicateClaimID returns the ID of an existing claim in the community with the same
+// normalized source or body
+func (k Keeper) duplicateClaimID(ctx sdk.Context, communityID, body string, source url.URL) (uint64, bool) {
+	store := k.store(ctx)
+	var claimID uint64
+	if hash := sourceHash(source); hash != nil {
+		bz := store.Get(sourceClaimKey(communityID, hash))
+		if bz != nil {
+			k.codec.MustUnmarshalBinaryLengthPrefixed(bz, &claimID)
+			return claimID, true
+		}
+	}
+	bz := store.Get(bodyClaimKey(communityID, bodyHash(body)))
+	if bz != nil {
+		k.codec.MustUnmarshalBinaryLengthPrefixed(bz, &claimID)
+		return claimID, true
+	}
+
+	return 0, false
+}
+
+// setSourceClaim indexes the claim by its normalized source, keeping the first claim on duplicates
+func (k Keeper) setSourceClaim(ctx sdk.Context, communityID string, source url.URL, claimID uint64) {
+	hash := sourceHash(source)
+	if hash == nil {
+		return
+	}
+	store := k.store(ctx)
+	key := sourceClaimKey(communityID, hash)
+	if store.Has(key) {
+		return
+	}
+	store.Set(key, k.codec.MustMarshalBinaryLengthPrefixed(claimID))
+}
+
+// setBodyClaim indexes the claim by its normalized body, keeping the first claim on duplicates
+func (k Keeper) setBodyClaim(ctx sdk.Context, communityID, body string, claimID uint64) {
+	store := k.store(ctx)
+	key := bodyClaimKey(communityID, bodyHash(body))
+	if store.Has(key) {
+		return
+	}
+	store.Set(key, k.codec.MustMarshalBinaryLengthPrefixed(claimID))
+}
+
+// deleteSourceClaim removes the source index if it belongs to the claim
+func (k Keeper) deleteSourceClaim(ctx sdk.Context, communityID string, source url.URL, claimID uint64) {
+	hash := sourceHash(source)
+	if hash == nil {
+		return
+	}
+	k.deleteIndexedClaim(ctx, sourceClaimKey(communityID, hash), claimID)
+}
+
+// deleteBodyClaim removes the body index if it belongs to the claim
+func (k Keeper) deleteBodyClaim(ctx sdk.Context, communityID, body string, claimID uint64) {
+	k.deleteIndexedClaim(ctx, bodyClaimKey(communityID, bodyHash(body)), claimID)
+}
+
+// deleteIndexedClaim removes a duplicate index entry only if it points to the claim
+func (k Keeper) deleteIndexedClaim(ctx sdk.Context, key []byte, claimID uint64) {
+	store := k.store(ctx)
+	bz := store.Get(key)
+	if bz == nil {
+		return
+	}
+	var indexedID uint64
+	k.codec.MustUnmarshalBinaryLengthPrefixed(bz, &indexedID)
+	if indexedID == claimID {
+		store.Delete(key)
+	}
+}
+
+func (k Keeper) setStatusClaim(ctx sdk.Context, status Status, claimID uint64) {
+	store := k.store(ctx)
+	bz := k.codec.MustMarshalBinaryLengthPrefixed(claimID)
+	store.Set(statusClaimKey(status, claimID), bz)
+}
+
+func (k Keeper) deleteStatusClaim(ctx sdk.Context, status Status, claimID uint64) {
+	k.store(ctx).Delete(statusClaimKey(status, claimID))
+}
+
+func (k Keeper) setClaimFlag(ctx sdk.Context, flag ClaimFlag) {
+	bz := k.codec.MustMarshalBinaryLengthPrefixed(flag)
+	k.store(ctx).Set(claimFlagKey(flag.ClaimID, flag.Creator), bz)
+}
+
+func (k Keeper) claimFlags(ctx sdk.Context, prefix []byte) []ClaimFlag {
+	flags := make([]ClaimFlag, 0)
+	iterator := sdk.KVStorePrefixIterator(k.store(ctx), prefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var flag ClaimFlag
+		k.codec.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &flag)
+		flags = append(flags, flag)
+	}
+
+	return flags
+}
+
+// insertModerationQueue inserts a claimID into the moderation queue at its flag count
+func (k Keeper) insertModerationQueue(ctx sdk.Context, flagCount, claimID uint64) {
+	bz := k.codec.MustMarshalBinaryLengthPrefixed(claimID)
+	k.store(ctx).Set(moderationQueueKey(flagCount, claimID), bz)
+}
+
+// removeFromModerationQueue removes a claimID from the moderation queue
+func (k Keeper) removeFromModerationQueue(ctx sdk.Context, flagCount, claimID uint64) {
+	k.store(ctx).Delete(moderationQueueKey(flagCount, claimID))
+}
+
+// setActivityClaim indexes a claim by its stake totals and, once argued, by its first argument time.
+// Deleted claims are only kept as tombstones, so stake refunds don't put them back in the indexes.
+func (k Keeper) setActivityClaim(ctx sdk.Context, claim Claim) {
+	if claim.IsDeleted() {
+		return
+	}
+	store := k.store(ctx)
+	bz := k.codec.MustMarshalBinaryLengthPrefixed(claim.ID)
+	store.Set(totalStakeClaimKey(claim.TotalStake(), claim.ID), bz)
+	store.Set(stakersClaimKey(claim.TotalStakers, claim.ID), bz)
+	if !claim.FirstArgumentTime.IsZero() {
+		store.Set(firstArgumentClaimKey(claim.FirstArgumentTime, claim.ID), bz)
+	}
+}
+
+// deleteActivityClaim removes a claim from the activity indexes, must be called before updating its totals
+func (k Keeper) deleteActivityClaim(ctx sdk.Context, claim Claim) {
+	store := k.store(ctx)
+	store.Delete(totalStakeClaimKey(claim.TotalStake(), claim.ID))
+	store.Delete(stakersClaimKey(claim.TotalStakers, claim.ID))
+	if !claim.FirstArgumentTime.IsZero() {
+		store.Delete(firstArgumentClaimKey(claim.FirstArgumentTime, claim.ID))
+	}
+}
+
+// insertClosingClaimQueue inserts a claimID into the closing claim queue at closingTime
+func (k Keeper) insertClosingClaimQueue(ctx sdk.Context, closingTime time.Time, claimID uint64) {
+	bz := k.codec.MustMarshalBinaryLengthPrefixed(claimID)
+	k.store(ctx).Set(closingClaimQueueKey(closingTime, claimID), bz)
+}
+
+// removeFromClosingClaimQueue removes a claimID from the closing claim queue
+func (k Keeper) removeFromClosingClaimQueue(ctx sdk.Context, closingTime time.Time, claimID uint64) {
+	k.store(ctx).Delete(closingClaimQueueKey(closingTime, claimID))
+}
+
+// insertArchivingClaimQueue inserts a claimID into the archiving claim queue at archiveTime
+func (k Keeper) insertArchivingClaimQueue(ctx sdk.Context, archiveTime time.Time, claimID uint64) {
+	bz := k.codec.MustMarshalBinaryLengthPrefixed(claimID)
+	k.store(ctx).Set(archivingClaimQueueKey(archiveTime, claimID), bz)
+}
+
+// removeFromArchivingClaimQueue removes a claimID from the archiving claim queue
+func (k Keeper) removeFromArchivingClaimQueue(ctx sdk.Context, archiveTime time.Time, claimID uint64) {
+	k.store(ctx).Delete(archivingClaimQueueKey(archiveTime, claimID))
+}
+
+// closingClaimQueueIterator returns an sdk.Iterator for all claims closing at or before closingTime
+func (k Keeper) closingClaimQueueIterator(ctx sdk.Context, closingTime time.Time) sdk.Iterator {
+	store := k.store(ctx)
+	return store.Iterator(ClosingClaimQueuePrefix, sdk.PrefixEndBytes(closingClaimByTimeKey(closingTime)))
+}
+
+// archivingClaimQueueIterator returns an sdk.Iterator for all claims archiving at or before archiveTime
+func (k Keeper) archivingClaimQueueIterator(ctx sdk.Context, archiveTime time.Time) sdk.Iterator {
+	store := k.store(ctx)
+	return store.Iterator(ArchivingClaimQueuePrefix, sdk.PrefixEndBytes(archivingClaimByTimeKey(archiveTime)))
+}
+
 // claimsIterator returns an sdk.Iterator for claims from startClaimID to endClaimID
 func (k Keeper) claimsIterator(ctx sdk.Context, startClaimID, endClaimID uint64) sdk.Iterator {
 	store := k.store(ctx)
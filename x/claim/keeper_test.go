@@ -1,7 +1,10 @@
 package claim
 
 import (
+	"fmt"
+	"math"
 	"net/url"
+	"strings"
 	"testing"
 	"time"
 
@@ -10,6 +13,7 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	app "github.com/TruStory/truchain/types"
+	"github.com/TruStory/truchain/x/community"
 )
 
 func TestAddGetClaim(t *testing.T) {
@@ -120,7 +124,9 @@ func TestCreatedTimeClaims(t *testing.T) {
 
 func createFakeClaim(ctx sdk.Context, keeper Keeper) Claim {
 	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Now().UTC()})
-	body := "Preethi can handle liquor better than Aamir."
+	// bodies must differ to pass the duplicate claim check
+	nextID, _ := keeper.claimID(ctx)
+	body := fmt.Sprintf("Preethi can handle liquor better than Aamir. #%d", nextID)
 	communityID := "crypto"
 	creator := sdk.AccAddress([]byte{1, 2})
 	source := url.URL{}
@@ -147,6 +153,53 @@ func TestKeeper_AddBackingChallengeStake(t *testing.T) {
 	assert.Equal(t, sdk.NewInt64Coin(app.StakeDenom, 75*app.Shanev).String(), c.TotalChallenged.String())
 }
 
+func TestClaimsSorted(t *testing.T) {
+	ctx, keeper := mockDB()
+	claim1 := fakeClaim(ctx, keeper, "crypto")
+	claim2 := fakeClaim(ctx, keeper, "crypto")
+	claim3 := fakeClaim(ctx, keeper, "meme")
+
+	keeper.AddBackingStake(ctx, claim1.ID, sdk.NewInt64Coin(app.StakeDenom, 50*app.Shanev))
+	keeper.AddChallengeStake(ctx, claim1.ID, sdk.NewInt64Coin(app.StakeDenom, 10*app.Shanev))
+	keeper.AddBackingStake(ctx, claim2.ID, sdk.NewInt64Coin(app.StakeDenom, 100*app.Shanev))
+	keeper.SubtractBackingStake(ctx, claim2.ID, sdk.NewInt64Coin(app.StakeDenom, 80*app.Shanev))
+	keeper.SetFirstArgumentTime(ctx, claim3.ID, time.Now())
+	keeper.SetFirstArgumentTime(ctx, claim2.ID, time.Now().Add(time.Hour))
+
+	claims := keeper.ClaimsSorted(ctx, SortByTotalStake, "", 0, 0)
+	assert.Len(t, claims, 3)
+	assert.Equal(t, claim1.ID, claims[0].ID)
+	assert.Equal(t, claim2.ID, claims[1].ID)
+	assert.Equal(t, claim3.ID, claims[2].ID)
+
+	claims = keeper.ClaimsSorted(ctx, SortByTotalStakers, "", 0, 0)
+	assert.Equal(t, claim1.ID, claims[0].ID)
+	assert.Equal(t, uint64(2), claims[0].TotalStakers)
+
+	// claims without arguments aren't in the first argument index
+	claims = keeper.ClaimsSorted(ctx, SortByFirstArgumentTime, "", 0, 0)
+	assert.Len(t, claims, 2)
+	assert.Equal(t, claim2.ID, claims[0].ID)
+	assert.Equal(t, claim3.ID, claims[1].ID)
+
+	claims = keeper.ClaimsSorted(ctx, SortByTotalStake, "crypto", 2, 1)
+	assert.Len(t, claims, 1)
+	assert.Equal(t, claim2.ID, claims[0].ID)
+
+	claims = keeper.ClaimsSorted(ctx, SortByTotalStake, "", math.MaxInt64, 2)
+	assert.Len(t, claims, 0)
+	claims = keeper.ClaimsSorted(ctx, SortByTotalStake, "", 2, -1)
+	assert.Len(t, claims, 0)
+
+	// deleted claims are removed from the indexes
+	admin := keeper.GetParams(ctx).ClaimAdmins[0]
+	_, err := keeper.DeleteClaim(ctx, claim1.ID, "spam", admin)
+	assert.NoError(t, err)
+	claims = keeper.ClaimsSorted(ctx, SortByTotalStake, "", 0, 0)
+	assert.Len(t, claims, 2)
+	assert.Equal(t, claim2.ID, claims[0].ID)
+}
+
 func TestAddAdmin_Success(t *testing.T) {
 	ctx, keeper := mockDB()
 
@@ -225,3 +278,565 @@ func TestEditClaim_ErrAddressNotAuthorised(t *testing.T) {
 
 	assert.Equal(t, ErrAddressNotAuthorised().Code(), err.Code())
 }
+
+func TestReopenClaim_Success(t *testing.T) {
+	ctx, keeper := mockDB()
+
+	claim := createFakeClaim(ctx, keeper)
+	admin := keeper.GetParams(ctx).ClaimAdmins[0]
+	ctx = ctx.WithBlockTime(claim.ClosingTime)
+	EndBlocker(ctx, keeper)
+
+	reopened, err := keeper.ReopenClaim(ctx, claim.ID, admin)
+	assert.NoError(t, err)
+	assert.Equal(t, StatusOpen, reopened.Status)
+	assert.Equal(t, ctx.BlockHeader().Time.Add(keeper.GetParams(ctx).ClaimDuration), reopened.ClosingTime)
+	assert.True(t, reopened.ArchiveTime.IsZero())
+	assert.Len(t, keeper.ClaimsByStatus(ctx, StatusOpen), 1)
+	assert.Len(t, keeper.ClaimsByStatus(ctx, StatusClosed), 0)
+}
+
+func TestReopenClaim_ErrClaimNotClosed(t *testing.T) {
+	ctx, keeper := mockDB()
+
+	claim := createFakeClaim(ctx, keeper)
+	admin := keeper.GetParams(ctx).ClaimAdmins[0]
+
+	_, err := keeper.ReopenClaim(ctx, claim.ID, admin)
+	assert.NotNil(t, err)
+	assert.Equal(t, ErrClaimNotClosed(claim.ID).Code(), err.Code())
+}
+
+func TestReopenClaim_ErrAddressNotAuthorised(t *testing.T) {
+	ctx, keeper := mockDB()
+
+	claim := createFakeClaim(ctx, keeper)
+	ctx = ctx.WithBlockTime(claim.ClosingTime)
+	EndBlocker(ctx, keeper)
+
+	_, err := keeper.ReopenClaim(ctx, claim.ID, sdk.AccAddress([]byte{1, 2}))
+	assert.NotNil(t, err)
+	assert.Equal(t, ErrAddressNotAuthorised().Code(), err.Code())
+}
+
+func TestSubmitClaim_ErrDuplicateClaim(t *testing.T) {
+	ctx, keeper := mockDB()
+
+	creator := sdk.AccAddress([]byte{1, 2})
+	source, _ := url.Parse("https://trustory.io/article?utm_source=twitter")
+	claim, err := keeper.SubmitClaim(ctx, "Preethi can handle liquor better than Aamir.", "crypto", creator, *source)
+	assert.NoError(t, err)
+
+	// same body after normalization
+	existing, err := keeper.SubmitClaim(ctx, "preethi can handle  liquor better than aamir.", "crypto", creator, url.URL{})
+	assert.NotNil(t, err)
+	assert.Equal(t, ErrorCodeDuplicateClaim, err.Code())
+	assert.Equal(t, claim.ID, existing.ID)
+
+	// same source after normalization
+	duplicateSource, _ := url.Parse("http://TRUSTORY.io/article/")
+	_, err = keeper.SubmitClaim(ctx, "A different body for the same article source", "crypto", creator, *duplicateSource)
+	assert.NotNil(t, err)
+	assert.Equal(t, ErrorCodeDuplicateClaim, err.Code())
+
+	// duplicates are allowed across communities
+	_, err = keeper.SubmitClaim(ctx, "Preethi can handle liquor better than Aamir.", "meme", creator, *source)
+	assert.NoError(t, err)
+}
+
+func TestSubmitClaim_ErrCommunityArchived(t *testing.T) {
+	ctx, keeper := mockDB()
+
+	claim := fakeClaim(ctx, keeper, "meme")
+	communityAdmin := keeper.communityKeeper.GetParams(ctx).CommunityAdmins[0]
+	_, err := keeper.communityKeeper.ArchiveCommunity(ctx, "meme", communityAdmin)
+	assert.Nil(t, err)
+
+	creator := sdk.AccAddress([]byte{1, 2})
+	_, err = keeper.SubmitClaim(ctx, "Archived communities don't take new claims.", "meme", creator, url.URL{})
+	assert.Equal(t, ErrorCodeCommunityArchived, err.Code())
+
+	admin := keeper.GetParams(ctx).ClaimAdmins[0]
+	other := fakeClaim(ctx, keeper, "crypto")
+	_, err = keeper.AddClaimTag(ctx, other.ID, "meme", admin)
+	assert.Equal(t, ErrorCodeCommunityArchived, err.Code())
+
+	// existing claims stay queryable
+	claims := keeper.CommunityClaims(ctx, "meme")
+	assert.Len(t, claims, 1)
+	assert.Equal(t, claim.ID, claims[0].ID)
+}
+
+func TestSubmitDuplicateClaim(t *testing.T) {
+	ctx, keeper := mockDB()
+
+	body := "Preethi can handle liquor better than Aamir."
+	creator := sdk.AccAddress([]byte{1, 2})
+	_, err := keeper.SubmitClaim(ctx, body, "crypto", creator, url.URL{})
+	assert.NoError(t, err)
+
+	_, err = keeper.SubmitDuplicateClaim(ctx, body, "crypto", creator, url.URL{})
+	assert.NotNil(t, err)
+	assert.Equal(t, ErrAddressNotAuthorised().Code(), err.Code())
+
+	admin := keeper.GetParams(ctx).ClaimAdmins[0]
+	claim, err := keeper.SubmitDuplicateClaim(ctx, body, "crypto", admin, url.URL{})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), claim.ID)
+}
+
+func TestEditClaim_UpdatesBodyIndex(t *testing.T) {
+	ctx, keeper := mockDB()
+
+	claim := createFakeClaim(ctx, keeper)
+	editor := keeper.GetParams(ctx).ClaimAdmins[0]
+	updatedBody := "This is the new claim body. Old wasn't gold anymore."
+	_, err := keeper.EditClaim(ctx, claim.ID, updatedBody, editor)
+	assert.NoError(t, err)
+
+	// old body is free again, new body is taken
+	_, err = keeper.SubmitClaim(ctx, claim.Body, claim.CommunityID, claim.Creator, url.URL{})
+	assert.NoError(t, err)
+	_, err = keeper.SubmitClaim(ctx, updatedBody, claim.CommunityID, claim.Creator, url.URL{})
+	assert.NotNil(t, err)
+	assert.Equal(t, ErrorCodeDuplicateClaim, err.Code())
+}
+
+func TestDeleteClaim_Success(t *testing.T) {
+	ctx, keeper, bankKeeper := mockDBWithBank()
+	hooks := &mockHooks{}
+	keeper.SetHooks(hooks)
+
+	claim := createFakeClaim(ctx, keeper)
+	admin := keeper.GetParams(ctx).ClaimAdmins[0]
+	searchKeeper := keeper.searchKeeper.(*searchKeeper)
+	assert.Contains(t, searchKeeper.Indexed, claim.ID)
+
+	deleted, err := keeper.DeleteClaim(ctx, claim.ID, "spam", admin)
+	assert.NoError(t, err)
+	assert.Equal(t, StatusDeleted, deleted.Status)
+	assert.Equal(t, "spam", deleted.DeletionReason)
+	assert.True(t, deleted.CreationStake.IsZero())
+
+	// tombstone is kept but hidden from the indexes
+	tombstone, ok := keeper.Claim(ctx, claim.ID)
+	assert.True(t, ok)
+	assert.True(t, tombstone.IsDeleted())
+	assert.Len(t, keeper.Claims(ctx), 0)
+	assert.Len(t, keeper.CommunityClaims(ctx, claim.CommunityID), 0)
+	assert.Len(t, keeper.CreatorClaims(ctx, claim.Creator), 0)
+	assert.Len(t, keeper.ClaimsBeforeTime(ctx, claim.CreatedTime), 0)
+	assert.Len(t, keeper.ClaimsByStatus(ctx, StatusOpen), 0)
+	assert.Len(t, keeper.ClaimsByStatus(ctx, StatusDeleted), 1)
+	assert.NotContains(t, searchKeeper.Indexed, claim.ID)
+
+	// stakes added or refunded afterwards don't index the tombstone again
+	stake := sdk.NewInt64Coin(app.StakeDenom, 10)
+	assert.NoError(t, keeper.AddBackingStake(ctx, claim.ID, stake))
+	assert.NoError(t, keeper.AddChallengeStake(ctx, claim.ID, stake))
+	assert.NoError(t, keeper.SubtractBackingStake(ctx, claim.ID, stake))
+	tombstone, _ = keeper.Claim(ctx, claim.ID)
+	assert.False(t, keeper.store(ctx).Has(totalStakeClaimKey(tombstone.TotalStake(), claim.ID)))
+	assert.False(t, keeper.store(ctx).Has(stakersClaimKey(tombstone.TotalStakers, claim.ID)))
+
+	// creation stake is refunded and hooks are called
+	assert.Len(t, bankKeeper.Transactions, 2)
+	assert.Equal(t, TransactionClaimCreationReturned, bankKeeper.Transactions[1].Type)
+	assert.Len(t, hooks.deleted, 1)
+
+	// deleted claims no longer close
+	EndBlocker(ctx.WithBlockTime(claim.ClosingTime), keeper)
+	assert.Len(t, hooks.closed, 0)
+
+	// the body can be submitted again
+	_, err = keeper.SubmitClaim(ctx, claim.Body, claim.CommunityID, claim.Creator, claim.Source)
+	assert.NoError(t, err)
+}
+
+func TestDeleteClaim_ErrAddressNotAuthorised(t *testing.T) {
+	ctx, keeper := mockDB()
+
+	claim := createFakeClaim(ctx, keeper)
+	_, err := keeper.DeleteClaim(ctx, claim.ID, "spam", sdk.AccAddress([]byte{1, 2}))
+	assert.NotNil(t, err)
+	assert.Equal(t, ErrAddressNotAuthorised().Code(), err.Code())
+}
+
+func TestDeleteClaim_ErrClaimDeleted(t *testing.T) {
+	ctx, keeper := mockDB()
+
+	claim := createFakeClaim(ctx, keeper)
+	admin := keeper.GetParams(ctx).ClaimAdmins[0]
+	_, err := keeper.DeleteClaim(ctx, claim.ID, "spam", admin)
+	assert.NoError(t, err)
+
+	_, err = keeper.DeleteClaim(ctx, claim.ID, "spam", admin)
+	assert.NotNil(t, err)
+	assert.Equal(t, ErrorCodeClaimDeleted, err.Code())
+
+	_, err = keeper.ReopenClaim(ctx, claim.ID, admin)
+	assert.NotNil(t, err)
+	assert.Equal(t, ErrorCodeClaimDeleted, err.Code())
+}
+
+func TestEditClaim_StoresRevision(t *testing.T) {
+	ctx, keeper := mockDB()
+	hooks := &mockHooks{}
+	keeper.SetHooks(hooks)
+
+	claim := createFakeClaim(ctx, keeper)
+	editor := keeper.GetParams(ctx).ClaimAdmins[0]
+	updatedBody := "This is the new claim body. Old wasn't gold anymore."
+	updated, err := keeper.EditClaim(ctx, claim.ID, updatedBody, editor)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), updated.Revisions)
+	assert.Len(t, hooks.edited, 1)
+
+	revisions := keeper.ClaimRevisions(ctx, claim.ID)
+	assert.Len(t, revisions, 1)
+	assert.Equal(t, uint64(1), revisions[0].Revision)
+	assert.Equal(t, claim.Body, revisions[0].PreviousBody)
+	assert.Equal(t, updatedBody, revisions[0].Body)
+	assert.Equal(t, editor, revisions[0].Editor)
+	assert.Equal(t, uint64(0), revisions[0].ProposalID)
+}
+
+func TestEditClaimEvidence(t *testing.T) {
+	ctx, keeper := mockDB()
+
+	claim := createFakeClaim(ctx, keeper)
+	editor := keeper.GetParams(ctx).ClaimAdmins[0]
+	evidence := []Evidence{
+		{Kind: EvidenceLink, URI: "https://trustory.io/evidence"},
+		{Kind: EvidenceDocument, URI: "ipfs://QmEvidence", Digest: strings.Repeat("0f", 32), MimeType: "application/pdf"},
+	}
+
+	_, err := keeper.EditClaimEvidence(ctx, claim.ID, evidence, claim.Creator)
+	assert.Equal(t, ErrorCodeAddressNotAuthorised, err.Code())
+
+	invalid := []Evidence{{Kind: EvidenceSnapshot, URI: "https://archive.org/web/1"}}
+	_, err = keeper.EditClaimEvidence(ctx, claim.ID, invalid, editor)
+	assert.Equal(t, ErrorCodeInvalidEvidence, err.Code())
+
+	updated, err := keeper.EditClaimEvidence(ctx, claim.ID, evidence, editor)
+	assert.NoError(t, err)
+	assert.Equal(t, evidence, updated.Evidence)
+	// editing evidence doesn't create a body revision
+	assert.Equal(t, uint64(0), updated.Revisions)
+
+	_, err = keeper.EditClaimEvidence(ctx, 99, evidence, editor)
+	assert.Equal(t, ErrorCodeInvalidID, err.Code())
+}
+
+func TestCommunityModerator(t *testing.T) {
+	ctx, keeper := mockDB()
+
+	claim := fakeClaim(ctx, keeper, "crypto")
+	other := fakeClaim(ctx, keeper, "meme")
+	moderator := getFakeAdmin()
+	communityAdmin := keeper.communityKeeper.GetParams(ctx).CommunityAdmins[0]
+	err := keeper.communityKeeper.AddModerator(ctx, "crypto", moderator, communityAdmin)
+	assert.Nil(t, err)
+
+	updatedBody := "A moderator corrected the body of this claim."
+	edited, err := keeper.EditClaim(ctx, claim.ID, updatedBody, moderator)
+	assert.NoError(t, err)
+	assert.Equal(t, updatedBody, edited.Body)
+
+	// moderators can't act outside their community
+	_, err = keeper.EditClaim(ctx, other.ID, updatedBody, moderator)
+	assert.Equal(t, ErrorCodeAddressNotAuthorised, err.Code())
+	_, err = keeper.DeleteClaim(ctx, other.ID, "spam", moderator)
+	assert.Equal(t, ErrorCodeAddressNotAuthorised, err.Code())
+
+	deleted, err := keeper.DeleteClaim(ctx, claim.ID, "spam", moderator)
+	assert.NoError(t, err)
+	assert.True(t, deleted.IsDeleted())
+}
+
+func TestProposeClaimEdit_Approve(t *testing.T) {
+	ctx, keeper := mockDB()
+	hooks := &mockHooks{}
+	keeper.SetHooks(hooks)
+
+	claim := createFakeClaim(ctx, keeper)
+	proposer := sdk.AccAddress([]byte{3, 4})
+	correctedBody := "This is the corrected claim body proposed by a user."
+	proposal, err := keeper.ProposeClaimEdit(ctx, claim.ID, correctedBody, proposer)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), proposal.ID)
+	assert.Equal(t, EditProposalPending, proposal.Status)
+
+	// proposals don't change the claim until approved
+	unchanged, _ := keeper.Claim(ctx, claim.ID)
+	assert.Equal(t, claim.Body, unchanged.Body)
+
+	_, err = keeper.ProposeClaimEdit(ctx, claim.ID, "This is another correction by the same user.", proposer)
+	assert.NotNil(t, err)
+	assert.Equal(t, ErrorCodeEditProposalAlreadyPending, err.Code())
+
+	_, err = keeper.ReviewClaimEdit(ctx, proposal.ID, true, proposer)
+	assert.NotNil(t, err)
+	assert.Equal(t, ErrAddressNotAuthorised().Code(), err.Code())
+
+	admin := keeper.GetParams(ctx).ClaimAdmins[0]
+	approved, err := keeper.ReviewClaimEdit(ctx, proposal.ID, true, admin)
+	assert.NoError(t, err)
+	assert.Equal(t, EditProposalApproved, approved.Status)
+	assert.Equal(t, admin, approved.Reviewer)
+	assert.Len(t, hooks.edited, 1)
+
+	updated, _ := keeper.Claim(ctx, claim.ID)
+	assert.Equal(t, correctedBody, updated.Body)
+	revisions := keeper.ClaimRevisions(ctx, claim.ID)
+	assert.Len(t, revisions, 1)
+	assert.Equal(t, proposer, revisions[0].Editor)
+	assert.Equal(t, proposal.ID, revisions[0].ProposalID)
+
+	_, err = keeper.ReviewClaimEdit(ctx, proposal.ID, false, admin)
+	assert.NotNil(t, err)
+	assert.Equal(t, ErrorCodeEditProposalNotPending, err.Code())
+}
+
+func TestProposeClaimEdit_Stale(t *testing.T) {
+	ctx, keeper := mockDB()
+
+	claim := createFakeClaim(ctx, keeper)
+	first, err := keeper.ProposeClaimEdit(ctx, claim.ID, "This is the first claim body correction.", sdk.AccAddress([]byte{3, 4}))
+	assert.NoError(t, err)
+	second, err := keeper.ProposeClaimEdit(ctx, claim.ID, "This is the second claim body correction.", sdk.AccAddress([]byte{5, 6}))
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0), second.BaseRevision)
+
+	admin := keeper.GetParams(ctx).ClaimAdmins[0]
+	_, err = keeper.ReviewClaimEdit(ctx, first.ID, true, admin)
+	assert.NoError(t, err)
+
+	// the second proposal was written against the original body
+	_, err = keeper.ReviewClaimEdit(ctx, second.ID, true, admin)
+	assert.NotNil(t, err)
+	assert.Equal(t, ErrorCodeEditProposalStale, err.Code())
+	updated, _ := keeper.Claim(ctx, claim.ID)
+	assert.Equal(t, "This is the first claim body correction.", updated.Body)
+
+	rejected, err := keeper.ReviewClaimEdit(ctx, second.ID, false, admin)
+	assert.NoError(t, err)
+	assert.Equal(t, EditProposalRejected, rejected.Status)
+}
+
+func TestProposeClaimEdit_Reject(t *testing.T) {
+	ctx, keeper := mockDB()
+
+	claim := createFakeClaim(ctx, keeper)
+	proposer := sdk.AccAddress([]byte{3, 4})
+	proposal, err := keeper.ProposeClaimEdit(ctx, claim.ID, "This is a rejected claim body correction.", proposer)
+	assert.NoError(t, err)
+
+	admin := keeper.GetParams(ctx).ClaimAdmins[0]
+	rejected, err := keeper.ReviewClaimEdit(ctx, proposal.ID, false, admin)
+	assert.NoError(t, err)
+	assert.Equal(t, EditProposalRejected, rejected.Status)
+
+	unchanged, _ := keeper.Claim(ctx, claim.ID)
+	assert.Equal(t, claim.Body, unchanged.Body)
+	assert.Len(t, keeper.ClaimRevisions(ctx, claim.ID), 0)
+	assert.Len(t, keeper.ClaimEditProposals(ctx, claim.ID), 1)
+
+	// reviewed proposals don't block new ones
+	_, err = keeper.ProposeClaimEdit(ctx, claim.ID, "This is a second claim body correction.", proposer)
+	assert.NoError(t, err)
+}
+
+func TestAddClaimTag(t *testing.T) {
+	ctx, keeper := mockDB()
+
+	claim := createFakeClaim(ctx, keeper)
+	admin := keeper.GetParams(ctx).ClaimAdmins[0]
+
+	_, err := keeper.AddClaimTag(ctx, claim.ID, "meme", sdk.AccAddress([]byte{1, 2}))
+	assert.NotNil(t, err)
+	assert.Equal(t, ErrAddressNotAuthorised().Code(), err.Code())
+
+	_, err = keeper.AddClaimTag(ctx, claim.ID, "unknown", admin)
+	assert.NotNil(t, err)
+	assert.Equal(t, ErrorCodeClaimsWithCommunityNotFound, err.Code())
+
+	_, err = keeper.AddClaimTag(ctx, claim.ID, claim.CommunityID, admin)
+	assert.NotNil(t, err)
+	assert.Equal(t, ErrorCodeClaimTagExists, err.Code())
+
+	tagged, err := keeper.AddClaimTag(ctx, claim.ID, "meme", admin)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"meme"}, tagged.Tags)
+
+	// tagged claims are listed in both communities
+	assert.Len(t, keeper.CommunityClaims(ctx, "crypto"), 1)
+	memeClaims := keeper.CommunityClaims(ctx, "meme")
+	assert.Len(t, memeClaims, 1)
+	assert.Equal(t, claim.ID, memeClaims[0].ID)
+
+	// tags of one community don't leak into communities whose ID extends it
+	communityAdmin := keeper.communityKeeper.GetParams(ctx).CommunityAdmins[0]
+	_, err = keeper.communityKeeper.NewCommunity(ctx, "memelords", "Meme Lords", "", communityAdmin)
+	assert.NoError(t, err)
+	lordsClaim := createFakeClaim(ctx, keeper)
+	_, err = keeper.AddClaimTag(ctx, lordsClaim.ID, "memelords", admin)
+	assert.NoError(t, err)
+	assert.Len(t, keeper.CommunityClaims(ctx, "meme"), 1)
+	assert.Len(t, keeper.CommunityClaims(ctx, "memelords"), 1)
+
+	p := keeper.GetParams(ctx)
+	p.MaxCommunityTags = 0
+	keeper.SetParams(ctx, p)
+	another := createFakeClaim(ctx, keeper)
+	_, err = keeper.AddClaimTag(ctx, another.ID, "meme", admin)
+	assert.NotNil(t, err)
+	assert.Equal(t, ErrorCodeTooManyClaimTags, err.Code())
+}
+
+func TestRemoveClaimTag(t *testing.T) {
+	ctx, keeper := mockDB()
+
+	claim := createFakeClaim(ctx, keeper)
+	admin := keeper.GetParams(ctx).ClaimAdmins[0]
+	_, err := keeper.AddClaimTag(ctx, claim.ID, "meme", admin)
+	assert.NoError(t, err)
+
+	untagged, err := keeper.RemoveClaimTag(ctx, claim.ID, "meme", admin)
+	assert.NoError(t, err)
+	assert.Len(t, untagged.Tags, 0)
+	assert.Len(t, keeper.CommunityClaims(ctx, "meme"), 0)
+
+	_, err = keeper.RemoveClaimTag(ctx, claim.ID, "meme", admin)
+	assert.NotNil(t, err)
+	assert.Equal(t, ErrorCodeClaimTagNotFound, err.Code())
+}
+
+func TestFlagClaim_HidesAtThreshold(t *testing.T) {
+	ctx, keeper := mockDB()
+	params := keeper.GetParams(ctx)
+	params.FlagHideThreshold = 2
+	keeper.SetParams(ctx, params)
+	staking := keeper.stakingKeeper.(*stakingKeeper)
+
+	claim := createFakeClaim(ctx, keeper)
+	flagger1 := getFakeAdmin()
+	flagger2 := getFakeAdmin()
+	staking.Earned[flagger1.String()] = params.FlagMinEarnedStake.Amount
+	staking.Earned[flagger2.String()] = params.FlagMinEarnedStake.Amount
+
+	flagged, err := keeper.FlagClaim(ctx, claim.ID, FlagReasonSpam, flagger1)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), flagged.FlagCount)
+	assert.False(t, flagged.Hidden)
+
+	_, err = keeper.FlagClaim(ctx, claim.ID, FlagReasonSpam, flagger1)
+	assert.Equal(t, ErrorCodeAlreadyFlagged, err.Code())
+
+	flagged, err = keeper.FlagClaim(ctx, claim.ID, FlagReasonMisleading, flagger2)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), flagged.FlagCount)
+	assert.True(t, flagged.Hidden)
+	assert.Len(t, keeper.ClaimFlags(ctx, claim.ID), 2)
+	assert.NotContains(t, keeper.searchKeeper.(*searchKeeper).Indexed, claim.ID)
+
+	_, err = keeper.FlagClaim(ctx, claim.ID, FlagReasonSpam, getFakeAdmin())
+	assert.Equal(t, ErrorCodeClaimHidden, err.Code())
+}
+
+func TestFlagClaim_ErrNotEnoughEarnedStake(t *testing.T) {
+	ctx, keeper := mockDB()
+	claim := createFakeClaim(ctx, keeper)
+
+	_, err := keeper.FlagClaim(ctx, claim.ID, FlagReasonSpam, getFakeAdmin())
+	assert.NotNil(t, err)
+	assert.Equal(t, ErrorCodeNotEnoughEarnedStake, err.Code())
+
+	// admins can always flag
+	admin := keeper.GetParams(ctx).ClaimAdmins[0]
+	_, err = keeper.FlagClaim(ctx, claim.ID, FlagReasonSpam, admin)
+	assert.NoError(t, err)
+}
+
+func TestModerationQueue(t *testing.T) {
+	ctx, keeper := mockDB()
+	admin1 := keeper.GetParams(ctx).ClaimAdmins[0]
+	admin2 := keeper.GetParams(ctx).ClaimAdmins[1]
+
+	claim1 := fakeClaim(ctx, keeper, "crypto")
+	claim2 := fakeClaim(ctx, keeper, "crypto")
+	fakeClaim(ctx, keeper, "crypto")
+	_, err := keeper.FlagClaim(ctx, claim1.ID, FlagReasonSpam, admin1)
+	assert.NoError(t, err)
+	_, err = keeper.FlagClaim(ctx, claim1.ID, FlagReasonSpam, admin2)
+	assert.NoError(t, err)
+	_, err = keeper.FlagClaim(ctx, claim2.ID, FlagReasonSpam, admin1)
+	assert.NoError(t, err)
+
+	queue := keeper.ModerationQueue(ctx)
+	assert.Len(t, queue, 2)
+	assert.Equal(t, claim1.ID, queue[0].ID)
+	assert.Equal(t, claim2.ID, queue[1].ID)
+
+	resolved, err := keeper.ResolveClaimFlags(ctx, claim1.ID, admin1)
+	assert.NoError(t, err)
+	assert.True(t, resolved.Hidden)
+	assert.Len(t, keeper.ClaimFlags(ctx, claim1.ID), 2)
+	assert.NotContains(t, keeper.searchKeeper.(*searchKeeper).Indexed, claim1.ID)
+
+	dismissed, err := keeper.DismissClaimFlags(ctx, claim2.ID, admin1)
+	assert.NoError(t, err)
+	assert.False(t, dismissed.Hidden)
+	assert.Len(t, keeper.ClaimFlags(ctx, claim2.ID), 0)
+	assert.Contains(t, keeper.searchKeeper.(*searchKeeper).Indexed, claim2.ID)
+	assert.Len(t, keeper.ModerationQueue(ctx), 0)
+
+	_, err = keeper.DismissClaimFlags(ctx, claim2.ID, admin1)
+	assert.Equal(t, ErrorCodeClaimNotFlagged, err.Code())
+	_, err = keeper.ResolveClaimFlags(ctx, claim2.ID, getFakeAdmin())
+	assert.Equal(t, ErrorCodeAddressNotAuthorised, err.Code())
+}
+
+func TestSubmitClaim_ParamOverrides(t *testing.T) {
+	ctx, keeper := mockDB()
+
+	communityAdmin := keeper.communityKeeper.GetParams(ctx).CommunityAdmins[0]
+	longBody := strings.Repeat("a", 200)
+	creator := sdk.AccAddress([]byte{1, 2})
+	_, err := keeper.SubmitClaim(ctx, longBody, "crypto", creator, url.URL{})
+	assert.Equal(t, ErrorCodeInvalidBodyTooLong, err.Code())
+
+	maxClaimLength := 250
+	err = keeper.communityKeeper.SetParamOverrides(ctx,
+		community.ParamOverrides{CommunityID: "crypto", MaxClaimLength: &maxClaimLength}, communityAdmin)
+	assert.NoError(t, err)
+	assert.Equal(t, 250, keeper.EffectiveParams(ctx, "crypto").MaxClaimLength)
+	assert.Equal(t, keeper.GetParams(ctx).MinClaimLength, keeper.EffectiveParams(ctx, "crypto").MinClaimLength)
+	_, err = keeper.SubmitClaim(ctx, longBody, "crypto", creator, url.URL{})
+	assert.NoError(t, err)
+	_, err = keeper.SubmitClaim(ctx, longBody, "meme", creator, url.URL{})
+	assert.Equal(t, ErrorCodeInvalidBodyTooLong, err.Code())
+
+	// sub-communities inherit the overrides of their parents
+	_, err = keeper.communityKeeper.SetParent(ctx, "meme", "crypto", communityAdmin)
+	assert.NoError(t, err)
+	_, err = keeper.SubmitClaim(ctx, longBody, "meme", creator, url.URL{})
+	assert.NoError(t, err)
+}
+
+func TestInitGenesis_MintsCreationStakes(t *testing.T) {
+	ctx, keeper := mockDB()
+	claim := createFakeClaim(ctx, keeper)
+	createFakeClaim(ctx, keeper)
+	supplyKeeper := keeper.supplyKeeper.(*supplyKeeper)
+	assert.True(t, supplyKeeper.Pools[ClaimStakesPoolName].Empty())
+
+	// an imported chain starts with an empty claim stakes pool
+	InitGenesis(ctx, keeper, ExportGenesis(ctx, keeper))
+	expected := sdk.NewCoins(claim.CreationStake.Add(claim.CreationStake))
+	assert.Equal(t, expected, supplyKeeper.Pools[ClaimStakesPoolName])
+
+	// a funded pool isn't minted again
+	InitGenesis(ctx, keeper, ExportGenesis(ctx, keeper))
+	assert.Equal(t, expected, supplyKeeper.Pools[ClaimStakesPoolName])
+}
@@ -11,17 +11,51 @@ import (
 //
 // - 0x00<claimID_Bytes>: Claim_Bytes
 // - 0x01: nextClaimID_Bytes
+// - 0x02<claimID_Bytes><revision_Bytes>: ClaimRevision_Bytes
+// - 0x03<proposalID_Bytes>: ClaimEditProposal_Bytes
+// - 0x04: nextEditProposalID_Bytes
+// - 0x05<claimID_Bytes><creator_Bytes>: ClaimFlag_Bytes
 //
 // - 0x10<communityID_Bytes><claimID_Bytes>: claimID_Bytes
 // - 0x11<creator_Bytes><claimID_Bytes>: claimID_Bytes
 // - 0x12<createdTime_Bytes><claimID_Bytes>: claimID_Bytes
+// - 0x13<status_Byte><claimID_Bytes>: claimID_Bytes
+// - 0x14<communityID_Bytes><sourceHash_Bytes>: claimID_Bytes
+// - 0x15<communityID_Bytes><bodyHash_Bytes>: claimID_Bytes
+// - 0x16<claimID_Bytes><proposalID_Bytes>: proposalID_Bytes
+// - 0x17<len(communityID)><communityID_Bytes><claimID_Bytes>: claimID_Bytes
+// - 0x18<totalStake_Bytes><claimID_Bytes>: claimID_Bytes
+// - 0x19<totalStakers_Bytes><claimID_Bytes>: claimID_Bytes
+// - 0x1A<firstArgumentTime_Bytes><claimID_Bytes>: claimID_Bytes
+// - 0x1B<flagCount_Bytes><claimID_Bytes>: claimID_Bytes
+//
+// - 0x40<closingTime_Bytes><claimID_Bytes>: claimID_Bytes
+// - 0x41<archiveTime_Bytes><claimID_Bytes>: claimID_Bytes
 var (
 	ClaimsKeyPrefix = []byte{0x00}
 	ClaimIDKey      = []byte{0x01}
 
-	CommunityClaimsPrefix   = []byte{0x10}
-	CreatorClaimsPrefix     = []byte{0x11}
-	CreatedTimeClaimsPrefix = []byte{0x12}
+	ClaimRevisionsPrefix = []byte{0x02}
+	EditProposalsPrefix  = []byte{0x03}
+	EditProposalIDKey    = []byte{0x04}
+	ClaimFlagsPrefix     = []byte{0x05}
+
+	CommunityClaimsPrefix     = []byte{0x10}
+	CreatorClaimsPrefix       = []byte{0x11}
+	CreatedTimeClaimsPrefix   = []byte{0x12}
+	StatusClaimsPrefix        = []byte{0x13}
+	SourceClaimPrefix         = []byte{0x14}
+	BodyClaimPrefix           = []byte{0x15}
+	ClaimEditProposalsPrefix  = []byte{0x16}
+	TagClaimsPrefix           = []byte{0x17}
+	TotalStakeClaimsPrefix    = []byte{0x18}
+	StakersClaimsPrefix       = []byte{0x19}
+	FirstArgumentClaimsPrefix = []byte{0x1A}
+	ModerationQueuePrefix     = []byte{0x1B}
+
+	// Queues
+	ClosingClaimQueuePrefix   = []byte{0x40}
+	ArchivingClaimQueuePrefix = []byte{0x41}
 )
 
 // key for getting a specific claim from the store
@@ -30,6 +64,41 @@ func key(claimID uint64) []byte {
 	return append(ClaimsKeyPrefix, bz...)
 }
 
+// claimRevisionsKey gets the first part of the revision key based on the claimID
+func claimRevisionsKey(claimID uint64) []byte {
+	return append(ClaimRevisionsPrefix, sdk.Uint64ToBigEndian(claimID)...)
+}
+
+// claimRevisionKey key of a specific claim revision from the store
+func claimRevisionKey(claimID, revision uint64) []byte {
+	return append(claimRevisionsKey(claimID), sdk.Uint64ToBigEndian(revision)...)
+}
+
+// editProposalKey key of a specific edit proposal from the store
+func editProposalKey(proposalID uint64) []byte {
+	return append(EditProposalsPrefix, sdk.Uint64ToBigEndian(proposalID)...)
+}
+
+// claimEditProposalsKey gets the first part of the claim <-> edit proposal key based on the claimID
+func claimEditProposalsKey(claimID uint64) []byte {
+	return append(ClaimEditProposalsPrefix, sdk.Uint64ToBigEndian(claimID)...)
+}
+
+// claimEditProposalKey key of a specific claim <-> edit proposal association from the store
+func claimEditProposalKey(claimID, proposalID uint64) []byte {
+	return append(claimEditProposalsKey(claimID), sdk.Uint64ToBigEndian(proposalID)...)
+}
+
+// claimFlagsKey gets the first part of the claim flags key based on the claimID
+func claimFlagsKey(claimID uint64) []byte {
+	return append(ClaimFlagsPrefix, sdk.Uint64ToBigEndian(claimID)...)
+}
+
+// claimFlagKey key of the flag of a user on a claim
+func claimFlagKey(claimID uint64, creator sdk.AccAddress) []byte {
+	return append(claimFlagsKey(claimID), creator.Bytes()...)
+}
+
 // communityClaimsKey gets the first part of the community claims key based on the communityID
 func communityClaimsKey(communityID string) []byte {
 	return append(CommunityClaimsPrefix, []byte(communityID)...)
@@ -41,6 +110,19 @@ func communityClaimKey(communityID string, claimID uint64) []byte {
 	return append(communityClaimsKey(communityID), bz...)
 }
 
+// tagClaimsKey gets the first part of the tagged claims key based on the communityID.
+// The ID is length prefixed so that one ID can't be the prefix of another.
+func tagClaimsKey(communityID string) []byte {
+	prefix := append(TagClaimsPrefix, byte(len(communityID)))
+	return append(prefix, []byte(communityID)...)
+}
+
+// tagClaimKey key of a specific community tag <-> claim association from the store
+func tagClaimKey(communityID string, claimID uint64) []byte {
+	bz := sdk.Uint64ToBigEndian(claimID)
+	return append(tagClaimsKey(communityID), bz...)
+}
+
 func creatorClaimsKey(creator sdk.AccAddress) []byte {
 	return append(CreatorClaimsPrefix, creator.Bytes()...)
 }
@@ -58,3 +140,85 @@ func createdTimeClaimKey(createdTime time.Time, claimID uint64) []byte {
 	bz := sdk.Uint64ToBigEndian(claimID)
 	return append(createdTimeClaimsKey(createdTime), bz...)
 }
+
+// statusClaimsKey gets the first part of the status claims key based on the status
+func statusClaimsKey(status Status) []byte {
+	return append(StatusClaimsPrefix, byte(status))
+}
+
+// statusClaimKey key of a specific status <-> claim association from the store
+func statusClaimKey(status Status, claimID uint64) []byte {
+	bz := sdk.Uint64ToBigEndian(claimID)
+	return append(statusClaimsKey(status), bz...)
+}
+
+// sourceClaimKey key of a community <-> normalized source association
+func sourceClaimKey(communityID string, sourceHash []byte) []byte {
+	return append(append(SourceClaimPrefix, []byte(communityID)...), sourceHash...)
+}
+
+// bodyClaimKey key of a community <-> normalized body association
+func bodyClaimKey(communityID string, bodyHash []byte) []byte {
+	return append(append(BodyClaimPrefix, []byte(communityID)...), bodyHash...)
+}
+
+// sortedClaimsPrefix gets the prefix of the claims index ordered by the sort key
+func sortedClaimsPrefix(sortKey SortKey) []byte {
+	switch sortKey {
+	case SortByTotalStakers:
+		return StakersClaimsPrefix
+	case SortByFirstArgumentTime:
+		return FirstArgumentClaimsPrefix
+	default:
+		return TotalStakeClaimsPrefix
+	}
+}
+
+// totalStakeClaimKey key of a total stake <-> claim association.
+// Stake totals are in the smallest denomination and fit in 8 bytes.
+func totalStakeClaimKey(totalStake sdk.Int, claimID uint64) []byte {
+	key := append(TotalStakeClaimsPrefix, sdk.Uint64ToBigEndian(uint64(totalStake.Int64()))...)
+	return append(key, sdk.Uint64ToBigEndian(claimID)...)
+}
+
+// stakersClaimKey key of a total stakers <-> claim association
+func stakersClaimKey(totalStakers, claimID uint64) []byte {
+	key := append(StakersClaimsPrefix, sdk.Uint64ToBigEndian(totalStakers)...)
+	return append(key, sdk.Uint64ToBigEndian(claimID)...)
+}
+
+// firstArgumentClaimKey key of a first argument time <-> claim association
+func firstArgumentClaimKey(firstArgumentTime time.Time, claimID uint64) []byte {
+	key := append(FirstArgumentClaimsPrefix, sdk.FormatTimeBytes(firstArgumentTime)...)
+	return append(key, sdk.Uint64ToBigEndian(claimID)...)
+}
+
+// moderationQueueKey key of a claim in the moderation queue, ordered by flag count
+func moderationQueueKey(flagCount, claimID uint64) []byte {
+	key := append(ModerationQueuePrefix, sdk.Uint64ToBigEndian(flagCount)...)
+	return append(key, sdk.Uint64ToBigEndian(claimID)...)
+}
+
+// closingClaimQueueKey
+// 0x40<closing_time><claim_id>
+func closingClaimQueueKey(closingTime time.Time, claimID uint64) []byte {
+	bz := sdk.Uint64ToBigEndian(claimID)
+	return append(closingClaimByTimeKey(closingTime), bz...)
+}
+
+// closingClaimByTimeKey gets the closing claim queue key by closingTime
+func closingClaimByTimeKey(closingTime time.Time) []byte {
+	return append(ClosingClaimQueuePrefix, sdk.FormatTimeBytes(closingTime)...)
+}
+
+// archivingClaimQueueKey
+// 0x41<archive_time><claim_id>
+func archivingClaimQueueKey(archiveTime time.Time, claimID uint64) []byte {
+	bz := sdk.Uint64ToBigEndian(claimID)
+	return append(archivingClaimByTimeKey(archiveTime), bz...)
+}
+
+// archivingClaimByTimeKey gets the archiving claim queue key by archiveTime
+func archivingClaimByTimeKey(archiveTime time.Time) []byte {
+	return append(ArchivingClaimQueuePrefix, sdk.FormatTimeBytes(archiveTime)...)
+}
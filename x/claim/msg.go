@@ -2,6 +2,7 @@ package claim
 
 import (
 	"fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -15,14 +16,44 @@ const (
 	TypeMsgRemoveAdmin = "remove_admin"
 	// TypeMsgUpdateParams represents the type of
 	TypeMsgUpdateParams = "update_params"
+	// TypeMsgReopenClaim represents the type of message for reopening a closed claim
+	TypeMsgReopenClaim = "reopen_claim"
+	// TypeMsgDeleteClaim represents the type of message for deleting a claim
+	TypeMsgDeleteClaim = "delete_claim"
+	// TypeMsgProposeClaimEdit represents the type of message for proposing a claim correction
+	TypeMsgProposeClaimEdit = "propose_claim_edit"
+	// TypeMsgReviewClaimEdit represents the type of message for approving or rejecting a claim correction
+	TypeMsgReviewClaimEdit = "review_claim_edit"
+	// TypeMsgAddClaimTag represents the type of message for tagging a claim with a community
+	TypeMsgAddClaimTag = "add_claim_tag"
+	// TypeMsgRemoveClaimTag represents the type of message for removing a community tag from a claim
+	TypeMsgRemoveClaimTag = "remove_claim_tag"
+	// TypeMsgFlagClaim represents the type of message for flagging a claim
+	TypeMsgFlagClaim = "flag_claim"
+	// TypeMsgResolveClaimFlags represents the type of message for upholding the flags on a claim
+	TypeMsgResolveClaimFlags = "resolve_claim_flags"
+	// TypeMsgDismissClaimFlags represents the type of message for rejecting the flags on a claim
+	TypeMsgDismissClaimFlags = "dismiss_claim_flags"
+	// TypeMsgEditClaimEvidence represents the type of message for replacing the evidence of a claim
+	TypeMsgEditClaimEvidence = "edit_claim_evidence"
 )
 
 // verify interface at compile time
 var _ sdk.Msg = &MsgCreateClaim{}
 var _ sdk.Msg = &MsgEditClaim{}
+var _ sdk.Msg = &MsgDeleteClaim{}
 var _ sdk.Msg = &MsgAddAdmin{}
 var _ sdk.Msg = &MsgRemoveAdmin{}
 var _ sdk.Msg = &MsgUpdateParams{}
+var _ sdk.Msg = &MsgReopenClaim{}
+var _ sdk.Msg = &MsgProposeClaimEdit{}
+var _ sdk.Msg = &MsgReviewClaimEdit{}
+var _ sdk.Msg = &MsgAddClaimTag{}
+var _ sdk.Msg = &MsgRemoveClaimTag{}
+var _ sdk.Msg = &MsgFlagClaim{}
+var _ sdk.Msg = &MsgResolveClaimFlags{}
+var _ sdk.Msg = &MsgDismissClaimFlags{}
+var _ sdk.Msg = &MsgEditClaimEvidence{}
 
 // MsgCreateClaim defines a message to submit a story
 type MsgCreateClaim struct {
@@ -30,6 +61,10 @@ type MsgCreateClaim struct {
 	Body        string         `json:"body"`
 	Creator     sdk.AccAddress `json:"creator"`
 	Source      string         `json:"source,omitempty"`
+	// AllowDuplicate lets claim admins skip the duplicate claim check
+	AllowDuplicate bool `json:"allow_duplicate,omitempty"`
+	// Evidence is attached to the claim alongside its source
+	Evidence []Evidence `json:"evidence,omitempty"`
 }
 
 // NewMsgCreateClaim creates a new message to create a claim
@@ -78,10 +113,20 @@ func (msg MsgCreateClaim) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sdk.AccAddress(msg.Creator)}
 }
 
-// MsgDeleteClaim defines a message to submit a story
+// MsgDeleteClaim defines the message to delete a claim
 type MsgDeleteClaim struct {
-	ID      uint64         `json:"id"`
-	Creator sdk.AccAddress `json:"creator"`
+	ID     uint64         `json:"id"`
+	Reason string         `json:"reason"`
+	Admin  sdk.AccAddress `json:"admin"`
+}
+
+// NewMsgDeleteClaim returns the message to delete a claim
+func NewMsgDeleteClaim(id uint64, reason string, admin sdk.AccAddress) MsgDeleteClaim {
+	return MsgDeleteClaim{
+		ID:     id,
+		Reason: reason,
+		Admin:  admin,
+	}
 }
 
 // Route is the name of the route for claim
@@ -91,7 +136,7 @@ func (msg MsgDeleteClaim) Route() string {
 
 // Type is the name for the Msg
 func (msg MsgDeleteClaim) Type() string {
-	return ModuleName
+	return TypeMsgDeleteClaim
 }
 
 // ValidateBasic validates basic fields of the Msg
@@ -99,8 +144,11 @@ func (msg MsgDeleteClaim) ValidateBasic() sdk.Error {
 	if msg.ID == 0 {
 		return ErrUnknownClaim(msg.ID)
 	}
-	if len(msg.Creator) == 0 {
-		return sdk.ErrInvalidAddress("Invalid address: " + msg.Creator.String())
+	if len(strings.TrimSpace(msg.Reason)) == 0 {
+		return ErrInvalidDeletionReason()
+	}
+	if len(msg.Admin) == 0 {
+		return sdk.ErrInvalidAddress("Invalid address: " + msg.Admin.String())
 	}
 
 	return nil
@@ -114,7 +162,7 @@ func (msg MsgDeleteClaim) GetSignBytes() []byte {
 
 // GetSigners gets the signs of the Msg
 func (msg MsgDeleteClaim) GetSigners() []sdk.AccAddress {
-	return []sdk.AccAddress{sdk.AccAddress(msg.Creator)}
+	return []sdk.AccAddress{sdk.AccAddress(msg.Admin)}
 }
 
 // MsgEditClaim defines a message to submit a story
@@ -291,3 +339,414 @@ func (msg MsgUpdateParams) GetSignBytes() []byte {
 func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sdk.AccAddress(msg.Updater)}
 }
+
+// MsgReopenClaim defines the message to reopen a closed claim
+type MsgReopenClaim struct {
+	ID    uint64         `json:"id"`
+	Admin sdk.AccAddress `json:"admin"`
+}
+
+// NewMsgReopenClaim returns the message to reopen a closed claim
+func NewMsgReopenClaim(id uint64, admin sdk.AccAddress) MsgReopenClaim {
+	return MsgReopenClaim{
+		ID:    id,
+		Admin: admin,
+	}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgReopenClaim) ValidateBasic() sdk.Error {
+	if msg.ID == 0 {
+		return ErrUnknownClaim(msg.ID)
+	}
+	if len(msg.Admin) == 0 {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Invalid address: %s", msg.Admin.String()))
+	}
+
+	return nil
+}
+
+// Route implements Msg
+func (msg MsgReopenClaim) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgReopenClaim) Type() string { return TypeMsgReopenClaim }
+
+// GetSignBytes implements Msg
+func (msg MsgReopenClaim) GetSignBytes() []byte {
+	msgBytes := ModuleCodec.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(msgBytes)
+}
+
+// GetSigners implements Msg. Returns the admin as the signer.
+func (msg MsgReopenClaim) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Admin)}
+}
+
+// MsgProposeClaimEdit defines the message to propose a correction to a claim body
+type MsgProposeClaimEdit struct {
+	ClaimID  uint64         `json:"claim_id"`
+	Body     string         `json:"body"`
+	Proposer sdk.AccAddress `json:"proposer"`
+}
+
+// NewMsgProposeClaimEdit returns the message to propose a correction to a claim body
+func NewMsgProposeClaimEdit(claimID uint64, body string, proposer sdk.AccAddress) MsgProposeClaimEdit {
+	return MsgProposeClaimEdit{
+		ClaimID:  claimID,
+		Body:     body,
+		Proposer: proposer,
+	}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgProposeClaimEdit) ValidateBasic() sdk.Error {
+	if msg.ClaimID == 0 {
+		return ErrUnknownClaim(msg.ClaimID)
+	}
+	if len(msg.Body) == 0 {
+		return ErrInvalidBodyTooShort(msg.Body)
+	}
+	if len(msg.Proposer) == 0 {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Invalid address: %s", msg.Proposer.String()))
+	}
+
+	return nil
+}
+
+// Route implements Msg
+func (msg MsgProposeClaimEdit) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgProposeClaimEdit) Type() string { return TypeMsgProposeClaimEdit }
+
+// GetSignBytes implements Msg
+func (msg MsgProposeClaimEdit) GetSignBytes() []byte {
+	msgBytes := ModuleCodec.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(msgBytes)
+}
+
+// GetSigners implements Msg. Returns the proposer as the signer.
+func (msg MsgProposeClaimEdit) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Proposer)}
+}
+
+// MsgReviewClaimEdit defines the message to approve or reject a claim edit proposal
+type MsgReviewClaimEdit struct {
+	ProposalID uint64         `json:"proposal_id"`
+	Approve    bool           `json:"approve"`
+	Admin      sdk.AccAddress `json:"admin"`
+}
+
+// NewMsgReviewClaimEdit returns the message to approve or reject a claim edit proposal
+func NewMsgReviewClaimEdit(proposalID uint64, approve bool, admin sdk.AccAddress) MsgReviewClaimEdit {
+	return MsgReviewClaimEdit{
+		ProposalID: proposalID,
+		Approve:    approve,
+		Admin:      admin,
+	}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgReviewClaimEdit) ValidateBasic() sdk.Error {
+	if msg.ProposalID == 0 {
+		return ErrUnknownEditProposal(msg.ProposalID)
+	}
+	if len(msg.Admin) == 0 {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Invalid address: %s", msg.Admin.String()))
+	}
+
+	return nil
+}
+
+// Route implements Msg
+func (msg MsgReviewClaimEdit) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgReviewClaimEdit) Type() string { return TypeMsgReviewClaimEdit }
+
+// GetSignBytes implements Msg
+func (msg MsgReviewClaimEdit) GetSignBytes() []byte {
+	msgBytes := ModuleCodec.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(msgBytes)
+}
+
+// GetSigners implements Msg. Returns the admin as the signer.
+func (msg MsgReviewClaimEdit) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Admin)}
+}
+
+// MsgAddClaimTag defines the message to tag a claim with a secondary community
+type MsgAddClaimTag struct {
+	ClaimID     uint64         `json:"claim_id"`
+	CommunityID string         `json:"community_id"`
+	Admin       sdk.AccAddress `json:"admin"`
+}
+
+// NewMsgAddClaimTag returns the message to tag a claim with a secondary community
+func NewMsgAddClaimTag(claimID uint64, communityID string, admin sdk.AccAddress) MsgAddClaimTag {
+	return MsgAddClaimTag{
+		ClaimID:     claimID,
+		CommunityID: communityID,
+		Admin:       admin,
+	}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgAddClaimTag) ValidateBasic() sdk.Error {
+	if msg.ClaimID == 0 {
+		return ErrUnknownClaim(msg.ClaimID)
+	}
+	if len(msg.CommunityID) == 0 {
+		return ErrInvalidCommunityID(msg.CommunityID)
+	}
+	if len(msg.Admin) == 0 {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Invalid address: %s", msg.Admin.String()))
+	}
+
+	return nil
+}
+
+// Route implements Msg
+func (msg MsgAddClaimTag) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgAddClaimTag) Type() string { return TypeMsgAddClaimTag }
+
+// GetSignBytes implements Msg
+func (msg MsgAddClaimTag) GetSignBytes() []byte {
+	msgBytes := ModuleCodec.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(msgBytes)
+}
+
+// GetSigners implements Msg. Returns the admin as the signer.
+func (msg MsgAddClaimTag) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Admin)}
+}
+
+// MsgRemoveClaimTag defines the message to remove a secondary community from a claim
+type MsgRemoveClaimTag struct {
+	ClaimID     uint64         `json:"claim_id"`
+	CommunityID string         `json:"community_id"`
+	Admin       sdk.AccAddress `json:"admin"`
+}
+
+// NewMsgRemoveClaimTag returns the message to remove a secondary community from a claim
+func NewMsgRemoveClaimTag(claimID uint64, communityID string, admin sdk.AccAddress) MsgRemoveClaimTag {
+	return MsgRemoveClaimTag{
+		ClaimID:     claimID,
+		CommunityID: communityID,
+		Admin:       admin,
+	}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgRemoveClaimTag) ValidateBasic() sdk.Error {
+	if msg.ClaimID == 0 {
+		return ErrUnknownClaim(msg.ClaimID)
+	}
+	if len(msg.CommunityID) == 0 {
+		return ErrInvalidCommunityID(msg.CommunityID)
+	}
+	if len(msg.Admin) == 0 {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Invalid address: %s", msg.Admin.String()))
+	}
+
+	return nil
+}
+
+// Route implements Msg
+func (msg MsgRemoveClaimTag) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgRemoveClaimTag) Type() string { return TypeMsgRemoveClaimTag }
+
+// GetSignBytes implements Msg
+func (msg MsgRemoveClaimTag) GetSignBytes() []byte {
+	msgBytes := ModuleCodec.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(msgBytes)
+}
+
+// GetSigners implements Msg. Returns the admin as the signer.
+func (msg MsgRemoveClaimTag) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Admin)}
+}
+
+// MsgFlagClaim defines the message to report a bad claim
+type MsgFlagClaim struct {
+	ClaimID uint64         `json:"claim_id"`
+	Reason  FlagReason     `json:"reason"`
+	Creator sdk.AccAddress `json:"creator"`
+}
+
+// NewMsgFlagClaim returns the message to flag a claim
+func NewMsgFlagClaim(claimID uint64, reason FlagReason, creator sdk.AccAddress) MsgFlagClaim {
+	return MsgFlagClaim{
+		ClaimID: claimID,
+		Reason:  reason,
+		Creator: creator,
+	}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgFlagClaim) ValidateBasic() sdk.Error {
+	if msg.ClaimID == 0 {
+		return ErrUnknownClaim(msg.ClaimID)
+	}
+	if !msg.Reason.Valid() {
+		return ErrInvalidFlagReason(msg.Reason)
+	}
+	if len(msg.Creator) == 0 {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Invalid address: %s", msg.Creator.String()))
+	}
+
+	return nil
+}
+
+// Route implements Msg
+func (msg MsgFlagClaim) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgFlagClaim) Type() string { return TypeMsgFlagClaim }
+
+// GetSignBytes implements Msg
+func (msg MsgFlagClaim) GetSignBytes() []byte {
+	msgBytes := ModuleCodec.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(msgBytes)
+}
+
+// GetSigners implements Msg. Returns the creator as the signer.
+func (msg MsgFlagClaim) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Creator)}
+}
+
+// MsgResolveClaimFlags defines the message to uphold the flags on a claim
+type MsgResolveClaimFlags struct {
+	ClaimID uint64         `json:"claim_id"`
+	Admin   sdk.AccAddress `json:"admin"`
+}
+
+// NewMsgResolveClaimFlags returns the message to uphold the flags on a claim
+func NewMsgResolveClaimFlags(claimID uint64, admin sdk.AccAddress) MsgResolveClaimFlags {
+	return MsgResolveClaimFlags{
+		ClaimID: claimID,
+		Admin:   admin,
+	}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgResolveClaimFlags) ValidateBasic() sdk.Error {
+	if msg.ClaimID == 0 {
+		return ErrUnknownClaim(msg.ClaimID)
+	}
+	if len(msg.Admin) == 0 {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Invalid address: %s", msg.Admin.String()))
+	}
+
+	return nil
+}
+
+// Route implements Msg
+func (msg MsgResolveClaimFlags) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgResolveClaimFlags) Type() string { return TypeMsgResolveClaimFlags }
+
+// GetSignBytes implements Msg
+func (msg MsgResolveClaimFlags) GetSignBytes() []byte {
+	msgBytes := ModuleCodec.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(msgBytes)
+}
+
+// GetSigners implements Msg. Returns the admin as the signer.
+func (msg MsgResolveClaimFlags) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Admin)}
+}
+
+// MsgDismissClaimFlags defines the message to reject the flags on a claim
+type MsgDismissClaimFlags struct {
+	ClaimID uint64         `json:"claim_id"`
+	Admin   sdk.AccAddress `json:"admin"`
+}
+
+// NewMsgDismissClaimFlags returns the message to reject the flags on a claim
+func NewMsgDismissClaimFlags(claimID uint64, admin sdk.AccAddress) MsgDismissClaimFlags {
+	return MsgDismissClaimFlags{
+		ClaimID: claimID,
+		Admin:   admin,
+	}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgDismissClaimFlags) ValidateBasic() sdk.Error {
+	if msg.ClaimID == 0 {
+		return ErrUnknownClaim(msg.ClaimID)
+	}
+	if len(msg.Admin) == 0 {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Invalid address: %s", msg.Admin.String()))
+	}
+
+	return nil
+}
+
+// Route implements Msg
+func (msg MsgDismissClaimFlags) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgDismissClaimFlags) Type() string { return TypeMsgDismissClaimFlags }
+
+// GetSignBytes implements Msg
+func (msg MsgDismissClaimFlags) GetSignBytes() []byte {
+	msgBytes := ModuleCodec.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(msgBytes)
+}
+
+// GetSigners implements Msg. Returns the admin as the signer.
+func (msg MsgDismissClaimFlags) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Admin)}
+}
+
+// MsgEditClaimEvidence defines the message to replace the evidence attached to a claim
+type MsgEditClaimEvidence struct {
+	ID       uint64         `json:"id"`
+	Evidence []Evidence     `json:"evidence"`
+	Editor   sdk.AccAddress `json:"editor"`
+}
+
+// NewMsgEditClaimEvidence returns the message to replace the evidence attached to a claim
+func NewMsgEditClaimEvidence(id uint64, evidence []Evidence, editor sdk.AccAddress) MsgEditClaimEvidence {
+	return MsgEditClaimEvidence{
+		ID:       id,
+		Evidence: evidence,
+		Editor:   editor,
+	}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgEditClaimEvidence) ValidateBasic() sdk.Error {
+	if msg.ID == 0 {
+		return ErrUnknownClaim(msg.ID)
+	}
+	if len(msg.Editor) == 0 {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Invalid address: %s", msg.Editor.String()))
+	}
+
+	return nil
+}
+
+// Route implements Msg
+func (msg MsgEditClaimEvidence) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgEditClaimEvidence) Type() string { return TypeMsgEditClaimEvidence }
+
+// GetSignBytes implements Msg
+func (msg MsgEditClaimEvidence) GetSignBytes() []byte {
+	msgBytes := ModuleCodec.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(msgBytes)
+}
+
+// GetSigners implements Msg. Returns the editor as the signer.
+func (msg MsgEditClaimEvidence) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Editor)}
+}
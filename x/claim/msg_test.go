@@ -67,3 +67,16 @@ func TestMsgRemoveAdmin_InvalidAdmin(t *testing.T) {
 	assert.NotNil(t, err)
 	assert.Equal(t, sdk.ErrInvalidAddress("").Code(), err.Code())
 }
+
+func TestMsgFlagClaim_InvalidReason(t *testing.T) {
+	creator := sdk.AccAddress([]byte{1, 2})
+
+	msg := NewMsgFlagClaim(1, FlagReason(20), creator)
+	err := msg.ValidateBasic()
+	assert.NotNil(t, err)
+	assert.Equal(t, ErrorCodeInvalidFlagReason, err.Code())
+
+	msg = NewMsgFlagClaim(1, FlagReasonSpam, creator)
+	assert.Nil(t, msg.ValidateBasic())
+	assert.Equal(t, TypeMsgFlagClaim, msg.Type())
+}
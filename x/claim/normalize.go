@@ -0,0 +1,86 @@
+package claim
+
+import (
+	"crypto/sha256"
+	"net/url"
+	"strings"
+)
+
+// trackingParams are query params that don't change the resource a URL points to
+var trackingParams = []string{
+	"fbclid", "gclid", "dclid", "msclkid", "igshid",
+	"mc_cid", "mc_eid", "ref", "ref_src", "ref_url",
+}
+
+// trackingParamPrefixes are prefixes of query params added by campaign trackers
+var trackingParamPrefixes = []string{"utm_"}
+
+// normalizeSourceURL returns a canonical form of a claim source so that
+// links to the same article compare equal. Returns "" for an empty source.
+func normalizeSourceURL(source url.URL) string {
+	if source.Host == "" {
+		return ""
+	}
+
+	scheme := strings.ToLower(source.Scheme)
+	// http and https point to the same resource
+	if scheme == "http" {
+		scheme = "https"
+	}
+
+	host := strings.ToLower(source.Hostname())
+	port := source.Port()
+	if port != "" && port != "80" && port != "443" {
+		host = host + ":" + port
+	}
+
+	query := source.Query()
+	for param := range query {
+		if isTrackingParam(param) {
+			query.Del(param)
+		}
+	}
+
+	normalized := url.URL{
+		Scheme: scheme,
+		Host:   host,
+		Path:   strings.TrimRight(source.Path, "/"),
+		// Encode sorts the params by key
+		RawQuery: query.Encode(),
+	}
+
+	return normalized.String()
+}
+
+func isTrackingParam(param string) bool {
+	param = strings.ToLower(param)
+	for _, p := range trackingParams {
+		if param == p {
+			return true
+		}
+	}
+	for _, prefix := range trackingParamPrefixes {
+		if strings.HasPrefix(param, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// sourceHash hashes the normalized claim source, nil for an empty source
+func sourceHash(source url.URL) []byte {
+	normalized := normalizeSourceURL(source)
+	if normalized == "" {
+		return nil
+	}
+	hash := sha256.Sum256([]byte(normalized))
+	return hash[:]
+}
+
+// bodyHash hashes the claim body after collapsing whitespace and lowercasing it
+func bodyHash(body string) []byte {
+	normalized := strings.ToLower(strings.Join(strings.Fields(body), " "))
+	hash := sha256.Sum256([]byte(normalized))
+	return hash[:]
+}
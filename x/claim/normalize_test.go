@@ -0,0 +1,33 @@
+package claim
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeSourceURL(t *testing.T) {
+	tests := []struct {
+		source   string
+		expected string
+	}{
+		{"", ""},
+		{"http://TruStory.io/claims/", "https://trustory.io/claims"},
+		{"HTTPS://trustory.io:443/claims//", "https://trustory.io/claims"},
+		{"https://trustory.io:8080/claims", "https://trustory.io:8080/claims"},
+		{"https://trustory.io/claims?utm_source=twitter&id=2&fbclid=abc&a=1", "https://trustory.io/claims?a=1&id=2"},
+		{"https://trustory.io/claims#comments", "https://trustory.io/claims"},
+	}
+	for _, tt := range tests {
+		source, err := url.Parse(tt.source)
+		assert.NoError(t, err)
+		assert.Equal(t, tt.expected, normalizeSourceURL(*source), tt.source)
+	}
+}
+
+func TestBodyHash(t *testing.T) {
+	hash := bodyHash("Blockchains will   allow communities\tto self govern")
+	assert.Equal(t, hash, bodyHash("  blockchains WILL allow communities to self govern\n"))
+	assert.NotEqual(t, hash, bodyHash("blockchains will allow communities to self-govern"))
+}
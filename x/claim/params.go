@@ -3,16 +3,29 @@ package claim
 import (
 	"fmt"
 	"reflect"
+	"time"
 
+	app "github.com/TruStory/truchain/types"
+	"github.com/TruStory/truchain/x/community"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
 )
 
 // Keys for params
 var (
-	KeyMinClaimLength = []byte("minClaimLength")
-	KeyMaxClaimLength = []byte("maxClaimLength")
-	KeyClaimAdmins    = []byte("claimAdmins")
+	KeyMinClaimLength       = []byte("minClaimLength")
+	KeyMaxClaimLength       = []byte("maxClaimLength")
+	KeyClaimAdmins          = []byte("claimAdmins")
+	KeyClaimDuration        = []byte("claimDuration")
+	KeyArchiveDuration      = []byte("archiveDuration")
+	KeyVerdictThreshold     = []byte("verdictThreshold")
+	KeyClaimCreationStake   = []byte("claimCreationStake")
+	KeyMaxCommunityTags     = []byte("maxCommunityTags")
+	KeyFlagMinEarnedStake   = []byte("flagMinEarnedStake")
+	KeyFlagHideThreshold    = []byte("flagHideThreshold")
+	KeyMaxEvidenceCount     = []byte("maxEvidenceCount")
+	KeyMaxEvidenceURILength = []byte("maxEvidenceURILength")
+	KeyClaimFeeShare        = []byte("claimFeeShare")
 )
 
 // Params holds parameters for a Claim
@@ -20,14 +33,44 @@ type Params struct {
 	MinClaimLength int              `json:"min_claim_length"`
 	MaxClaimLength int              `json:"max_claim_length"`
 	ClaimAdmins    []sdk.AccAddress `json:"claim_admins"`
+	// ClaimDuration is how long a claim stays open after creation
+	ClaimDuration time.Duration `json:"claim_duration"`
+	// ArchiveDuration is how long a claim stays closed before being archived
+	ArchiveDuration time.Duration `json:"archive_duration"`
+	// VerdictThreshold is the share of the total stake a side needs to win a claim
+	VerdictThreshold sdk.Dec `json:"verdict_threshold"`
+	// ClaimCreationStake is held from the creator until the claim closes
+	ClaimCreationStake sdk.Coin `json:"claim_creation_stake"`
+	// MaxCommunityTags is the number of secondary communities a claim can be tagged with
+	MaxCommunityTags int `json:"max_community_tags"`
+	// FlagMinEarnedStake is the amount a user must have earned to flag claims
+	FlagMinEarnedStake sdk.Coin `json:"flag_min_earned_stake"`
+	// FlagHideThreshold is the number of flags that hides a claim until it is moderated
+	FlagHideThreshold int `json:"flag_hide_threshold"`
+	// MaxEvidenceCount is the number of pieces of evidence a claim can have
+	MaxEvidenceCount int `json:"max_evidence_count"`
+	// MaxEvidenceURILength is the maximum length of an evidence URI
+	MaxEvidenceURILength int `json:"max_evidence_uri_length"`
+	// ClaimFeeShare is the share of the claim creation stake kept as a fee for the community treasury
+	ClaimFeeShare sdk.Dec `json:"claim_fee_share"`
 }
 
 // DefaultParams is the Claim params for testing
 func DefaultParams() Params {
 	return Params{
-		MinClaimLength: 25,
-		MaxClaimLength: 140,
-		ClaimAdmins:    []sdk.AccAddress{},
+		MinClaimLength:       25,
+		MaxClaimLength:       140,
+		ClaimAdmins:          []sdk.AccAddress{},
+		ClaimDuration:        time.Hour * 24 * 30,
+		ArchiveDuration:      time.Hour * 24 * 90,
+		VerdictThreshold:     sdk.NewDecWithPrec(67, 2),
+		ClaimCreationStake:   sdk.NewInt64Coin(app.StakeDenom, app.Shanev*10),
+		MaxCommunityTags:     3,
+		FlagMinEarnedStake:   sdk.NewInt64Coin(app.StakeDenom, app.Shanev*10),
+		FlagHideThreshold:    5,
+		MaxEvidenceCount:     10,
+		MaxEvidenceURILength: 2048,
+		ClaimFeeShare:        sdk.NewDecWithPrec(10, 2),
 	}
 }
 
@@ -37,6 +80,16 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		{Key: KeyMinClaimLength, Value: &p.MinClaimLength},
 		{Key: KeyMaxClaimLength, Value: &p.MaxClaimLength},
 		{Key: KeyClaimAdmins, Value: &p.ClaimAdmins},
+		{Key: KeyClaimDuration, Value: &p.ClaimDuration},
+		{Key: KeyArchiveDuration, Value: &p.ArchiveDuration},
+		{Key: KeyVerdictThreshold, Value: &p.VerdictThreshold},
+		{Key: KeyClaimCreationStake, Value: &p.ClaimCreationStake},
+		{Key: KeyMaxCommunityTags, Value: &p.MaxCommunityTags},
+		{Key: KeyFlagMinEarnedStake, Value: &p.FlagMinEarnedStake},
+		{Key: KeyFlagHideThreshold, Value: &p.FlagHideThreshold},
+		{Key: KeyMaxEvidenceCount, Value: &p.MaxEvidenceCount},
+		{Key: KeyMaxEvidenceURILength, Value: &p.MaxEvidenceURILength},
+		{Key: KeyClaimFeeShare, Value: &p.ClaimFeeShare},
 	}
 }
 
@@ -52,6 +105,30 @@ func (k Keeper) GetParams(ctx sdk.Context) Params {
 	return paramSet
 }
 
+// EffectiveParams gets the claim params inside a community, the global params
+// with the community param overrides applied
+func (k Keeper) EffectiveParams(ctx sdk.Context, communityID string) Params {
+	params := k.GetParams(ctx)
+	overrides := k.communityKeeper.EffectiveOverrides(ctx, communityID)
+	if overrides.MinClaimLength != nil {
+		params.MinClaimLength = *overrides.MinClaimLength
+	}
+	if overrides.MaxClaimLength != nil {
+		params.MaxClaimLength = *overrides.MaxClaimLength
+	}
+
+	return params
+}
+
+// GlobalParamOverrides returns the global claim params that communities can override
+func (k Keeper) GlobalParamOverrides(ctx sdk.Context) community.ParamOverrides {
+	params := k.GetParams(ctx)
+	return community.ParamOverrides{
+		MinClaimLength: &params.MinClaimLength,
+		MaxClaimLength: &params.MaxClaimLength,
+	}
+}
+
 // SetParams sets the params for the claim
 func (k Keeper) SetParams(ctx sdk.Context, params Params) {
 	k.paramStore.SetParamSet(ctx, &params)
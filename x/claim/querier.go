@@ -19,6 +19,17 @@ const (
 	QueryClaimsIDRange     = "claims_id_range"
 	QueryClaimsBeforeTime  = "claims_before_time"
 	QueryClaimsAfterTime   = "claims_after_time"
+	QueryClaimsByStatus    = "claims_by_status"
+	QueryClaimsSorted      = "claims_sorted"
+	QueryClaimResult       = "claim_result"
+	QueryClaimResults      = "claim_results"
+	QueryClaimRevisions    = "claim_revisions"
+	QueryEditProposals     = "claim_edit_proposals"
+	QueryClaimFlags        = "claim_flags"
+	QueryModerationQueue   = "moderation_queue"
+	QueryClaimEvidence     = "claim_evidence"
+	QueryFollowedClaims    = "followed_claims"
+	QueryEffectiveParams   = "effective_params"
 	QueryParams            = "params"
 )
 
@@ -35,6 +46,8 @@ type QueryClaimsParams struct {
 // QueryCommunityClaimsParams for community claims
 type QueryCommunityClaimsParams struct {
 	CommunityID string `json:"community_id"`
+	// IncludeDescendants adds the claims of every community nested under the community
+	IncludeDescendants bool `json:"include_descendants,omitempty"`
 }
 
 // QueryCommunitiesClaimsParams for communities claims
@@ -58,6 +71,30 @@ type QueryClaimsTimeParams struct {
 	CreatedTime time.Time `json:"created_time"`
 }
 
+// QueryClaimsByStatusParams for claims by status
+type QueryClaimsByStatusParams struct {
+	Status Status `json:"status"`
+}
+
+// QueryClaimsSortedParams for claims ordered by activity
+type QueryClaimsSortedParams struct {
+	SortBy      SortKey `json:"sort_by"`
+	CommunityID string  `json:"community_id,omitempty"`
+	// Page starts at 1, 0 is the first page
+	Page int `json:"page,omitempty"`
+	// Limit is the page size, 0 returns every claim
+	Limit int `json:"limit,omitempty"`
+}
+
+// QueryFollowedClaimsParams for the claims of the communities a user follows
+type QueryFollowedClaimsParams struct {
+	Address sdk.AccAddress `json:"address"`
+	// Page starts at 1, 0 is the first page
+	Page int `json:"page,omitempty"`
+	// Limit is the page size, 0 returns every claim
+	Limit int `json:"limit,omitempty"`
+}
+
 // NewQuerier returns a function that handles queries on the KVStore
 func NewQuerier(keeper Keeper) sdk.Querier {
 	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
@@ -80,6 +117,28 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryClaimsBeforeTime(ctx, req, keeper)
 		case QueryClaimsAfterTime:
 			return queryClaimsAfterTime(ctx, req, keeper)
+		case QueryClaimsByStatus:
+			return queryClaimsByStatus(ctx, req, keeper)
+		case QueryClaimsSorted:
+			return queryClaimsSorted(ctx, req, keeper)
+		case QueryClaimResult:
+			return queryClaimResult(ctx, req, keeper)
+		case QueryClaimResults:
+			return queryClaimResults(ctx, req, keeper)
+		case QueryClaimRevisions:
+			return queryClaimRevisions(ctx, req, keeper)
+		case QueryEditProposals:
+			return queryEditProposals(ctx, req, keeper)
+		case QueryClaimFlags:
+			return queryClaimFlags(ctx, req, keeper)
+		case QueryModerationQueue:
+			return queryModerationQueue(ctx, keeper)
+		case QueryClaimEvidence:
+			return queryClaimEvidence(ctx, req, keeper)
+		case QueryFollowedClaims:
+			return queryFollowedClaims(ctx, req, keeper)
+		case QueryEffectiveParams:
+			return queryEffectiveParams(ctx, req, keeper)
 		case QueryParams:
 			return queryParams(ctx, keeper)
 		}
@@ -103,10 +162,29 @@ func queryClaim(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte,
 	return mustMarshal(claim)
 }
 
+func queryClaimEvidence(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params QueryClaimParams
+	codecErr := ModuleCodec.UnmarshalJSON(req.Data, &params)
+	if codecErr != nil {
+		return nil, ErrJSONParse(codecErr)
+	}
+
+	claim, ok := keeper.Claim(ctx, params.ID)
+	if !ok {
+		return nil, ErrUnknownClaim(params.ID)
+	}
+	evidence := claim.Evidence
+	if evidence == nil {
+		evidence = make([]Evidence, 0)
+	}
+
+	return mustMarshal(evidence)
+}
+
 func queryClaims(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
 	claims := keeper.Claims(ctx)
 
-	return mustMarshal(claims)
+	return mustMarshal(visibleClaims(claims))
 }
 
 func queryClaimsByIDs(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
@@ -135,8 +213,11 @@ func queryCommunityClaims(ctx sdk.Context, req abci.RequestQuery, keeper Keeper)
 		return nil, ErrJSONParse(codecErr)
 	}
 	claims := keeper.CommunityClaims(ctx, params.CommunityID)
+	if params.IncludeDescendants {
+		claims = keeper.CommunityTreeClaims(ctx, params.CommunityID)
+	}
 
-	return mustMarshal(claims)
+	return mustMarshal(visibleClaims(claims))
 }
 
 func queryCommunitiesClaims(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
@@ -146,12 +227,19 @@ func queryCommunitiesClaims(ctx sdk.Context, req abci.RequestQuery, keeper Keepe
 		return nil, ErrJSONParse(codecErr)
 	}
 	claims := make([]Claim, 0)
+	// a claim tagged with several of the communities is only returned once
+	seen := make(map[uint64]bool)
 	for _, community := range params.CommunityIDs {
-		communityClaims := keeper.CommunityClaims(ctx, community)
-		claims = append(claims, communityClaims...)
+		for _, claim := range keeper.CommunityClaims(ctx, community) {
+			if seen[claim.ID] {
+				continue
+			}
+			seen[claim.ID] = true
+			claims = append(claims, claim)
+		}
 	}
 
-	return mustMarshal(claims)
+	return mustMarshal(visibleClaims(claims))
 }
 
 func queryCreatorClaims(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
@@ -162,7 +250,7 @@ func queryCreatorClaims(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (
 	}
 	claims := keeper.CreatorClaims(ctx, params.Creator)
 
-	return mustMarshal(claims)
+	return mustMarshal(visibleClaims(claims))
 }
 
 func queryClaimsByIDRange(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
@@ -173,7 +261,7 @@ func queryClaimsByIDRange(ctx sdk.Context, req abci.RequestQuery, keeper Keeper)
 	}
 	claims := keeper.ClaimsBetweenIDs(ctx, params.StartID, params.EndID)
 
-	return mustMarshal(claims)
+	return mustMarshal(visibleClaims(claims))
 }
 
 func queryClaimsBeforeTime(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
@@ -184,7 +272,7 @@ func queryClaimsBeforeTime(ctx sdk.Context, req abci.RequestQuery, keeper Keeper
 	}
 	claims := keeper.ClaimsBeforeTime(ctx, params.CreatedTime)
 
-	return mustMarshal(claims)
+	return mustMarshal(visibleClaims(claims))
 }
 
 func queryClaimsAfterTime(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
@@ -195,9 +283,154 @@ func queryClaimsAfterTime(ctx sdk.Context, req abci.RequestQuery, keeper Keeper)
 	}
 	claims := keeper.ClaimsAfterTime(ctx, params.CreatedTime)
 
+	return mustMarshal(visibleClaims(claims))
+}
+
+func queryClaimsByStatus(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params QueryClaimsByStatusParams
+	codecErr := ModuleCodec.UnmarshalJSON(req.Data, &params)
+	if codecErr != nil {
+		return nil, ErrJSONParse(codecErr)
+	}
+	if !params.Status.Valid() {
+		return nil, ErrInvalidStatus(params.Status)
+	}
+	claims := keeper.ClaimsByStatus(ctx, params.Status)
+
+	return mustMarshal(visibleClaims(claims))
+}
+
+func queryClaimsSorted(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params QueryClaimsSortedParams
+	codecErr := ModuleCodec.UnmarshalJSON(req.Data, &params)
+	if codecErr != nil {
+		return nil, ErrJSONParse(codecErr)
+	}
+	if !params.SortBy.Valid() {
+		return nil, ErrInvalidSortKey(params.SortBy)
+	}
+	if params.Limit < 0 {
+		return nil, ErrInvalidPageLimit(params.Limit)
+	}
+	claims := keeper.ClaimsSorted(ctx, params.SortBy, params.CommunityID, params.Page, params.Limit)
+
 	return mustMarshal(claims)
 }
 
+func queryFollowedClaims(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params QueryFollowedClaimsParams
+	codecErr := ModuleCodec.UnmarshalJSON(req.Data, &params)
+	if codecErr != nil {
+		return nil, ErrJSONParse(codecErr)
+	}
+	if params.Limit < 0 {
+		return nil, ErrInvalidPageLimit(params.Limit)
+	}
+	claims := keeper.FollowedClaims(ctx, params.Address, params.Page, params.Limit)
+
+	return mustMarshal(claims)
+}
+
+func queryClaimResult(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params QueryClaimParams
+	codecErr := ModuleCodec.UnmarshalJSON(req.Data, &params)
+	if codecErr != nil {
+		return nil, ErrJSONParse(codecErr)
+	}
+
+	claim, ok := keeper.Claim(ctx, params.ID)
+	if !ok {
+		return nil, ErrUnknownClaim(params.ID)
+	}
+	if claim.Result == nil {
+		return nil, ErrClaimNotResolved(params.ID)
+	}
+
+	return mustMarshal(claim.Result)
+}
+
+func queryClaimResults(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params QueryClaimsParams
+	codecErr := ModuleCodec.UnmarshalJSON(req.Data, &params)
+	if codecErr != nil {
+		return nil, ErrJSONParse(codecErr)
+	}
+
+	results := make([]ClaimResult, 0)
+	for _, id := range params.IDs {
+		claim, ok := keeper.Claim(ctx, id)
+		if !ok {
+			return nil, ErrUnknownClaim(id)
+		}
+		// unresolved claims are skipped
+		if claim.Result != nil {
+			results = append(results, *claim.Result)
+		}
+	}
+
+	return mustMarshal(results)
+}
+
+func queryClaimRevisions(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params QueryClaimParams
+	codecErr := ModuleCodec.UnmarshalJSON(req.Data, &params)
+	if codecErr != nil {
+		return nil, ErrJSONParse(codecErr)
+	}
+	if _, ok := keeper.Claim(ctx, params.ID); !ok {
+		return nil, ErrUnknownClaim(params.ID)
+	}
+	revisions := keeper.ClaimRevisions(ctx, params.ID)
+
+	return mustMarshal(revisions)
+}
+
+func queryEditProposals(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params QueryClaimParams
+	codecErr := ModuleCodec.UnmarshalJSON(req.Data, &params)
+	if codecErr != nil {
+		return nil, ErrJSONParse(codecErr)
+	}
+	if _, ok := keeper.Claim(ctx, params.ID); !ok {
+		return nil, ErrUnknownClaim(params.ID)
+	}
+	proposals := keeper.ClaimEditProposals(ctx, params.ID)
+
+	return mustMarshal(proposals)
+}
+
+func queryClaimFlags(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params QueryClaimParams
+	codecErr := ModuleCodec.UnmarshalJSON(req.Data, &params)
+	if codecErr != nil {
+		return nil, ErrJSONParse(codecErr)
+	}
+	if _, ok := keeper.Claim(ctx, params.ID); !ok {
+		return nil, ErrUnknownClaim(params.ID)
+	}
+	flags := keeper.ClaimFlags(ctx, params.ID)
+
+	return mustMarshal(flags)
+}
+
+func queryModerationQueue(ctx sdk.Context, keeper Keeper) ([]byte, sdk.Error) {
+	claims := keeper.ModerationQueue(ctx)
+
+	return mustMarshal(claims)
+}
+
+// visibleClaims filters hidden claims out of the claim feeds
+func visibleClaims(claims Claims) Claims {
+	visible := make(Claims, 0, len(claims))
+	for _, claim := range claims {
+		if !claim.Hidden {
+			visible = append(visible, claim)
+		}
+	}
+
+	return visible
+}
+
 func queryParams(ctx sdk.Context, keeper Keeper) (result []byte, err sdk.Error) {
 	params := keeper.GetParams(ctx)
 
@@ -209,6 +442,16 @@ func queryParams(ctx sdk.Context, keeper Keeper) (result []byte, err sdk.Error)
 	return result, nil
 }
 
+func queryEffectiveParams(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params QueryCommunityClaimsParams
+	codecErr := ModuleCodec.UnmarshalJSON(req.Data, &params)
+	if codecErr != nil {
+		return nil, ErrJSONParse(codecErr)
+	}
+
+	return mustMarshal(keeper.EffectiveParams(ctx, params.CommunityID))
+}
+
 func mustMarshal(v interface{}) (result []byte, err sdk.Error) {
 	result, jsonErr := codec.MarshalJSONIndent(ModuleCodec, v)
 	if jsonErr != nil {
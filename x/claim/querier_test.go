@@ -2,9 +2,15 @@ package claim
 
 import (
 	"fmt"
+	"math"
+	"net/url"
 	"strings"
 	"testing"
+	"time"
 
+	app "github.com/TruStory/truchain/types"
+	"github.com/TruStory/truchain/x/community"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -106,6 +112,51 @@ func TestQueryCommunityClaims(t *testing.T) {
 	require.Equal(t, 1, len(claims))
 }
 
+func TestQueryCommunityClaims_IncludeDescendants(t *testing.T) {
+	ctx, keeper := mockDB()
+
+	communityAdmin := keeper.communityKeeper.GetParams(ctx).CommunityAdmins[0]
+	_, err := keeper.communityKeeper.SetParent(ctx, "meme", "crypto", communityAdmin)
+	require.NoError(t, err)
+	_, err = keeper.communityKeeper.SetParent(ctx, "Furries", "meme", communityAdmin)
+	require.NoError(t, err)
+
+	claim1 := fakeClaim(ctx, keeper, "crypto")
+	claim2 := fakeClaim(ctx, keeper, "Furries")
+	fakeClaim(ctx, keeper, "meme")
+
+	queryParamsBytes, jsonErr := ModuleCodec.MarshalJSON(QueryCommunityClaimsParams{
+		CommunityID:        "crypto",
+		IncludeDescendants: true,
+	})
+	require.Nil(t, jsonErr)
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, QueryCommunityClaims}, "/"),
+		Data: queryParamsBytes,
+	}
+
+	querier := NewQuerier(keeper)
+	resBytes, err := querier(ctx, []string{QueryCommunityClaims}, query)
+	require.NoError(t, err)
+
+	var claims []Claim
+	require.NoError(t, ModuleCodec.UnmarshalJSON(resBytes, &claims))
+	require.Len(t, claims, 3)
+	require.Equal(t, claim1.ID, claims[2].ID)
+
+	queryParamsBytes, jsonErr = ModuleCodec.MarshalJSON(QueryCommunityClaimsParams{
+		CommunityID:        "Furries",
+		IncludeDescendants: true,
+	})
+	require.Nil(t, jsonErr)
+	query.Data = queryParamsBytes
+	resBytes, err = querier(ctx, []string{QueryCommunityClaims}, query)
+	require.NoError(t, err)
+	require.NoError(t, ModuleCodec.UnmarshalJSON(resBytes, &claims))
+	require.Len(t, claims, 1)
+	require.Equal(t, claim2.ID, claims[0].ID)
+}
+
 func TestQueryCommunitiesClaims(t *testing.T) {
 	ctx, keeper := mockDB()
 
@@ -133,10 +184,42 @@ func TestQueryCommunitiesClaims(t *testing.T) {
 	require.Equal(t, 2, len(claims))
 }
 
+func TestQueryCommunitiesClaims_Tagged(t *testing.T) {
+	ctx, keeper := mockDB()
+
+	claim := fakeClaim(ctx, keeper, "crypto")
+	admin := keeper.GetParams(ctx).ClaimAdmins[0]
+	_, err := keeper.AddClaimTag(ctx, claim.ID, "meme", admin)
+	require.NoError(t, err)
+
+	queryParamsBytes, jsonErr := ModuleCodec.MarshalJSON(QueryCommunitiesClaimsParams{
+		CommunityIDs: []string{"crypto", "meme"},
+	})
+	require.Nil(t, jsonErr)
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, QueryCommunitiesClaims}, "/"),
+		Data: queryParamsBytes,
+	}
+
+	querier := NewQuerier(keeper)
+	resBytes, err := querier(ctx, []string{QueryCommunitiesClaims}, query)
+	require.NoError(t, err)
+
+	var claims []Claim
+	cdcErr := ModuleCodec.UnmarshalJSON(resBytes, &claims)
+	require.NoError(t, cdcErr)
+	require.Equal(t, 1, len(claims))
+}
+
 func TestQueryCreatorClaims(t *testing.T) {
 	ctx, keeper := mockDB()
 
 	claim := fakeClaim(ctx, keeper, "crypto")
+	hidden := fakeClaim(ctx, keeper, "crypto")
+	hidden.Creator = claim.Creator
+	hidden.Hidden = true
+	keeper.setClaim(ctx, hidden)
+	keeper.setCreatorClaim(ctx, claim.Creator, hidden.ID)
 
 	queryParams := QueryCreatorClaimsParams{
 		Creator: claim.Creator,
@@ -159,6 +242,198 @@ func TestQueryCreatorClaims(t *testing.T) {
 	require.Equal(t, 1, len(claims))
 }
 
+func TestQueryClaimsByStatus(t *testing.T) {
+	ctx, keeper := mockDB()
+
+	claim := fakeClaim(ctx, keeper, "crypto")
+	fakeClaim(ctx, keeper, "crypto")
+	EndBlocker(ctx.WithBlockTime(claim.ClosingTime), keeper)
+	fakeClaim(ctx.WithBlockTime(claim.ClosingTime), keeper, "crypto")
+	// hidden claims aren't returned
+	hidden := fakeClaim(ctx, keeper, "crypto")
+	EndBlocker(ctx.WithBlockTime(hidden.ClosingTime), keeper)
+	hidden, _ = keeper.Claim(ctx, hidden.ID)
+	hidden.Hidden = true
+	keeper.setClaim(ctx, hidden)
+
+	queryParams := QueryClaimsByStatusParams{
+		Status: StatusClosed,
+	}
+	queryParamsBytes, jsonErr := ModuleCodec.MarshalJSON(queryParams)
+	require.Nil(t, jsonErr)
+
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, QueryClaimsByStatus}, "/"),
+		Data: queryParamsBytes,
+	}
+
+	querier := NewQuerier(keeper)
+	resBytes, err := querier(ctx, []string{QueryClaimsByStatus}, query)
+	require.NoError(t, err)
+
+	var claims []Claim
+	cdcErr := ModuleCodec.UnmarshalJSON(resBytes, &claims)
+	require.NoError(t, cdcErr)
+	require.Equal(t, 2, len(claims))
+}
+
+func TestQueryClaimsSorted(t *testing.T) {
+	ctx, keeper := mockDB()
+
+	claim1 := fakeClaim(ctx, keeper, "crypto")
+	claim2 := fakeClaim(ctx, keeper, "crypto")
+	keeper.AddChallengeStake(ctx, claim1.ID, sdk.NewInt64Coin(app.StakeDenom, 10*app.Shanev))
+
+	queryParamsBytes, jsonErr := ModuleCodec.MarshalJSON(QueryClaimsSortedParams{
+		SortBy:      SortByTotalStake,
+		CommunityID: "crypto",
+	})
+	require.Nil(t, jsonErr)
+
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, QueryClaimsSorted}, "/"),
+		Data: queryParamsBytes,
+	}
+
+	querier := NewQuerier(keeper)
+	resBytes, err := querier(ctx, []string{QueryClaimsSorted}, query)
+	require.NoError(t, err)
+
+	var claims []Claim
+	cdcErr := ModuleCodec.UnmarshalJSON(resBytes, &claims)
+	require.NoError(t, cdcErr)
+	require.Len(t, claims, 2)
+	require.Equal(t, claim1.ID, claims[0].ID)
+	require.Equal(t, claim2.ID, claims[1].ID)
+
+	queryParamsBytes, jsonErr = ModuleCodec.MarshalJSON(QueryClaimsSortedParams{SortBy: SortKey(10)})
+	require.Nil(t, jsonErr)
+	query.Data = queryParamsBytes
+	_, err = querier(ctx, []string{QueryClaimsSorted}, query)
+	require.Error(t, err)
+	require.Equal(t, ErrorCodeInvalidSortKey, err.Code())
+
+	queryParamsBytes, jsonErr = ModuleCodec.MarshalJSON(QueryClaimsSortedParams{SortBy: SortByTotalStake, Limit: -1})
+	require.Nil(t, jsonErr)
+	query.Data = queryParamsBytes
+	_, err = querier(ctx, []string{QueryClaimsSorted}, query)
+	require.Error(t, err)
+	require.Equal(t, ErrorCodeInvalidPageLimit, err.Code())
+}
+
+func TestQueryModerationQueue(t *testing.T) {
+	ctx, keeper := mockDB()
+	admin := keeper.GetParams(ctx).ClaimAdmins[0]
+
+	claim := fakeClaim(ctx, keeper, "crypto")
+	fakeClaim(ctx, keeper, "crypto")
+	_, err := keeper.FlagClaim(ctx, claim.ID, FlagReasonSpam, admin)
+	require.NoError(t, err)
+	_, err = keeper.ResolveClaimFlags(ctx, claim.ID, admin)
+	require.NoError(t, err)
+	flagged := fakeClaim(ctx, keeper, "crypto")
+	_, err = keeper.FlagClaim(ctx, flagged.ID, FlagReasonSpam, admin)
+	require.NoError(t, err)
+
+	querier := NewQuerier(keeper)
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, QueryModerationQueue}, "/"),
+	}
+	resBytes, err := querier(ctx, []string{QueryModerationQueue}, query)
+	require.NoError(t, err)
+	var claims []Claim
+	require.NoError(t, ModuleCodec.UnmarshalJSON(resBytes, &claims))
+	require.Len(t, claims, 1)
+	require.Equal(t, flagged.ID, claims[0].ID)
+
+	// hidden claims are left out of the claim feeds
+	query.Path = strings.Join([]string{custom, QueryClaims}, "/")
+	resBytes, err = querier(ctx, []string{QueryClaims}, query)
+	require.NoError(t, err)
+	require.NoError(t, ModuleCodec.UnmarshalJSON(resBytes, &claims))
+	require.Len(t, claims, 2)
+}
+
+func TestQueryClaimEvidence(t *testing.T) {
+	ctx, keeper := mockDB()
+	admin := keeper.GetParams(ctx).ClaimAdmins[0]
+
+	evidence := []Evidence{{Kind: EvidenceLink, URI: "https://trustory.io/evidence"}}
+	claim, err := keeper.SubmitClaim(ctx, "a claim with some evidence attached to it", "crypto",
+		admin, url.URL{}, evidence...)
+	require.NoError(t, err)
+
+	queryParamsBytes, jsonErr := ModuleCodec.MarshalJSON(QueryClaimParams{ID: claim.ID})
+	require.Nil(t, jsonErr)
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, QueryClaimEvidence}, "/"),
+		Data: queryParamsBytes,
+	}
+	querier := NewQuerier(keeper)
+	resBytes, err := querier(ctx, []string{QueryClaimEvidence}, query)
+	require.NoError(t, err)
+	var returned []Evidence
+	require.NoError(t, ModuleCodec.UnmarshalJSON(resBytes, &returned))
+	require.Equal(t, evidence, returned)
+}
+
+func TestQueryClaimResult(t *testing.T) {
+	ctx, keeper := mockDB()
+
+	claim := fakeClaim(ctx, keeper, "crypto")
+	queryParamsBytes, jsonErr := ModuleCodec.MarshalJSON(QueryClaimParams{ID: claim.ID})
+	require.Nil(t, jsonErr)
+
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, QueryClaimResult}, "/"),
+		Data: queryParamsBytes,
+	}
+
+	querier := NewQuerier(keeper)
+	_, err := querier(ctx, []string{QueryClaimResult}, query)
+	require.Error(t, err)
+	require.Equal(t, ErrorCodeClaimNotResolved, err.Code())
+
+	EndBlocker(ctx.WithBlockTime(claim.ClosingTime), keeper)
+	resBytes, err := querier(ctx, []string{QueryClaimResult}, query)
+	require.NoError(t, err)
+
+	var result ClaimResult
+	cdcErr := ModuleCodec.UnmarshalJSON(resBytes, &result)
+	require.NoError(t, cdcErr)
+	require.Equal(t, claim.ID, result.ClaimID)
+	require.Equal(t, VerdictMajorityNotReached, result.Verdict)
+}
+
+func TestQueryClaimRevisions(t *testing.T) {
+	ctx, keeper := mockDB()
+
+	claim := fakeClaim(ctx, keeper, "crypto")
+	admin := keeper.GetParams(ctx).ClaimAdmins[0]
+	_, err := keeper.EditClaim(ctx, claim.ID, "This is the first edit of the claim body.", admin)
+	require.NoError(t, err)
+	_, err = keeper.EditClaim(ctx, claim.ID, "This is the second edit of the claim body.", admin)
+	require.NoError(t, err)
+
+	queryParamsBytes, jsonErr := ModuleCodec.MarshalJSON(QueryClaimParams{ID: claim.ID})
+	require.Nil(t, jsonErr)
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, QueryClaimRevisions}, "/"),
+		Data: queryParamsBytes,
+	}
+
+	querier := NewQuerier(keeper)
+	resBytes, err := querier(ctx, []string{QueryClaimRevisions}, query)
+	require.NoError(t, err)
+
+	var revisions []ClaimRevision
+	cdcErr := ModuleCodec.UnmarshalJSON(resBytes, &revisions)
+	require.NoError(t, cdcErr)
+	require.Len(t, revisions, 2)
+	require.Equal(t, uint64(1), revisions[0].Revision)
+	require.Equal(t, revisions[0].Body, revisions[1].PreviousBody)
+}
+
 func TestQueryParams_Success(t *testing.T) {
 	ctx, keeper := mockDB()
 
@@ -177,3 +452,95 @@ func TestQueryParams_Success(t *testing.T) {
 	assert.Nil(t, sdkErr)
 	assert.Equal(t, returnedParams, onChainParams)
 }
+
+func TestQueryFollowedClaims(t *testing.T) {
+	ctx, keeper := mockDB()
+
+	follower := getFakeAdmin()
+	require.NoError(t, keeper.communityKeeper.FollowCommunity(ctx, "crypto", follower))
+	require.NoError(t, keeper.communityKeeper.FollowCommunity(ctx, "meme", follower))
+
+	now := time.Now().UTC()
+	claim1 := fakeClaim(ctx.WithBlockTime(now.Add(2*time.Hour)), keeper, "crypto")
+	claim2 := fakeClaim(ctx.WithBlockTime(now), keeper, "meme")
+	fakeClaim(ctx.WithBlockTime(now.Add(3*time.Hour)), keeper, "Furries")
+	claim4 := fakeClaim(ctx.WithBlockTime(now.Add(time.Hour)), keeper, "crypto")
+
+	queryParamsBytes, jsonErr := ModuleCodec.MarshalJSON(QueryFollowedClaimsParams{
+		Address: follower,
+		Limit:   2,
+	})
+	require.Nil(t, jsonErr)
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, QueryFollowedClaims}, "/"),
+		Data: queryParamsBytes,
+	}
+
+	querier := NewQuerier(keeper)
+	resBytes, err := querier(ctx, []string{QueryFollowedClaims}, query)
+	require.NoError(t, err)
+
+	var claims []Claim
+	require.NoError(t, ModuleCodec.UnmarshalJSON(resBytes, &claims))
+	require.Len(t, claims, 2)
+	require.Equal(t, claim1.ID, claims[0].ID)
+	require.Equal(t, claim4.ID, claims[1].ID)
+
+	queryParamsBytes, jsonErr = ModuleCodec.MarshalJSON(QueryFollowedClaimsParams{
+		Address: follower,
+		Page:    2,
+		Limit:   2,
+	})
+	require.Nil(t, jsonErr)
+	query.Data = queryParamsBytes
+	resBytes, err = querier(ctx, []string{QueryFollowedClaims}, query)
+	require.NoError(t, err)
+	require.NoError(t, ModuleCodec.UnmarshalJSON(resBytes, &claims))
+	require.Len(t, claims, 1)
+	require.Equal(t, claim2.ID, claims[0].ID)
+
+	queryParamsBytes, jsonErr = ModuleCodec.MarshalJSON(QueryFollowedClaimsParams{
+		Address: follower,
+		Page:    math.MaxInt64,
+		Limit:   2,
+	})
+	require.Nil(t, jsonErr)
+	query.Data = queryParamsBytes
+	resBytes, err = querier(ctx, []string{QueryFollowedClaims}, query)
+	require.NoError(t, err)
+	require.NoError(t, ModuleCodec.UnmarshalJSON(resBytes, &claims))
+	require.Len(t, claims, 0)
+
+	queryParamsBytes, jsonErr = ModuleCodec.MarshalJSON(QueryFollowedClaimsParams{Address: follower, Limit: -1})
+	require.Nil(t, jsonErr)
+	query.Data = queryParamsBytes
+	_, err = querier(ctx, []string{QueryFollowedClaims}, query)
+	require.Error(t, err)
+	require.Equal(t, ErrorCodeInvalidPageLimit, err.Code())
+}
+
+func TestQueryEffectiveParams(t *testing.T) {
+	ctx, keeper := mockDB()
+
+	communityAdmin := keeper.communityKeeper.GetParams(ctx).CommunityAdmins[0]
+	minClaimLength := 10
+	err := keeper.communityKeeper.SetParamOverrides(ctx,
+		community.ParamOverrides{CommunityID: "crypto", MinClaimLength: &minClaimLength}, communityAdmin)
+	require.NoError(t, err)
+
+	queryParamsBytes, jsonErr := ModuleCodec.MarshalJSON(QueryCommunityClaimsParams{CommunityID: "crypto"})
+	require.Nil(t, jsonErr)
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, QueryEffectiveParams}, "/"),
+		Data: queryParamsBytes,
+	}
+
+	querier := NewQuerier(keeper)
+	resBytes, err := querier(ctx, []string{QueryEffectiveParams}, query)
+	require.NoError(t, err)
+
+	var params Params
+	require.NoError(t, ModuleCodec.UnmarshalJSON(resBytes, &params))
+	require.Equal(t, 10, params.MinClaimLength)
+	require.Equal(t, keeper.GetParams(ctx).MaxClaimLength, params.MaxClaimLength)
+}
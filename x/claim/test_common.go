@@ -1,13 +1,17 @@
 package claim
 
 import (
+	"fmt"
 	"net/url"
 
+	bankexported "github.com/TruStory/truchain/x/bank/exported"
 	"github.com/TruStory/truchain/x/community"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	supplyexported "github.com/cosmos/cosmos-sdk/x/supply/exported"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto/ed25519"
 	cryptoAmino "github.com/tendermint/tendermint/crypto/encoding/amino"
@@ -28,7 +32,119 @@ func (ak accKeeper) IsJailed(ctx sdk.Context, addr sdk.AccAddress) (bool, sdk.Er
 	return ak.Jailed, nil
 }
 
+// interface conformance check
+var _ BankKeeper = &bankKeeper{}
+
+type bankKeeper struct {
+	Transactions []bankexported.Transaction
+	AddCoinErr   sdk.Error
+}
+
+// AddCoin ...
+func (bk *bankKeeper) AddCoin(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coin,
+	referenceID uint64, txType bankexported.TransactionType, setters ...bankexported.TransactionSetter) (sdk.Coins, sdk.Error) {
+	if bk.AddCoinErr != nil {
+		return nil, bk.AddCoinErr
+	}
+	bk.record(addr, amt, referenceID, txType, setters...)
+	return sdk.Coins{amt}, nil
+}
+
+// SubtractCoin ...
+func (bk *bankKeeper) SubtractCoin(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coin,
+	referenceID uint64, txType bankexported.TransactionType, setters ...bankexported.TransactionSetter) (sdk.Coins, sdk.Error) {
+	bk.record(addr, amt, referenceID, txType, setters...)
+	return sdk.Coins{amt}, nil
+}
+
+// SendCoinBetweenModules ...
+func (bk *bankKeeper) SendCoinBetweenModules(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coin,
+	referenceID uint64, txType bankexported.TransactionType, setters ...bankexported.TransactionSetter) sdk.Error {
+	bk.record(addr, amt, referenceID, txType, setters...)
+	return nil
+}
+
+// GetCoins ...
+func (bk *bankKeeper) GetCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
+	return sdk.Coins{}
+}
+
+func (bk *bankKeeper) record(addr sdk.AccAddress, amt sdk.Coin,
+	referenceID uint64, txType bankexported.TransactionType, setters ...bankexported.TransactionSetter) {
+	tx := bankexported.Transaction{
+		Type:              txType,
+		AppAccountAddress: addr,
+		ReferenceID:       referenceID,
+		Amount:            amt,
+	}
+	for _, setter := range setters {
+		setter(&tx)
+	}
+	bk.Transactions = append(bk.Transactions, tx)
+}
+
+// interface conformance check
+var _ SearchKeeper = &searchKeeper{}
+
+type searchKeeper struct {
+	Indexed map[uint64]string
+}
+
+// IndexClaim ...
+func (sk *searchKeeper) IndexClaim(ctx sdk.Context, id uint64, body string) {
+	sk.Indexed[id] = body
+}
+
+// RemoveClaim ...
+func (sk *searchKeeper) RemoveClaim(ctx sdk.Context, id uint64) {
+	delete(sk.Indexed, id)
+}
+
+// interface conformance check
+var _ StakingKeeper = &stakingKeeper{}
+
+type stakingKeeper struct {
+	Earned map[string]sdk.Int
+}
+
+// TotalEarnedCoins ...
+func (sk *stakingKeeper) TotalEarnedCoins(ctx sdk.Context, creator sdk.AccAddress) sdk.Int {
+	earned, ok := sk.Earned[creator.String()]
+	if !ok {
+		return sdk.ZeroInt()
+	}
+	return earned
+}
+
+// interface conformance check
+var _ SupplyKeeper = &supplyKeeper{}
+
+type supplyKeeper struct {
+	Pools map[string]sdk.Coins
+}
+
+// GetModuleAccount ...
+func (sk *supplyKeeper) GetModuleAccount(ctx sdk.Context, moduleName string) supplyexported.ModuleAccountI {
+	acc := supply.NewEmptyModuleAccount(moduleName, supply.Minter)
+	err := acc.SetCoins(sk.Pools[moduleName])
+	if err != nil {
+		panic(err)
+	}
+	return acc
+}
+
+// MintCoins ...
+func (sk *supplyKeeper) MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) sdk.Error {
+	sk.Pools[moduleName] = sk.Pools[moduleName].Add(amt)
+	return nil
+}
+
 func mockDB() (sdk.Context, Keeper) {
+	ctx, keeper, _ := mockDBWithBank()
+	return ctx, keeper
+}
+
+func mockDBWithBank() (sdk.Context, Keeper, *bankKeeper) {
 	db := dbm.NewMemDB()
 
 	claimKey := sdk.NewKVStoreKey("claim")
@@ -51,10 +167,13 @@ func mockDB() (sdk.Context, Keeper) {
 
 	pk := params.NewKeeper(codec, paramsKey, transientParamsKey, params.DefaultCodespace)
 
+	bankKeeper := &bankKeeper{}
+
 	communityKeeper := community.NewKeeper(
 		communityKey,
 		pk.Subspace(community.ModuleName),
-		codec)
+		codec,
+		bankKeeper)
 	admin1 := getFakeAdmin()
 	admin2 := getFakeAdmin()
 	genesis := community.DefaultGenesisState()
@@ -75,13 +194,17 @@ func mockDB() (sdk.Context, Keeper) {
 		pk.Subspace(ModuleName),
 		codec,
 		accountKeeper,
+		bankKeeper,
 		communityKeeper,
+		&searchKeeper{Indexed: make(map[uint64]string)},
+		&supplyKeeper{Pools: make(map[string]sdk.Coins)},
 	)
+	keeper.SetStakingKeeper(&stakingKeeper{Earned: make(map[string]sdk.Int)})
 	claimGenesis := DefaultGenesisState()
 	claimGenesis.Params.ClaimAdmins = append(claimGenesis.Params.ClaimAdmins, admin1, admin2)
 	InitGenesis(ctx, keeper, claimGenesis)
 
-	return ctx, keeper
+	return ctx, keeper, bankKeeper
 }
 
 func getFakeAdmin() (address sdk.AccAddress) {
@@ -92,7 +215,9 @@ func getFakeAdmin() (address sdk.AccAddress) {
 }
 
 func fakeClaim(ctx sdk.Context, keeper Keeper, communityID string) Claim {
-	body := "body string ajsdkhfakjsdfhd"
+	// bodies must differ to pass the duplicate claim check
+	nextID, _ := keeper.claimID(ctx)
+	body := fmt.Sprintf("body string ajsdkhfakjsdfhd #%d", nextID)
 	creator := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
 	source := url.URL{}
 	claim, err := keeper.SubmitClaim(ctx, body, communityID, creator, source)
@@ -15,8 +15,139 @@ const (
 	QuerierRoute      = ModuleName
 	StoreKey          = ModuleName
 	DefaultParamspace = ModuleName
+
+	// ClaimStakesPoolName holds the creation stakes of open claims
+	ClaimStakesPoolName = "claim_stakes_tokens_pool"
+
+	EventTypeClaimClosed   = "claim-closed"
+	EventTypeClaimArchived = "claim-archived"
+	EventTypeClaimReopened = "claim-reopened"
+	EventTypeClaimDeleted  = "claim-deleted"
+	AttributeKeyClaimID    = "claim-id"
+	AttributeKeyClaimIDs   = "claim-ids"
+	AttributeKeyVerdict    = "verdict"
+	AttributeKeyReason     = "reason"
+
+	EventTypeClaimEdited       = "claim-edited"
+	EventTypeClaimEditProposed = "claim-edit-proposed"
+	EventTypeClaimEditRejected = "claim-edit-rejected"
+	AttributeKeyRevision       = "revision"
+	AttributeKeyEditProposalID = "edit-proposal-id"
+
+	EventTypeClaimFlagged        = "claim-flagged"
+	EventTypeClaimHidden         = "claim-hidden"
+	EventTypeClaimFlagsResolved  = "claim-flags-resolved"
+	EventTypeClaimFlagsDismissed = "claim-flags-dismissed"
+	AttributeKeyFlagReason       = "flag-reason"
+	AttributeKeyFlagCount        = "flag-count"
+
+	EventTypeClaimEvidenceEdited = "claim-evidence-edited"
+)
+
+// Status enum for the claim lifecycle
+type Status int8
+
+const (
+	// StatusOpen represents a claim that accepts new arguments and stakes
+	StatusOpen Status = iota
+	// StatusClosed represents a claim past its closing time
+	StatusClosed
+	// StatusArchived represents a claim that has been closed for the archive duration
+	StatusArchived
+	// StatusDeleted represents a claim removed by an admin, kept as a tombstone
+	StatusDeleted
+)
+
+// StatusName maps a claim status to its name
+var StatusName = []string{
+	StatusOpen:     "open",
+	StatusClosed:   "closed",
+	StatusArchived: "archived",
+	StatusDeleted:  "deleted",
+}
+
+// Valid returns true if the status is a known status
+func (s Status) Valid() bool {
+	return s >= StatusOpen && int(s) < len(StatusName)
+}
+
+func (s Status) String() string {
+	if !s.Valid() {
+		return fmt.Sprintf("Status(%d)", s)
+	}
+	return StatusName[s]
+}
+
+// Verdict enum for the outcome of a closed claim
+type Verdict int8
+
+const (
+	// VerdictNone represents a claim that hasn't been resolved yet
+	VerdictNone Verdict = iota
+	// VerdictMajorityNotReached represents a claim where neither side reached the threshold
+	VerdictMajorityNotReached
+	// VerdictBackersWin represents a claim where backers reached the threshold
+	VerdictBackersWin
+	// VerdictChallengersWin represents a claim where challengers reached the threshold
+	VerdictChallengersWin
+)
+
+// VerdictName maps a verdict to its name
+var VerdictName = []string{
+	VerdictNone:               "none",
+	VerdictMajorityNotReached: "majority_not_reached",
+	VerdictBackersWin:         "backers_win",
+	VerdictChallengersWin:     "challengers_win",
+}
+
+func (v Verdict) String() string {
+	if v < VerdictNone || int(v) >= len(VerdictName) {
+		return fmt.Sprintf("Verdict(%d)", v)
+	}
+	return VerdictName[v]
+}
+
+// SortKey enum for the activity orders of claims
+type SortKey int8
+
+const (
+	// SortByTotalStake orders claims by their total backing and challenge stake
+	SortByTotalStake SortKey = iota
+	// SortByTotalStakers orders claims by their number of stakers
+	SortByTotalStakers
+	// SortByFirstArgumentTime orders claims by the time of their first argument
+	SortByFirstArgumentTime
 )
 
+// SortKeyName maps a sort key to its name
+var SortKeyName = []string{
+	SortByTotalStake:        "total_stake",
+	SortByTotalStakers:      "total_stakers",
+	SortByFirstArgumentTime: "first_argument_time",
+}
+
+// Valid returns true if the sort key is a known sort key
+func (s SortKey) Valid() bool {
+	return s >= SortByTotalStake && int(s) < len(SortKeyName)
+}
+
+func (s SortKey) String() string {
+	if !s.Valid() {
+		return fmt.Sprintf("SortKey(%d)", s)
+	}
+	return SortKeyName[s]
+}
+
+// ClaimResult stores the outcome of a claim at close
+type ClaimResult struct {
+	ClaimID         uint64    `json:"claim_id"`
+	Verdict         Verdict   `json:"verdict"`
+	TotalBacked     sdk.Coin  `json:"total_backed"`
+	TotalChallenged sdk.Coin  `json:"total_challenged"`
+	Threshold       sdk.Dec   `json:"threshold"`
+	ResolvedTime    time.Time `json:"resolved_time"`
+}
+
 // Claim stores data about a claim
 type Claim struct {
 	ID                uint64         `json:"id"`
@@ -29,13 +160,29 @@ type Claim struct {
 	TotalChallenged   sdk.Coin       `json:"total_challenged,omitempty"`
 	CreatedTime       time.Time      `json:"created_time"`
 	FirstArgumentTime time.Time      `json:"first_argument_time"`
+	CreationStake     sdk.Coin       `json:"creation_stake"`
+	Status            Status         `json:"status"`
+	ClosingTime       time.Time      `json:"closing_time"`
+	ArchiveTime       time.Time      `json:"archive_time,omitempty"`
+	Result            *ClaimResult   `json:"result,omitempty"`
+	DeletedTime       time.Time      `json:"deleted_time,omitempty"`
+	DeletionReason    string         `json:"deletion_reason,omitempty"`
+	Revisions         uint64         `json:"revisions,omitempty"`
+	// Tags are secondary communities the claim also belongs to
+	Tags []string `json:"tags,omitempty"`
+	// FlagCount is the number of user flags awaiting moderation
+	FlagCount uint64 `json:"flag_count,omitempty"`
+	// Hidden claims reached the flag threshold or were hidden by a moderator
+	Hidden bool `json:"hidden,omitempty"`
+	// Evidence supports the claim alongside its source
+	Evidence []Evidence `json:"evidence,omitempty"`
 }
 
 // Claims is an array of claims
 type Claims []Claim
 
 // NewClaim creates a new claim object
-func NewClaim(id uint64, communityID string, body string, creator sdk.AccAddress, source url.URL, createdTime time.Time) Claim {
+func NewClaim(id uint64, communityID string, body string, creator sdk.AccAddress, source url.URL, createdTime, closingTime time.Time) Claim {
 	return Claim{
 		ID:              id,
 		CommunityID:     communityID,
@@ -45,7 +192,10 @@ func NewClaim(id uint64, communityID string, body string, creator sdk.AccAddress
 		TotalStakers:    0,
 		TotalBacked:     sdk.NewCoin(app.StakeDenom, sdk.ZeroInt()),
 		TotalChallenged: sdk.NewCoin(app.StakeDenom, sdk.ZeroInt()),
+		CreationStake:   sdk.NewCoin(app.StakeDenom, sdk.ZeroInt()),
 		CreatedTime:     createdTime,
+		Status:          StatusOpen,
+		ClosingTime:     closingTime,
 	}
 }
 
@@ -55,6 +205,142 @@ func (c Claim) String() string {
   Body:		   %s
   Creator:     %s
   Source:      %s
-  CreatedTime  %s`,
-		c.ID, c.CommunityID, c.Body, c.Creator.String(), c.Source.String(), c.CreatedTime.String())
+  CreatedTime  %s
+  Status:      %s
+  ClosingTime  %s`,
+		c.ID, c.CommunityID, c.Body, c.Creator.String(), c.Source.String(), c.CreatedTime.String(),
+		c.Status.String(), c.ClosingTime.String())
+}
+
+// TotalStake returns the sum of the backing and challenge stakes
+func (c Claim) TotalStake() sdk.Int {
+	return c.TotalBacked.Amount.Add(c.TotalChallenged.Amount)
+}
+
+// IsOpen returns true if the claim accepts new arguments and stakes
+func (c Claim) IsOpen() bool {
+	return c.Status == StatusOpen
+}
+
+// HasCommunity returns true if the claim belongs to the community, as primary or tag
+func (c Claim) HasCommunity(communityID string) bool {
+	if c.CommunityID == communityID {
+		return true
+	}
+	for _, tag := range c.Tags {
+		if tag == communityID {
+			return true
+		}
+	}
+	return false
+}
+
+// IsDeleted returns true if the claim has been deleted by an admin
+func (c Claim) IsDeleted() bool {
+	return c.Status == StatusDeleted
+}
+
+// ClaimRevision stores a single edit of a claim body
+type ClaimRevision struct {
+	ClaimID      uint64         `json:"claim_id"`
+	Revision     uint64         `json:"revision"`
+	Body         string         `json:"body"`
+	PreviousBody string         `json:"previous_body"`
+	Editor       sdk.AccAddress `json:"editor"`
+	// ProposalID is set when the revision comes from an approved edit proposal
+	ProposalID uint64    `json:"proposal_id,omitempty"`
+	EditedTime time.Time `json:"edited_time"`
+}
+
+// EditProposalStatus enum for the review state of a claim edit proposal
+type EditProposalStatus int8
+
+const (
+	// EditProposalPending represents a proposal waiting for review by a claim admin
+	EditProposalPending EditProposalStatus = iota
+	// EditProposalApproved represents a proposal applied to the claim
+	EditProposalApproved
+	// EditProposalRejected represents a proposal discarded by a claim admin
+	EditProposalRejected
+)
+
+// EditProposalStatusName maps an edit proposal status to its name
+var EditProposalStatusName = []string{
+	EditProposalPending:  "pending",
+	EditProposalApproved: "approved",
+	EditProposalRejected: "rejected",
+}
+
+// Valid returns true if the status is a known status
+func (s EditProposalStatus) Valid() bool {
+	return s >= EditProposalPending && int(s) < len(EditProposalStatusName)
+}
+
+func (s EditProposalStatus) String() string {
+	if !s.Valid() {
+		return fmt.Sprintf("EditProposalStatus(%d)", s)
+	}
+	return EditProposalStatusName[s]
+}
+
+// FlagReason enum
+type FlagReason int
+
+const (
+	// FlagReasonSpam represents a claim that is spam or advertising
+	FlagReasonSpam FlagReason = iota
+	// FlagReasonMisleading represents a claim with a misleading body or source
+	FlagReasonMisleading
+	// FlagReasonHarassment represents a claim targeting a person
+	FlagReasonHarassment
+	// FlagReasonOffensiveContent represents a claim with offensive content
+	FlagReasonOffensiveContent
+	// FlagReasonDuplicate represents a claim already debated in another claim
+	FlagReasonDuplicate
+	// FlagReasonOther represents any reason other than the above
+	FlagReasonOther
+)
+
+// FlagReasonName is the name of the flag reason
+var FlagReasonName = []string{
+	FlagReasonSpam:             "Spam",
+	FlagReasonMisleading:       "Misleading",
+	FlagReasonHarassment:       "Harassment",
+	FlagReasonOffensiveContent: "Offensive Content",
+	FlagReasonDuplicate:        "Duplicate",
+	FlagReasonOther:            "Other",
+}
+
+// Valid returns true if the flag reason is a known reason
+func (r FlagReason) Valid() bool {
+	return r >= FlagReasonSpam && int(r) < len(FlagReasonName)
+}
+
+func (r FlagReason) String() string {
+	if !r.Valid() {
+		return "Unknown"
+	}
+	return FlagReasonName[r]
+}
+
+// ClaimFlag stores a user report of a bad claim
+type ClaimFlag struct {
+	ClaimID     uint64         `json:"claim_id"`
+	Reason      FlagReason     `json:"reason"`
+	Creator     sdk.AccAddress `json:"creator"`
+	CreatedTime time.Time      `json:"created_time"`
+}
+
+// ClaimEditProposal stores a correction to a claim body proposed by a user.
+// BaseRevision is the claim revision the correction was written against.
+type ClaimEditProposal struct {
+	ID           uint64             `json:"id"`
+	ClaimID      uint64             `json:"claim_id"`
+	Body         string             `json:"body"`
+	BaseRevision uint64             `json:"base_revision"`
+	Proposer     sdk.AccAddress     `json:"proposer"`
+	Status       EditProposalStatus `json:"status"`
+	CreatedTime  time.Time          `json:"created_time"`
+	Reviewer     sdk.AccAddress     `json:"reviewer,omitempty"`
+	ReviewedTime time.Time          `json:"reviewed_time,omitempty"`
 }
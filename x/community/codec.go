@@ -5,6 +5,16 @@ import "github.com/cosmos/cosmos-sdk/codec"
 // RegisterCodec registers messages into the codec
 func RegisterCodec(c *codec.Codec) {
 	c.RegisterConcrete(MsgNewCommunity{}, "community/MsgNewCommunity", nil)
+	c.RegisterConcrete(MsgUpdateCommunity{}, "community/MsgUpdateCommunity", nil)
+	c.RegisterConcrete(MsgArchiveCommunity{}, "community/MsgArchiveCommunity", nil)
+	c.RegisterConcrete(MsgSetCommunityParent{}, "community/MsgSetCommunityParent", nil)
+	c.RegisterConcrete(MsgSetParamOverrides{}, "community/MsgSetParamOverrides", nil)
+	c.RegisterConcrete(MsgClearParamOverrides{}, "community/MsgClearParamOverrides", nil)
+	c.RegisterConcrete(MsgTreasurySpend{}, "community/MsgTreasurySpend", nil)
+	c.RegisterConcrete(MsgAddModerator{}, "community/MsgAddModerator", nil)
+	c.RegisterConcrete(MsgRemoveModerator{}, "community/MsgRemoveModerator", nil)
+	c.RegisterConcrete(MsgFollowCommunity{}, "community/MsgFollowCommunity", nil)
+	c.RegisterConcrete(MsgUnfollowCommunity{}, "community/MsgUnfollowCommunity", nil)
 	c.RegisterConcrete(MsgAddAdmin{}, "community/MsgAddAdmin", nil)
 	c.RegisterConcrete(MsgRemoveAdmin{}, "community/MsgRemoveAdmin", nil)
 	c.RegisterConcrete(MsgUpdateParams{}, "community/MsgUpdateParams", nil)
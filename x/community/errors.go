@@ -14,6 +14,15 @@ const (
 	ErrorCodeInvalidCommunityMsg  sdk.CodeType = 802
 	ErrorCodeAddressNotAuthorised sdk.CodeType = 803
 	ErrorCodeJSONParsing          sdk.CodeType = 804
+	ErrorCodeCommunityArchived    sdk.CodeType = 805
+	ErrorCodeModeratorExists      sdk.CodeType = 806
+	ErrorCodeModeratorNotFound    sdk.CodeType = 807
+	ErrorCodeAlreadyFollowing     sdk.CodeType = 808
+	ErrorCodeNotFollowing         sdk.CodeType = 809
+	ErrorCodeInvalidParent        sdk.CodeType = 810
+	ErrorCodeMaxDepthExceeded     sdk.CodeType = 811
+	ErrorCodeOverridesNotFound    sdk.CodeType = 812
+	ErrorCodeInsufficientTreasury sdk.CodeType = 813
 )
 
 // ErrCommunityNotFound throws an error when the searched category is not found
@@ -35,3 +44,51 @@ func ErrAddressNotAuthorised() sdk.Error {
 func ErrJSONParse(err error) sdk.Error {
 	return sdk.NewError(DefaultCodespace, ErrorCodeJSONParsing, "JSON parsing error: "+err.Error())
 }
+
+// ErrCommunityArchived throws an error when changing an archived community
+func ErrCommunityArchived(id string) sdk.Error {
+	return sdk.NewError(DefaultCodespace, ErrorCodeCommunityArchived, fmt.Sprintf("Community %s is archived", id))
+}
+
+// ErrModeratorExists throws an error when appointing an existing moderator
+func ErrModeratorExists(id string, moderator sdk.AccAddress) sdk.Error {
+	return sdk.NewError(DefaultCodespace, ErrorCodeModeratorExists, fmt.Sprintf("%s is already a moderator of %s", moderator, id))
+}
+
+// ErrModeratorNotFound throws an error when removing an address that isn't a moderator
+func ErrModeratorNotFound(id string, moderator sdk.AccAddress) sdk.Error {
+	return sdk.NewError(DefaultCodespace, ErrorCodeModeratorNotFound, fmt.Sprintf("%s is not a moderator of %s", moderator, id))
+}
+
+// ErrAlreadyFollowing throws an error when following a community twice
+func ErrAlreadyFollowing(id string) sdk.Error {
+	return sdk.NewError(DefaultCodespace, ErrorCodeAlreadyFollowing, fmt.Sprintf("Already following community %s", id))
+}
+
+// ErrNotFollowing throws an error when unfollowing a community that isn't followed
+func ErrNotFollowing(id string) sdk.Error {
+	return sdk.NewError(DefaultCodespace, ErrorCodeNotFollowing, fmt.Sprintf("Not following community %s", id))
+}
+
+// ErrInvalidParent throws an error when a community can't be nested under a parent
+func ErrInvalidParent(id, parentID string) sdk.Error {
+	return sdk.NewError(DefaultCodespace, ErrorCodeInvalidParent,
+		fmt.Sprintf("Community %s can't be nested under %s", id, parentID))
+}
+
+// ErrMaxDepthExceeded throws an error when nesting a community goes past the maximum depth
+func ErrMaxDepthExceeded(maxDepth int) sdk.Error {
+	return sdk.NewError(DefaultCodespace, ErrorCodeMaxDepthExceeded,
+		fmt.Sprintf("Communities can't be nested more than %d levels deep", maxDepth))
+}
+
+// ErrParamOverridesNotFound throws an error when a community has no param overrides
+func ErrParamOverridesNotFound(id string) sdk.Error {
+	return sdk.NewError(DefaultCodespace, ErrorCodeOverridesNotFound, fmt.Sprintf("Community %s has no param overrides", id))
+}
+
+// ErrInsufficientTreasury throws an error when a community treasury can't cover a spend
+func ErrInsufficientTreasury(id string, balance sdk.Coin) sdk.Error {
+	return sdk.NewError(DefaultCodespace, ErrorCodeInsufficientTreasury,
+		fmt.Sprintf("Treasury of community %s only holds %s", id, balance))
+}
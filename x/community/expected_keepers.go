@@ -0,0 +1,23 @@
+package community
+
+import (
+	bankexported "github.com/TruStory/truchain/x/bank/exported"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BankKeeper is the expected bank keeper interface for this module
+type BankKeeper interface {
+	AddCoin(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coin,
+		referenceID uint64, txType bankexported.TransactionType, setters ...bankexported.TransactionSetter) (sdk.Coins, sdk.Error)
+
+	SendCoinBetweenModules(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coin,
+		referenceID uint64, txType bankexported.TransactionType, setters ...bankexported.TransactionSetter) sdk.Error
+
+	GetCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+}
+
+// ParamsKeeper is the expected interface of the modules whose params can be overridden in a community
+type ParamsKeeper interface {
+	// GlobalParamOverrides returns the global params of the module that communities can override
+	GlobalParamOverrides(ctx sdk.Context) ParamOverrides
+}
@@ -4,13 +4,19 @@ import (
 	"fmt"
 	"time"
 
+	app "github.com/TruStory/truchain/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // GenesisState defines genesis data for the module
 type GenesisState struct {
-	Communities []Community `json:"communities"`
-	Params      Params      `json:"params"`
+	Communities []Community      `json:"communities"`
+	Params      Params           `json:"params"`
+	Moderators  []Moderator      `json:"moderators"`
+	Follows     []Follow         `json:"follows"`
+	Stats       []Stats          `json:"stats"`
+	Overrides   []ParamOverrides `json:"param_overrides"`
+	Treasuries  []Treasury       `json:"treasuries"`
 }
 
 // NewGenesisState creates a new genesis state.
@@ -33,6 +39,24 @@ func DefaultGenesisState() GenesisState {
 func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
 	for _, community := range data.Communities {
 		keeper.setCommunity(ctx, community)
+		if community.ParentID != "" {
+			keeper.setChild(ctx, community.ParentID, community.ID)
+		}
+	}
+	for _, moderator := range data.Moderators {
+		keeper.setModerator(ctx, moderator.CommunityID, moderator.Address)
+	}
+	for _, follow := range data.Follows {
+		keeper.setFollow(ctx, follow.CommunityID, follow.Follower)
+	}
+	for _, stats := range data.Stats {
+		keeper.SetStats(ctx, stats)
+	}
+	for _, overrides := range data.Overrides {
+		keeper.setParamOverrides(ctx, overrides)
+	}
+	for _, treasury := range data.Treasuries {
+		keeper.setTreasury(ctx, treasury)
 	}
 	keeper.SetParams(ctx, data.Params)
 }
@@ -42,7 +66,26 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
 	return GenesisState{
 		Communities: keeper.Communities(ctx),
 		Params:      keeper.GetParams(ctx),
+		Moderators:  keeper.moderators(ctx, ModeratorKeyPrefix),
+		Follows:     keeper.follows(ctx),
+		Stats:       keeper.allStats(ctx),
+		Overrides:   keeper.allParamOverrides(ctx),
+		Treasuries:  keeper.allTreasuries(ctx),
+	}
+}
+
+// defaultMissingParams sets the params added after a genesis file was exported to their defaults,
+// so older genesis files can still be validated and imported
+func defaultMissingParams(data GenesisState) GenesisState {
+	defaults := DefaultParams()
+	if data.Params.MaxIconURILength == 0 {
+		data.Params.MaxIconURILength = defaults.MaxIconURILength
+	}
+	if data.Params.MaxRulesLength == 0 {
+		data.Params.MaxRulesLength = defaults.MaxRulesLength
 	}
+
+	return data
 }
 
 // ValidateGenesis validates the genesis state data
@@ -67,9 +110,75 @@ func ValidateGenesis(data GenesisState) error {
 		return fmt.Errorf("Param: MaxDescriptionLength, must have a positive value")
 	}
 
+	if data.Params.MaxIconURILength < 1 {
+		return fmt.Errorf("Param: MaxIconURILength, must have a positive value")
+	}
+
+	if data.Params.MaxRulesLength < 1 {
+		return fmt.Errorf("Param: MaxRulesLength, must have a positive value")
+	}
+
+	if data.Params.MaxCommunityDepth < 1 {
+		return fmt.Errorf("Param: MaxCommunityDepth, must have a positive value")
+	}
+
+	if data.Params.TreasuryShare.IsNil() || data.Params.TreasuryShare.IsNegative() || data.Params.TreasuryShare.GT(sdk.OneDec()) {
+		return fmt.Errorf("Param: TreasuryShare, must be between 0 and 1")
+	}
+
 	if len(data.Params.CommunityAdmins) < 1 {
 		return fmt.Errorf("Param: CommunityAdmins, must have atleast one admin")
 	}
 
+	communityIDs := make(map[string]bool)
+	parents := make(map[string]string)
+	for _, community := range data.Communities {
+		communityIDs[community.ID] = true
+		parents[community.ID] = community.ParentID
+	}
+	for _, community := range data.Communities {
+		depth := 0
+		for parentID := community.ParentID; parentID != ""; parentID = parents[parentID] {
+			if !communityIDs[parentID] {
+				return fmt.Errorf("Community %s has an unknown parent %s", community.ID, parentID)
+			}
+			depth++
+			if depth > data.Params.MaxCommunityDepth {
+				return fmt.Errorf("Community %s is nested deeper than %d levels or in a cycle", community.ID, data.Params.MaxCommunityDepth)
+			}
+		}
+	}
+	for _, moderator := range data.Moderators {
+		if !communityIDs[moderator.CommunityID] {
+			return fmt.Errorf("Moderator %s belongs to an unknown community %s", moderator.Address, moderator.CommunityID)
+		}
+	}
+	for _, follow := range data.Follows {
+		if !communityIDs[follow.CommunityID] {
+			return fmt.Errorf("Follower %s follows an unknown community %s", follow.Follower, follow.CommunityID)
+		}
+	}
+	for _, stats := range data.Stats {
+		if !communityIDs[stats.CommunityID] {
+			return fmt.Errorf("Stats belong to an unknown community %s", stats.CommunityID)
+		}
+	}
+	for _, overrides := range data.Overrides {
+		if !communityIDs[overrides.CommunityID] {
+			return fmt.Errorf("Param overrides belong to an unknown community %s", overrides.CommunityID)
+		}
+		if err := overrides.Validate(); err != nil {
+			return fmt.Errorf("Param overrides of community %s: %s", overrides.CommunityID, err)
+		}
+	}
+	for _, treasury := range data.Treasuries {
+		if !communityIDs[treasury.CommunityID] {
+			return fmt.Errorf("Treasury belongs to an unknown community %s", treasury.CommunityID)
+		}
+		if treasury.Balance.Denom != app.StakeDenom || treasury.Balance.IsNegative() {
+			return fmt.Errorf("Treasury of community %s must hold a non-negative %s amount", treasury.CommunityID, app.StakeDenom)
+		}
+	}
+
 	return nil
 }
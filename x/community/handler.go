@@ -12,6 +12,26 @@ func NewHandler(k Keeper) sdk.Handler {
 		switch msg := msg.(type) {
 		case MsgNewCommunity:
 			return handleMsgNewCommunity(ctx, k, msg)
+		case MsgUpdateCommunity:
+			return handleMsgUpdateCommunity(ctx, k, msg)
+		case MsgArchiveCommunity:
+			return handleMsgArchiveCommunity(ctx, k, msg)
+		case MsgSetCommunityParent:
+			return handleMsgSetCommunityParent(ctx, k, msg)
+		case MsgSetParamOverrides:
+			return handleMsgSetParamOverrides(ctx, k, msg)
+		case MsgClearParamOverrides:
+			return handleMsgClearParamOverrides(ctx, k, msg)
+		case MsgTreasurySpend:
+			return handleMsgTreasurySpend(ctx, k, msg)
+		case MsgAddModerator:
+			return handleMsgAddModerator(ctx, k, msg)
+		case MsgRemoveModerator:
+			return handleMsgRemoveModerator(ctx, k, msg)
+		case MsgFollowCommunity:
+			return handleMsgFollowCommunity(ctx, k, msg)
+		case MsgUnfollowCommunity:
+			return handleMsgUnfollowCommunity(ctx, k, msg)
 		case MsgAddAdmin:
 			return handleMsgAddAdmin(ctx, k, msg)
 		case MsgRemoveAdmin:
@@ -45,6 +65,206 @@ func handleMsgNewCommunity(ctx sdk.Context, k Keeper, msg MsgNewCommunity) sdk.R
 	}
 }
 
+func handleMsgUpdateCommunity(ctx sdk.Context, k Keeper, msg MsgUpdateCommunity) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	community, err := k.UpdateCommunity(ctx, msg.ID, msg.Name, msg.Description, msg.IconURI, msg.Rules, msg.Updater)
+	if err != nil {
+		return err.Result()
+	}
+
+	res, jsonErr := ModuleCodec.MarshalJSON(community)
+	if jsonErr != nil {
+		return sdk.ErrInternal(fmt.Sprintf("Marshal result error: %s", jsonErr)).Result()
+	}
+
+	return sdk.Result{
+		Data: res,
+	}
+}
+
+func handleMsgArchiveCommunity(ctx sdk.Context, k Keeper, msg MsgArchiveCommunity) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	community, err := k.ArchiveCommunity(ctx, msg.ID, msg.Admin)
+	if err != nil {
+		return err.Result()
+	}
+
+	res, jsonErr := ModuleCodec.MarshalJSON(community)
+	if jsonErr != nil {
+		return sdk.ErrInternal(fmt.Sprintf("Marshal result error: %s", jsonErr)).Result()
+	}
+
+	return sdk.Result{
+		Data: res,
+	}
+}
+
+func handleMsgSetCommunityParent(ctx sdk.Context, k Keeper, msg MsgSetCommunityParent) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	community, err := k.SetParent(ctx, msg.ID, msg.ParentID, msg.Admin)
+	if err != nil {
+		return err.Result()
+	}
+
+	res, jsonErr := ModuleCodec.MarshalJSON(community)
+	if jsonErr != nil {
+		return sdk.ErrInternal(fmt.Sprintf("Marshal result error: %s", jsonErr)).Result()
+	}
+
+	return sdk.Result{
+		Data: res,
+	}
+}
+
+func handleMsgSetParamOverrides(ctx sdk.Context, k Keeper, msg MsgSetParamOverrides) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	err := k.SetParamOverrides(ctx, msg.Overrides, msg.Admin)
+	if err != nil {
+		return err.Result()
+	}
+
+	res, jsonErr := ModuleCodec.MarshalJSON(true)
+	if jsonErr != nil {
+		return sdk.ErrInternal(fmt.Sprintf("Marshal result error: %s", jsonErr)).Result()
+	}
+
+	return sdk.Result{
+		Data: res,
+	}
+}
+
+func handleMsgClearParamOverrides(ctx sdk.Context, k Keeper, msg MsgClearParamOverrides) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	err := k.ClearParamOverrides(ctx, msg.CommunityID, msg.Admin)
+	if err != nil {
+		return err.Result()
+	}
+
+	res, jsonErr := ModuleCodec.MarshalJSON(true)
+	if jsonErr != nil {
+		return sdk.ErrInternal(fmt.Sprintf("Marshal result error: %s", jsonErr)).Result()
+	}
+
+	return sdk.Result{
+		Data: res,
+	}
+}
+
+func handleMsgTreasurySpend(ctx sdk.Context, k Keeper, msg MsgTreasurySpend) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	treasury, err := k.SpendTreasury(ctx, msg.CommunityID, msg.Recipient, msg.Amount, msg.Admin)
+	if err != nil {
+		return err.Result()
+	}
+
+	res, jsonErr := ModuleCodec.MarshalJSON(treasury)
+	if jsonErr != nil {
+		return sdk.ErrInternal(fmt.Sprintf("Marshal result error: %s", jsonErr)).Result()
+	}
+
+	return sdk.Result{
+		Data: res,
+	}
+}
+
+func handleMsgAddModerator(ctx sdk.Context, k Keeper, msg MsgAddModerator) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	err := k.AddModerator(ctx, msg.CommunityID, msg.Moderator, msg.Creator)
+	if err != nil {
+		return err.Result()
+	}
+
+	res, jsonErr := ModuleCodec.MarshalJSON(true)
+	if jsonErr != nil {
+		return sdk.ErrInternal(fmt.Sprintf("Marshal result error: %s", jsonErr)).Result()
+	}
+
+	return sdk.Result{
+		Data: res,
+	}
+}
+
+func handleMsgRemoveModerator(ctx sdk.Context, k Keeper, msg MsgRemoveModerator) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	err := k.RemoveModerator(ctx, msg.CommunityID, msg.Moderator, msg.Remover)
+	if err != nil {
+		return err.Result()
+	}
+
+	res, jsonErr := ModuleCodec.MarshalJSON(true)
+	if jsonErr != nil {
+		return sdk.ErrInternal(fmt.Sprintf("Marshal result error: %s", jsonErr)).Result()
+	}
+
+	return sdk.Result{
+		Data: res,
+	}
+}
+
+func handleMsgFollowCommunity(ctx sdk.Context, k Keeper, msg MsgFollowCommunity) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	err := k.FollowCommunity(ctx, msg.CommunityID, msg.Follower)
+	if err != nil {
+		return err.Result()
+	}
+
+	res, jsonErr := ModuleCodec.MarshalJSON(true)
+	if jsonErr != nil {
+		return sdk.ErrInternal(fmt.Sprintf("Marshal result error: %s", jsonErr)).Result()
+	}
+
+	return sdk.Result{
+		Data: res,
+	}
+}
+
+func handleMsgUnfollowCommunity(ctx sdk.Context, k Keeper, msg MsgUnfollowCommunity) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	err := k.UnfollowCommunity(ctx, msg.CommunityID, msg.Follower)
+	if err != nil {
+		return err.Result()
+	}
+
+	res, jsonErr := ModuleCodec.MarshalJSON(true)
+	if jsonErr != nil {
+		return sdk.ErrInternal(fmt.Sprintf("Marshal result error: %s", jsonErr)).Result()
+	}
+
+	return sdk.Result{
+		Data: res,
+	}
+}
+
 func handleMsgAddAdmin(ctx sdk.Context, k Keeper, msg MsgAddAdmin) sdk.Result {
 	if err := msg.ValidateBasic(); err != nil {
 		return err.Result()
@@ -0,0 +1,155 @@
+package community
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetParent nests a community under a parent community. An empty parentID
+// moves the community back to the top level.
+func (k Keeper) SetParent(ctx sdk.Context, id, parentID string, admin sdk.AccAddress) (community Community, err sdk.Error) {
+	if !k.isAdmin(ctx, admin) {
+		return community, ErrAddressNotAuthorised()
+	}
+	community, err = k.Community(ctx, id)
+	if err != nil {
+		return
+	}
+	if community.IsArchived() {
+		return community, ErrCommunityArchived(id)
+	}
+	if parentID != "" {
+		err = k.validateParent(ctx, community, parentID)
+		if err != nil {
+			return
+		}
+	}
+
+	// the community inherits new param overrides, so they're validated before the move is kept
+	cacheCtx, write := ctx.CacheContext()
+	if community.ParentID != "" {
+		k.store(cacheCtx).Delete(childCommunityKey(community.ParentID, id))
+	}
+	if parentID != "" {
+		k.setChild(cacheCtx, parentID, id)
+	}
+	community.ParentID = parentID
+	community.UpdatedTime = ctx.BlockHeader().Time
+	k.setCommunity(cacheCtx, community)
+	err = k.validateEffectiveOverrides(cacheCtx, id)
+	if err != nil {
+		return
+	}
+	write()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeCommunityParentUpdated,
+			sdk.NewAttribute(AttributeKeyCommunityID, id),
+			sdk.NewAttribute(AttributeKeyParentID, parentID),
+		),
+	)
+
+	return community, nil
+}
+
+// Children returns the communities nested directly under a community
+func (k Keeper) Children(ctx sdk.Context, id string) []Community {
+	children := make([]Community, 0)
+	for _, childID := range k.childIDs(ctx, id) {
+		child, err := k.Community(ctx, childID)
+		if err != nil {
+			continue
+		}
+		children = append(children, child)
+	}
+
+	return children
+}
+
+// Ancestors returns the parents of a community, closest first
+func (k Keeper) Ancestors(ctx sdk.Context, id string) []Community {
+	ancestors := make([]Community, 0)
+	community, err := k.Community(ctx, id)
+	if err != nil {
+		return ancestors
+	}
+	// the depth limit keeps the walk bounded even if the store was corrupted
+	maxDepth := k.GetParams(ctx).MaxCommunityDepth
+	for community.ParentID != "" && len(ancestors) < maxDepth {
+		community, err = k.Community(ctx, community.ParentID)
+		if err != nil {
+			break
+		}
+		ancestors = append(ancestors, community)
+	}
+
+	return ancestors
+}
+
+// Descendants returns the IDs of every community nested under a community, breadth first
+func (k Keeper) Descendants(ctx sdk.Context, id string) []string {
+	descendants := make([]string, 0)
+	queue := k.childIDs(ctx, id)
+	for len(queue) > 0 {
+		childID := queue[0]
+		queue = queue[1:]
+		descendants = append(descendants, childID)
+		queue = append(queue, k.childIDs(ctx, childID)...)
+	}
+
+	return descendants
+}
+
+func (k Keeper) validateParent(ctx sdk.Context, community Community, parentID string) sdk.Error {
+	if parentID == community.ID {
+		return ErrInvalidParent(community.ID, parentID)
+	}
+	parent, err := k.Community(ctx, parentID)
+	if err != nil {
+		return err
+	}
+	if parent.IsArchived() {
+		return ErrCommunityArchived(parentID)
+	}
+	ancestors := k.Ancestors(ctx, parentID)
+	for _, ancestor := range ancestors {
+		if ancestor.ID == community.ID {
+			return ErrInvalidParent(community.ID, parentID)
+		}
+	}
+	maxDepth := k.GetParams(ctx).MaxCommunityDepth
+	if len(ancestors)+1+k.height(ctx, community.ID) > maxDepth {
+		return ErrMaxDepthExceeded(maxDepth)
+	}
+
+	return nil
+}
+
+// height returns the number of levels nested under a community
+func (k Keeper) height(ctx sdk.Context, id string) int {
+	height := 0
+	for _, childID := range k.childIDs(ctx, id) {
+		if h := k.height(ctx, childID) + 1; h > height {
+			height = h
+		}
+	}
+
+	return height
+}
+
+func (k Keeper) childIDs(ctx sdk.Context, id string) []string {
+	childIDs := make([]string, 0)
+	iterator := sdk.KVStorePrefixIterator(k.store(ctx), childCommunitiesKey(id))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var childID string
+		k.codec.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &childID)
+		childIDs = append(childIDs, childID)
+	}
+
+	return childIDs
+}
+
+func (k Keeper) setChild(ctx sdk.Context, parentID, childID string) {
+	k.store(ctx).Set(childCommunityKey(parentID, childID), k.codec.MustMarshalBinaryLengthPrefixed(childID))
+}
@@ -0,0 +1,30 @@
+package community
+
+import (
+	"fmt"
+
+	app "github.com/TruStory/truchain/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+)
+
+// RegisterInvariants registers the community module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(ModuleName, "treasury", TreasuryInvariant(k))
+}
+
+// TreasuryInvariant checks that the community treasuries add up to the coins of the treasury module account
+func TreasuryInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		total := sdk.ZeroInt()
+		for _, treasury := range k.allTreasuries(ctx) {
+			total = total.Add(treasury.Balance.Amount)
+		}
+		held := k.bankKeeper.GetCoins(ctx, supply.NewModuleAddress(TreasuryPoolName)).AmountOf(app.StakeDenom)
+
+		broken := !total.Equal(held)
+		msg := fmt.Sprintf("\tsum of community treasuries: %s\n\ttreasury module account: %s\n", total, held)
+
+		return sdk.FormatInvariant(ModuleName, "treasury", msg), broken
+	}
+}
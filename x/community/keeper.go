@@ -2,6 +2,7 @@ package community
 
 import (
 	"fmt"
+	"net/url"
 
 	app "github.com/TruStory/truchain/types"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -13,20 +14,35 @@ import (
 
 // Keeper data type storing keys to the KVStore
 type Keeper struct {
-	storeKey   sdk.StoreKey
-	codec      *codec.Codec
-	paramStore params.Subspace
+	storeKey      sdk.StoreKey
+	codec         *codec.Codec
+	paramStore    params.Subspace
+	bankKeeper    BankKeeper
+	paramsKeepers []ParamsKeeper
 }
 
 // NewKeeper creates a new keeper of the community Keeper
-func NewKeeper(storeKey sdk.StoreKey, paramStore params.Subspace, codec *codec.Codec) Keeper {
+func NewKeeper(storeKey sdk.StoreKey, paramStore params.Subspace, codec *codec.Codec, bankKeeper BankKeeper) Keeper {
 	return Keeper{
 		storeKey,
 		codec,
 		paramStore.WithKeyTable(ParamKeyTable()),
+		bankKeeper,
+		nil,
 	}
 }
 
+// SetParamsKeepers sets the keepers of the modules whose params can be overridden, used to validate
+// the param overrides against the global params. They're created after the community keeper,
+// so they can't be passed to NewKeeper.
+func (k *Keeper) SetParamsKeepers(paramsKeepers ...ParamsKeeper) *Keeper {
+	if k.paramsKeepers != nil {
+		panic("cannot set community params keepers twice")
+	}
+	k.paramsKeepers = paramsKeepers
+	return k
+}
+
 // NewCommunity creates a new community
 func (k Keeper) NewCommunity(ctx sdk.Context, id string, name string, description string, creator sdk.AccAddress) (community Community, err sdk.Error) {
 	err = k.validateParams(ctx, id, name, description, creator)
@@ -47,6 +63,70 @@ func (k Keeper) NewCommunity(ctx sdk.Context, id string, name string, descriptio
 	return
 }
 
+// UpdateCommunity updates the name, description, icon and rules of a community
+func (k Keeper) UpdateCommunity(ctx sdk.Context, id, name, description, iconURI, rules string,
+	updater sdk.AccAddress) (community Community, err sdk.Error) {
+
+	if !k.isAdmin(ctx, updater) {
+		return community, ErrAddressNotAuthorised()
+	}
+	err = k.validateMetadata(ctx, name, description, iconURI, rules)
+	if err != nil {
+		return
+	}
+	community, err = k.Community(ctx, id)
+	if err != nil {
+		return
+	}
+	if community.IsArchived() {
+		return community, ErrCommunityArchived(id)
+	}
+
+	community.Name = name
+	community.Description = description
+	community.IconURI = iconURI
+	community.Rules = rules
+	community.UpdatedTime = ctx.BlockHeader().Time
+	k.setCommunity(ctx, community)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeCommunityUpdated,
+			sdk.NewAttribute(AttributeKeyCommunityID, community.ID),
+		),
+	)
+
+	return community, nil
+}
+
+// ArchiveCommunity stops a community from accepting new claims.
+// Its existing claims stay queryable.
+func (k Keeper) ArchiveCommunity(ctx sdk.Context, id string, admin sdk.AccAddress) (community Community, err sdk.Error) {
+	if !k.isAdmin(ctx, admin) {
+		return community, ErrAddressNotAuthorised()
+	}
+	community, err = k.Community(ctx, id)
+	if err != nil {
+		return
+	}
+	if community.IsArchived() {
+		return community, ErrCommunityArchived(id)
+	}
+
+	community.Archived = true
+	community.ArchivedTime = ctx.BlockHeader().Time
+	k.setCommunity(ctx, community)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeCommunityArchived,
+			sdk.NewAttribute(AttributeKeyCommunityID, community.ID),
+		),
+	)
+
+	return community, nil
+}
+
 // Community returns a community by its ID
 func (k Keeper) Community(ctx sdk.Context, id string) (community Community, err sdk.Error) {
 	store := k.store(ctx)
@@ -74,6 +154,206 @@ func (k Keeper) Communities(ctx sdk.Context) (communities []Community) {
 	return
 }
 
+// AddModerator appoints a moderator of a community
+func (k Keeper) AddModerator(ctx sdk.Context, communityID string, moderator, admin sdk.AccAddress) sdk.Error {
+	if !k.isAdmin(ctx, admin) {
+		return ErrAddressNotAuthorised()
+	}
+	_, err := k.Community(ctx, communityID)
+	if err != nil {
+		return err
+	}
+	if k.hasModerator(ctx, communityID, moderator) {
+		return ErrModeratorExists(communityID, moderator)
+	}
+
+	k.setModerator(ctx, communityID, moderator)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeModeratorAdded,
+			sdk.NewAttribute(AttributeKeyCommunityID, communityID),
+			sdk.NewAttribute(AttributeKeyModerator, moderator.String()),
+		),
+	)
+
+	return nil
+}
+
+// RemoveModerator removes a moderator of a community
+func (k Keeper) RemoveModerator(ctx sdk.Context, communityID string, moderator, admin sdk.AccAddress) sdk.Error {
+	if !k.isAdmin(ctx, admin) {
+		return ErrAddressNotAuthorised()
+	}
+	if !k.hasModerator(ctx, communityID, moderator) {
+		return ErrModeratorNotFound(communityID, moderator)
+	}
+
+	k.store(ctx).Delete(communityModeratorKey(communityID, moderator))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeModeratorRemoved,
+			sdk.NewAttribute(AttributeKeyCommunityID, communityID),
+			sdk.NewAttribute(AttributeKeyModerator, moderator.String()),
+		),
+	)
+
+	return nil
+}
+
+// IsModerator returns true if the address moderates the community or one of its ancestors
+func (k Keeper) IsModerator(ctx sdk.Context, communityID string, address sdk.AccAddress) bool {
+	if k.hasModerator(ctx, communityID, address) {
+		return true
+	}
+	for _, ancestor := range k.Ancestors(ctx, communityID) {
+		if k.hasModerator(ctx, ancestor.ID, address) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// hasModerator returns true if the address was appointed a moderator of the community itself
+func (k Keeper) hasModerator(ctx sdk.Context, communityID string, address sdk.AccAddress) bool {
+	return k.store(ctx).Has(communityModeratorKey(communityID, address))
+}
+
+// Moderators returns the moderators appointed to a community, not including inherited ones
+func (k Keeper) Moderators(ctx sdk.Context, communityID string) []sdk.AccAddress {
+	moderators := make([]sdk.AccAddress, 0)
+	for _, moderator := range k.moderators(ctx, communityModeratorsKey(communityID)) {
+		moderators = append(moderators, moderator.Address)
+	}
+
+	return moderators
+}
+
+func (k Keeper) moderators(ctx sdk.Context, prefix []byte) (moderators []Moderator) {
+	iterator := sdk.KVStorePrefixIterator(k.store(ctx), prefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var moderator Moderator
+		k.codec.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &moderator)
+		moderators = append(moderators, moderator)
+	}
+
+	return
+}
+
+func (k Keeper) setModerator(ctx sdk.Context, communityID string, address sdk.AccAddress) {
+	moderator := Moderator{CommunityID: communityID, Address: address}
+	bz := k.codec.MustMarshalBinaryLengthPrefixed(moderator)
+	k.store(ctx).Set(communityModeratorKey(communityID, address), bz)
+}
+
+// FollowCommunity adds a community to the communities a user follows
+func (k Keeper) FollowCommunity(ctx sdk.Context, communityID string, follower sdk.AccAddress) sdk.Error {
+	community, err := k.Community(ctx, communityID)
+	if err != nil {
+		return err
+	}
+	if community.IsArchived() {
+		return ErrCommunityArchived(communityID)
+	}
+	if k.IsFollowing(ctx, communityID, follower) {
+		return ErrAlreadyFollowing(communityID)
+	}
+
+	k.setFollow(ctx, communityID, follower)
+	community.FollowerCount++
+	k.setCommunity(ctx, community)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeCommunityFollowed,
+			sdk.NewAttribute(AttributeKeyCommunityID, communityID),
+			sdk.NewAttribute(AttributeKeyFollower, follower.String()),
+		),
+	)
+
+	return nil
+}
+
+// UnfollowCommunity removes a community from the communities a user follows
+func (k Keeper) UnfollowCommunity(ctx sdk.Context, communityID string, follower sdk.AccAddress) sdk.Error {
+	community, err := k.Community(ctx, communityID)
+	if err != nil {
+		return err
+	}
+	if !k.IsFollowing(ctx, communityID, follower) {
+		return ErrNotFollowing(communityID)
+	}
+
+	store := k.store(ctx)
+	store.Delete(followedCommunityKey(follower, communityID))
+	store.Delete(communityFollowerKey(communityID, follower))
+	community.FollowerCount--
+	k.setCommunity(ctx, community)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeCommunityUnfollowed,
+			sdk.NewAttribute(AttributeKeyCommunityID, communityID),
+			sdk.NewAttribute(AttributeKeyFollower, follower.String()),
+		),
+	)
+
+	return nil
+}
+
+// IsFollowing returns true if the user follows the community
+func (k Keeper) IsFollowing(ctx sdk.Context, communityID string, follower sdk.AccAddress) bool {
+	return k.store(ctx).Has(communityFollowerKey(communityID, follower))
+}
+
+// FollowedCommunities returns the IDs of the communities a user follows
+func (k Keeper) FollowedCommunities(ctx sdk.Context, follower sdk.AccAddress) []string {
+	communityIDs := make([]string, 0)
+	iterator := sdk.KVStorePrefixIterator(k.store(ctx), followedCommunitiesKey(follower))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var communityID string
+		k.codec.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &communityID)
+		communityIDs = append(communityIDs, communityID)
+	}
+
+	return communityIDs
+}
+
+// Followers returns the users following a community
+func (k Keeper) Followers(ctx sdk.Context, communityID string) []sdk.AccAddress {
+	followers := make([]sdk.AccAddress, 0)
+	iterator := sdk.KVStorePrefixIterator(k.store(ctx), communityFollowersKey(communityID))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var follower sdk.AccAddress
+		k.codec.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &follower)
+		followers = append(followers, follower)
+	}
+
+	return followers
+}
+
+// follows returns every follow relationship, used for genesis export
+func (k Keeper) follows(ctx sdk.Context) (follows []Follow) {
+	for _, community := range k.Communities(ctx) {
+		for _, follower := range k.Followers(ctx, community.ID) {
+			follows = append(follows, Follow{CommunityID: community.ID, Follower: follower})
+		}
+	}
+
+	return
+}
+
+func (k Keeper) setFollow(ctx sdk.Context, communityID string, follower sdk.AccAddress) {
+	store := k.store(ctx)
+	store.Set(followedCommunityKey(follower, communityID), k.codec.MustMarshalBinaryLengthPrefixed(communityID))
+	store.Set(communityFollowerKey(communityID, follower), k.codec.MustMarshalBinaryLengthPrefixed(follower))
+}
+
 // AddAdmin adds a new admin
 func (k Keeper) AddAdmin(ctx sdk.Context, admin, creator sdk.AccAddress) (err sdk.Error) {
 	params := k.GetParams(ctx)
@@ -140,6 +420,38 @@ func (k Keeper) validateParams(ctx sdk.Context, id, name, description string, cr
 	return
 }
 
+func (k Keeper) validateMetadata(ctx sdk.Context, name, description, iconURI, rules string) sdk.Error {
+	params := k.GetParams(ctx)
+	if len(name) < params.MinNameLength || len(name) > params.MaxNameLength {
+		return ErrInvalidCommunityMsg(
+			fmt.Sprintf("Name must be between %d-%d chars in length", params.MinNameLength, params.MaxNameLength),
+		)
+	}
+	if len(description) > params.MaxDescriptionLength {
+		return ErrInvalidCommunityMsg(
+			fmt.Sprintf("Description must be less than %d chars in length", params.MaxDescriptionLength),
+		)
+	}
+	if len(iconURI) > params.MaxIconURILength {
+		return ErrInvalidCommunityMsg(
+			fmt.Sprintf("Icon URI must be less than %d chars in length", params.MaxIconURILength),
+		)
+	}
+	if iconURI != "" {
+		uri, err := url.Parse(iconURI)
+		if err != nil || uri.Scheme == "" {
+			return ErrInvalidCommunityMsg(fmt.Sprintf("Icon URI %s is not a valid URI", iconURI))
+		}
+	}
+	if len(rules) > params.MaxRulesLength {
+		return ErrInvalidCommunityMsg(
+			fmt.Sprintf("Rules must be less than %d chars in length", params.MaxRulesLength),
+		)
+	}
+
+	return nil
+}
+
 func (k Keeper) setCommunity(ctx sdk.Context, community Community) {
 	store := k.store(ctx)
 	bz := k.codec.MustMarshalBinaryLengthPrefixed(community)
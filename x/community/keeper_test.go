@@ -3,7 +3,9 @@ package community
 import (
 	"testing"
 
+	app "github.com/TruStory/truchain/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/supply"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -175,3 +177,340 @@ func TestRemoveAdmin_RemoverNotAuthorised(t *testing.T) {
 	assert.NotNil(t, err)
 	assert.Equal(t, ErrAddressNotAuthorised().Code(), err.Code())
 }
+
+func TestUpdateCommunity_Success(t *testing.T) {
+	ctx, keeper := mockDB()
+
+	admin := keeper.GetParams(ctx).CommunityAdmins[0]
+	community, err := keeper.UpdateCommunity(ctx, "crypto", "Crypto Assets", "All things crypto",
+		"https://trustory.io/icons/crypto.png", "Be civil.", admin)
+	assert.Nil(t, err)
+	assert.Equal(t, "Crypto Assets", community.Name)
+	assert.Equal(t, "https://trustory.io/icons/crypto.png", community.IconURI)
+	assert.Equal(t, "Be civil.", community.Rules)
+
+	stored, err := keeper.Community(ctx, "crypto")
+	assert.Nil(t, err)
+	assert.Equal(t, community, stored)
+}
+
+func TestUpdateCommunity_Errors(t *testing.T) {
+	ctx, keeper := mockDB()
+
+	admin := keeper.GetParams(ctx).CommunityAdmins[0]
+	_, err := keeper.UpdateCommunity(ctx, "crypto", "Crypto Assets", "", "", "", sdk.AccAddress([]byte{1, 2}))
+	assert.Equal(t, ErrorCodeAddressNotAuthorised, err.Code())
+
+	_, err = keeper.UpdateCommunity(ctx, "crypto", "Crypto Assets", "", "not a uri", "", admin)
+	assert.Equal(t, ErrorCodeInvalidCommunityMsg, err.Code())
+
+	_, err = keeper.UpdateCommunity(ctx, "unknown", "Unknown Community", "", "", "", admin)
+	assert.Equal(t, ErrorCodeCommunityNotFound, err.Code())
+}
+
+func TestArchiveCommunity(t *testing.T) {
+	ctx, keeper := mockDB()
+
+	admin := keeper.GetParams(ctx).CommunityAdmins[0]
+	_, err := keeper.ArchiveCommunity(ctx, "crypto", sdk.AccAddress([]byte{1, 2}))
+	assert.Equal(t, ErrorCodeAddressNotAuthorised, err.Code())
+
+	community, err := keeper.ArchiveCommunity(ctx, "crypto", admin)
+	assert.Nil(t, err)
+	assert.True(t, community.IsArchived())
+
+	// archived communities are still returned
+	assert.Len(t, keeper.Communities(ctx), 2)
+
+	_, err = keeper.ArchiveCommunity(ctx, "crypto", admin)
+	assert.Equal(t, ErrorCodeCommunityArchived, err.Code())
+	_, err = keeper.UpdateCommunity(ctx, "crypto", "Crypto Assets", "", "", "", admin)
+	assert.Equal(t, ErrorCodeCommunityArchived, err.Code())
+}
+
+func TestModerators(t *testing.T) {
+	ctx, keeper := mockDB()
+
+	admin := keeper.GetParams(ctx).CommunityAdmins[0]
+	moderator := getFakeAdmin()
+	err := keeper.AddModerator(ctx, "crypto", moderator, sdk.AccAddress([]byte{1, 2}))
+	assert.Equal(t, ErrorCodeAddressNotAuthorised, err.Code())
+	err = keeper.AddModerator(ctx, "unknown", moderator, admin)
+	assert.Equal(t, ErrorCodeCommunityNotFound, err.Code())
+
+	err = keeper.AddModerator(ctx, "crypto", moderator, admin)
+	assert.Nil(t, err)
+	err = keeper.AddModerator(ctx, "crypto", moderator, admin)
+	assert.Equal(t, ErrorCodeModeratorExists, err.Code())
+
+	// moderators are scoped to a single community
+	assert.True(t, keeper.IsModerator(ctx, "crypto", moderator))
+	assert.False(t, keeper.IsModerator(ctx, "meme", moderator))
+	assert.Equal(t, []sdk.AccAddress{moderator}, keeper.Moderators(ctx, "crypto"))
+	assert.Len(t, keeper.Moderators(ctx, "meme"), 0)
+
+	err = keeper.RemoveModerator(ctx, "meme", moderator, admin)
+	assert.Equal(t, ErrorCodeModeratorNotFound, err.Code())
+	err = keeper.RemoveModerator(ctx, "crypto", moderator, admin)
+	assert.Nil(t, err)
+	assert.False(t, keeper.IsModerator(ctx, "crypto", moderator))
+}
+
+func TestFollowCommunity(t *testing.T) {
+	ctx, keeper := mockDB()
+
+	follower := sdk.AccAddress([]byte{1, 2})
+	err := keeper.FollowCommunity(ctx, "unknown", follower)
+	assert.Equal(t, ErrorCodeCommunityNotFound, err.Code())
+
+	err = keeper.FollowCommunity(ctx, "crypto", follower)
+	assert.Nil(t, err)
+	err = keeper.FollowCommunity(ctx, "crypto", follower)
+	assert.Equal(t, ErrorCodeAlreadyFollowing, err.Code())
+	err = keeper.FollowCommunity(ctx, "meme", follower)
+	assert.Nil(t, err)
+
+	community, err := keeper.Community(ctx, "crypto")
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), community.FollowerCount)
+	assert.True(t, keeper.IsFollowing(ctx, "crypto", follower))
+	assert.ElementsMatch(t, []string{"crypto", "meme"}, keeper.FollowedCommunities(ctx, follower))
+	assert.Equal(t, []sdk.AccAddress{follower}, keeper.Followers(ctx, "crypto"))
+
+	err = keeper.UnfollowCommunity(ctx, "crypto", follower)
+	assert.Nil(t, err)
+	err = keeper.UnfollowCommunity(ctx, "crypto", follower)
+	assert.Equal(t, ErrorCodeNotFollowing, err.Code())
+
+	community, err = keeper.Community(ctx, "crypto")
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0), community.FollowerCount)
+	assert.Equal(t, []string{"meme"}, keeper.FollowedCommunities(ctx, follower))
+	assert.Len(t, keeper.Followers(ctx, "crypto"), 0)
+
+	admin := keeper.GetParams(ctx).CommunityAdmins[0]
+	_, err = keeper.ArchiveCommunity(ctx, "crypto", admin)
+	assert.Nil(t, err)
+	err = keeper.FollowCommunity(ctx, "crypto", follower)
+	assert.Equal(t, ErrorCodeCommunityArchived, err.Code())
+}
+
+func TestSetParent(t *testing.T) {
+	ctx, keeper := mockDB()
+
+	admin := keeper.GetParams(ctx).CommunityAdmins[0]
+	for _, id := range []string{"football", "premier", "league"} {
+		_, err := keeper.NewCommunity(ctx, id, "Community "+id, "", admin)
+		assert.Nil(t, err)
+	}
+
+	_, err := keeper.SetParent(ctx, "football", "crypto", sdk.AccAddress([]byte{1, 2}))
+	assert.Equal(t, ErrorCodeAddressNotAuthorised, err.Code())
+	_, err = keeper.SetParent(ctx, "football", "unknown", admin)
+	assert.Equal(t, ErrorCodeCommunityNotFound, err.Code())
+	_, err = keeper.SetParent(ctx, "football", "football", admin)
+	assert.Equal(t, ErrorCodeInvalidParent, err.Code())
+
+	community, err := keeper.SetParent(ctx, "football", "crypto", admin)
+	assert.Nil(t, err)
+	assert.Equal(t, "crypto", community.ParentID)
+	_, err = keeper.SetParent(ctx, "premier", "football", admin)
+	assert.Nil(t, err)
+	_, err = keeper.SetParent(ctx, "league", "premier", admin)
+	assert.Nil(t, err)
+
+	// a community can't be nested under one of its descendants
+	_, err = keeper.SetParent(ctx, "crypto", "league", admin)
+	assert.Equal(t, ErrorCodeInvalidParent, err.Code())
+	// crypto -> football -> premier -> league is already 3 levels deep
+	_, err = keeper.SetParent(ctx, "crypto", "meme", admin)
+	assert.Equal(t, ErrorCodeMaxDepthExceeded, err.Code())
+
+	children := keeper.Children(ctx, "crypto")
+	assert.Len(t, children, 1)
+	assert.Equal(t, "football", children[0].ID)
+	ancestors := keeper.Ancestors(ctx, "league")
+	assert.Len(t, ancestors, 3)
+	assert.Equal(t, "premier", ancestors[0].ID)
+	assert.Equal(t, "crypto", ancestors[2].ID)
+	assert.Equal(t, []string{"football", "premier", "league"}, keeper.Descendants(ctx, "crypto"))
+
+	// moderators are inherited from parent communities
+	moderator := getFakeAdmin()
+	err = keeper.AddModerator(ctx, "football", moderator, admin)
+	assert.Nil(t, err)
+	assert.True(t, keeper.IsModerator(ctx, "league", moderator))
+	assert.False(t, keeper.IsModerator(ctx, "crypto", moderator))
+	err = keeper.AddModerator(ctx, "league", moderator, admin)
+	assert.Nil(t, err)
+
+	// moving a community back to the top level
+	_, err = keeper.SetParent(ctx, "premier", "", admin)
+	assert.Nil(t, err)
+	assert.Len(t, keeper.Children(ctx, "football"), 0)
+	assert.Equal(t, []string{"football"}, keeper.Descendants(ctx, "crypto"))
+	_, err = keeper.SetParent(ctx, "crypto", "meme", admin)
+	assert.Nil(t, err)
+}
+
+func TestParamOverrides(t *testing.T) {
+	ctx, keeper := mockDB()
+
+	admin := keeper.GetParams(ctx).CommunityAdmins[0]
+	_, err := keeper.NewCommunity(ctx, "football", "Football", "", admin)
+	assert.Nil(t, err)
+	_, err = keeper.SetParent(ctx, "football", "crypto", admin)
+	assert.Nil(t, err)
+
+	minLength, maxLength, zero := 10, 200, 0
+	upvoteStake := sdk.NewInt64Coin(app.StakeDenom, app.Shanev*20)
+	err = keeper.SetParamOverrides(ctx, ParamOverrides{CommunityID: "crypto", MinClaimLength: &minLength}, sdk.AccAddress([]byte{1, 2}))
+	assert.Equal(t, ErrorCodeAddressNotAuthorised, err.Code())
+	err = keeper.SetParamOverrides(ctx, ParamOverrides{CommunityID: "unknown", MinClaimLength: &minLength}, admin)
+	assert.Equal(t, ErrorCodeCommunityNotFound, err.Code())
+	err = keeper.SetParamOverrides(ctx, ParamOverrides{CommunityID: "crypto", MinClaimLength: &zero}, admin)
+	assert.Equal(t, ErrorCodeInvalidCommunityMsg, err.Code())
+
+	err = keeper.SetParamOverrides(ctx, ParamOverrides{CommunityID: "crypto", MinClaimLength: &minLength, UpvoteStake: &upvoteStake}, admin)
+	assert.Nil(t, err)
+	err = keeper.SetParamOverrides(ctx, ParamOverrides{CommunityID: "football", MaxClaimLength: &maxLength, MinClaimLength: &maxLength}, admin)
+	assert.Nil(t, err)
+
+	// football inherits the upvote stake of crypto but keeps its own claim lengths
+	effective := keeper.EffectiveOverrides(ctx, "football")
+	assert.Equal(t, "football", effective.CommunityID)
+	assert.Equal(t, maxLength, *effective.MinClaimLength)
+	assert.Equal(t, maxLength, *effective.MaxClaimLength)
+	assert.Equal(t, upvoteStake, *effective.UpvoteStake)
+	assert.Nil(t, effective.InterestRate)
+
+	err = keeper.ClearParamOverrides(ctx, "football", admin)
+	assert.Nil(t, err)
+	err = keeper.ClearParamOverrides(ctx, "football", admin)
+	assert.Equal(t, ErrorCodeOverridesNotFound, err.Code())
+	_, ok := keeper.ParamOverrides(ctx, "football")
+	assert.False(t, ok)
+	effective = keeper.EffectiveOverrides(ctx, "football")
+	assert.Equal(t, minLength, *effective.MinClaimLength)
+	assert.Nil(t, effective.MaxClaimLength)
+}
+
+func TestParamOverrides_EffectiveParams(t *testing.T) {
+	ctx, keeper := mockDB()
+	globalMin, globalMax := 25, 140
+	keeper.SetParamsKeepers(paramsKeeper{Global: ParamOverrides{MinClaimLength: &globalMin, MaxClaimLength: &globalMax}})
+
+	admin := keeper.GetParams(ctx).CommunityAdmins[0]
+	_, err := keeper.NewCommunity(ctx, "football", "Football", "", admin)
+	assert.Nil(t, err)
+
+	// the overrides are checked on top of the global params
+	minLength, maxLength := 10, 20
+	err = keeper.SetParamOverrides(ctx, ParamOverrides{CommunityID: "crypto", MaxClaimLength: &maxLength}, admin)
+	assert.Equal(t, ErrorCodeInvalidCommunityMsg, err.Code())
+	_, ok := keeper.ParamOverrides(ctx, "crypto")
+	assert.False(t, ok)
+	err = keeper.SetParamOverrides(ctx, ParamOverrides{CommunityID: "crypto", MinClaimLength: &minLength, MaxClaimLength: &maxLength}, admin)
+	assert.Nil(t, err)
+
+	// and on top of the overrides inherited from the new parent
+	footballMin := 30
+	err = keeper.SetParamOverrides(ctx, ParamOverrides{CommunityID: "football", MinClaimLength: &footballMin}, admin)
+	assert.Nil(t, err)
+	_, err = keeper.SetParent(ctx, "football", "crypto", admin)
+	assert.Equal(t, ErrorCodeInvalidCommunityMsg, err.Code())
+	football, err := keeper.Community(ctx, "football")
+	assert.Nil(t, err)
+	assert.Equal(t, "", football.ParentID)
+	assert.Len(t, keeper.Children(ctx, "crypto"), 0)
+
+	// descendants are checked when their ancestors change
+	err = keeper.ClearParamOverrides(ctx, "football", admin)
+	assert.Nil(t, err)
+	_, err = keeper.SetParent(ctx, "football", "crypto", admin)
+	assert.Nil(t, err)
+	err = keeper.SetParamOverrides(ctx, ParamOverrides{CommunityID: "football", MinClaimLength: &footballMin}, admin)
+	assert.Equal(t, ErrorCodeInvalidCommunityMsg, err.Code())
+	footballMax := 35
+	err = keeper.SetParamOverrides(ctx, ParamOverrides{CommunityID: "football", MaxClaimLength: &footballMax}, admin)
+	assert.Nil(t, err)
+	cryptoMin := 40
+	err = keeper.SetParamOverrides(ctx, ParamOverrides{CommunityID: "crypto", MinClaimLength: &cryptoMin}, admin)
+	assert.Equal(t, ErrorCodeInvalidCommunityMsg, err.Code())
+	overrides, _ := keeper.ParamOverrides(ctx, "crypto")
+	assert.Equal(t, minLength, *overrides.MinClaimLength)
+}
+
+func TestParamOverrides_InterestRate(t *testing.T) {
+	ctx, keeper := mockDB()
+	globalRate := sdk.NewDecWithPrec(10, 2)
+	keeper.SetParamsKeepers(paramsKeeper{Global: ParamOverrides{InterestRate: &globalRate}})
+	admin := keeper.GetParams(ctx).CommunityAdmins[0]
+
+	higherRate := sdk.NewDecWithPrec(20, 2)
+	err := keeper.SetParamOverrides(ctx, ParamOverrides{CommunityID: "crypto", InterestRate: &higherRate}, admin)
+	assert.Equal(t, ErrorCodeInvalidCommunityMsg, err.Code())
+	lowerRate := sdk.NewDecWithPrec(5, 2)
+	err = keeper.SetParamOverrides(ctx, ParamOverrides{CommunityID: "crypto", InterestRate: &lowerRate}, admin)
+	assert.Nil(t, err)
+}
+
+func TestTreasury(t *testing.T) {
+	ctx, keeper, bank := mockDBWithBank()
+
+	admin := keeper.GetParams(ctx).CommunityAdmins[0]
+	slashed := sdk.NewInt64Coin(app.StakeDenom, app.Shanev*50)
+	share := keeper.TreasuryShare(ctx, slashed)
+	assert.Equal(t, sdk.NewInt64Coin(app.StakeDenom, app.Shanev*5), share)
+
+	funder := getFakeAdmin()
+	rewardPool := supply.NewModuleAddress("user_reward_tokens_pool")
+	bank.Balances[rewardPool.String()] = sdk.Coins{slashed}
+	err := keeper.DepositToTreasury(ctx, "unknown", share, funder, 1, "user_reward_tokens_pool")
+	assert.Equal(t, ErrorCodeCommunityNotFound, err.Code())
+	err = keeper.DepositToTreasury(ctx, "crypto", share, funder, 1, "user_reward_tokens_pool")
+	assert.Nil(t, err)
+	assert.Equal(t, share, keeper.Treasury(ctx, "crypto").Balance)
+	assert.Equal(t, "crypto", bank.Transactions[0].CommunityID)
+	assert.Equal(t, TreasuryPoolName, bank.Transactions[0].ToModuleAccount)
+
+	recipient := getFakeAdmin()
+	spend := sdk.NewInt64Coin(app.StakeDenom, app.Shanev*2)
+	_, err = keeper.SpendTreasury(ctx, "crypto", recipient, spend, recipient)
+	assert.Equal(t, ErrorCodeAddressNotAuthorised, err.Code())
+	_, err = keeper.SpendTreasury(ctx, "meme", recipient, spend, admin)
+	assert.Equal(t, ErrorCodeInsufficientTreasury, err.Code())
+
+	treasury, err := keeper.SpendTreasury(ctx, "crypto", recipient, spend, admin)
+	assert.Nil(t, err)
+	assert.Equal(t, sdk.NewInt64Coin(app.StakeDenom, app.Shanev*3), treasury.Balance)
+	assert.Equal(t, spend.Amount, bank.GetCoins(ctx, recipient).AmountOf(app.StakeDenom))
+	assert.Equal(t, []Treasury{treasury}, ExportGenesis(ctx, keeper).Treasuries)
+
+	_, broken := TreasuryInvariant(keeper)(ctx)
+	assert.False(t, broken)
+	keeper.setTreasury(ctx, NewTreasury("meme"))
+	bank.Balances[recipient.String()] = nil
+	treasury.Balance = treasury.Balance.Add(spend)
+	keeper.setTreasury(ctx, treasury)
+	_, broken = TreasuryInvariant(keeper)(ctx)
+	assert.True(t, broken)
+}
+
+func TestGenesis_DefaultsMissingParams(t *testing.T) {
+	ctx, keeper := mockDB()
+
+	// genesis files exported before the icon and rules params existed
+	genesis := ExportGenesis(ctx, keeper)
+	genesis.Params.MaxIconURILength = 0
+	genesis.Params.MaxRulesLength = 0
+	assert.Error(t, ValidateGenesis(genesis))
+
+	bz := ModuleCodec.MustMarshalJSON(genesis)
+	assert.NoError(t, AppModuleBasic{}.ValidateGenesis(bz))
+
+	ctx, keeper = mockDB()
+	NewAppModule(keeper).InitGenesis(ctx, bz)
+	assert.Equal(t, DefaultParams().MaxIconURILength, keeper.GetParams(ctx).MaxIconURILength)
+	assert.Equal(t, DefaultParams().MaxRulesLength, keeper.GetParams(ctx).MaxRulesLength)
+}
@@ -1,14 +1,91 @@
 package community
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // Keys for community store
 // Items are stored with the following key: values
 //
 // - 0x00<communityID_Bytes>: Community{} bytes
+// - 0x01<len(communityID)><communityID_Bytes><moderator_Address>: Moderator{} bytes
+// - 0x02<follower_Address><communityID_Bytes>: communityID
+// - 0x03<len(communityID)><communityID_Bytes><follower_Address>: follower_Address
+// - 0x04<len(parentID)><parentID_Bytes><childID_Bytes>: childID
+// - 0x05<communityID_Bytes>: Stats{} bytes
+// - 0x06<communityID_Bytes>: ParamOverrides{} bytes
+// - 0x07<communityID_Bytes>: Treasury{} bytes
 var (
-	CommunityKeyPrefix = []byte{0x00}
+	CommunityKeyPrefix        = []byte{0x00}
+	ModeratorKeyPrefix        = []byte{0x01}
+	FollowedCommunitiesPrefix = []byte{0x02}
+	CommunityFollowersPrefix  = []byte{0x03}
+	ChildCommunitiesPrefix    = []byte{0x04}
+	StatsKeyPrefix            = []byte{0x05}
+	ParamOverridesKeyPrefix   = []byte{0x06}
+	TreasuryKeyPrefix         = []byte{0x07}
 )
 
 // key for getting a specific community from the store
 func key(id string) []byte {
 	return append(CommunityKeyPrefix, []byte(id)...)
 }
+
+// communityModeratorsKey gets the prefix for the moderators of a community.
+// The ID is length prefixed so that one ID can't be the prefix of another.
+func communityModeratorsKey(communityID string) []byte {
+	prefix := append(ModeratorKeyPrefix, byte(len(communityID)))
+	return append(prefix, []byte(communityID)...)
+}
+
+// communityModeratorKey gets the key for a moderator of a community
+func communityModeratorKey(communityID string, moderator sdk.AccAddress) []byte {
+	return append(communityModeratorsKey(communityID), moderator.Bytes()...)
+}
+
+// followedCommunitiesKey gets the prefix for the communities a user follows
+func followedCommunitiesKey(follower sdk.AccAddress) []byte {
+	return append(FollowedCommunitiesPrefix, follower.Bytes()...)
+}
+
+// followedCommunityKey gets the key for a community a user follows
+func followedCommunityKey(follower sdk.AccAddress, communityID string) []byte {
+	return append(followedCommunitiesKey(follower), []byte(communityID)...)
+}
+
+// communityFollowersKey gets the prefix for the followers of a community
+func communityFollowersKey(communityID string) []byte {
+	prefix := append(CommunityFollowersPrefix, byte(len(communityID)))
+	return append(prefix, []byte(communityID)...)
+}
+
+// communityFollowerKey gets the key for a follower of a community
+func communityFollowerKey(communityID string, follower sdk.AccAddress) []byte {
+	return append(communityFollowersKey(communityID), follower.Bytes()...)
+}
+
+// childCommunitiesKey gets the prefix for the children of a community
+func childCommunitiesKey(parentID string) []byte {
+	prefix := append(ChildCommunitiesPrefix, byte(len(parentID)))
+	return append(prefix, []byte(parentID)...)
+}
+
+// childCommunityKey gets the key for a child of a community
+func childCommunityKey(parentID, childID string) []byte {
+	return append(childCommunitiesKey(parentID), []byte(childID)...)
+}
+
+// statsKey gets the key for the stats of a community
+func statsKey(communityID string) []byte {
+	return append(StatsKeyPrefix, []byte(communityID)...)
+}
+
+// paramOverridesKey gets the key for the param overrides of a community
+func paramOverridesKey(communityID string) []byte {
+	return append(ParamOverridesKeyPrefix, []byte(communityID)...)
+}
+
+// treasuryKey gets the key for the treasury of a community
+func treasuryKey(communityID string) []byte {
+	return append(TreasuryKeyPrefix, []byte(communityID)...)
+}
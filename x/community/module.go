@@ -47,7 +47,7 @@ func (AppModuleBasic) ValidateGenesis(bz json.RawMessage) error {
 	if err != nil {
 		return err
 	}
-	return ValidateGenesis(data)
+	return ValidateGenesis(defaultMissingParams(data))
 }
 
 // RegisterRESTRoutes registers the REST routes for the community module.
@@ -81,6 +81,7 @@ func NewAppModule(keeper Keeper) AppModule {
 
 // RegisterInvariants enforces registering of invariants
 func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	RegisterInvariants(ir, am.keeper)
 }
 
 // Route defines the key for the route
@@ -107,7 +108,7 @@ func (am AppModule) NewQuerierHandler() sdk.Querier {
 func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abci.ValidatorUpdate {
 	var genesisState GenesisState
 	ModuleCodec.MustUnmarshalJSON(data, &genesisState)
-	InitGenesis(ctx, am.keeper, genesisState)
+	InitGenesis(ctx, am.keeper, defaultMissingParams(genesisState))
 	return []abci.ValidatorUpdate{}
 }
 
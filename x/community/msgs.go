@@ -3,6 +3,7 @@ package community
 import (
 	"fmt"
 
+	app "github.com/TruStory/truchain/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -15,6 +16,26 @@ const (
 	TypeMsgRemoveAdmin = "remove_admin"
 	// TypeMsgUpdateParams represents the type of
 	TypeMsgUpdateParams = "update_params"
+	// TypeMsgUpdateCommunity represents the type of message for updating a community
+	TypeMsgUpdateCommunity = "update_community"
+	// TypeMsgArchiveCommunity represents the type of message for archiving a community
+	TypeMsgArchiveCommunity = "archive_community"
+	// TypeMsgSetCommunityParent represents the type of message for nesting a community under another
+	TypeMsgSetCommunityParent = "set_community_parent"
+	// TypeMsgSetParamOverrides represents the type of message for overriding params inside a community
+	TypeMsgSetParamOverrides = "set_param_overrides"
+	// TypeMsgClearParamOverrides represents the type of message for clearing the param overrides of a community
+	TypeMsgClearParamOverrides = "clear_param_overrides"
+	// TypeMsgTreasurySpend represents the type of message for spending from a community treasury
+	TypeMsgTreasurySpend = "treasury_spend"
+	// TypeMsgAddModerator represents the type of message for appointing a community moderator
+	TypeMsgAddModerator = "add_moderator"
+	// TypeMsgRemoveModerator represents the type of message for removing a community moderator
+	TypeMsgRemoveModerator = "remove_moderator"
+	// TypeMsgFollowCommunity represents the type of message for following a community
+	TypeMsgFollowCommunity = "follow_community"
+	// TypeMsgUnfollowCommunity represents the type of message for unfollowing a community
+	TypeMsgUnfollowCommunity = "unfollow_community"
 )
 
 // MsgNewCommunity defines the message to add a new admin
@@ -61,6 +82,489 @@ func (msg MsgNewCommunity) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sdk.AccAddress(msg.Creator)}
 }
 
+// MsgUpdateCommunity defines the message to update the details of a community
+type MsgUpdateCommunity struct {
+	ID          string         `json:"id"`
+	Name        string         `json:"name"`
+	Description string         `json:"description"`
+	IconURI     string         `json:"icon_uri,omitempty"`
+	Rules       string         `json:"rules,omitempty"`
+	Updater     sdk.AccAddress `json:"updater"`
+}
+
+// NewMsgUpdateCommunity returns the message to update the details of a community
+func NewMsgUpdateCommunity(id, name, description, iconURI, rules string, updater sdk.AccAddress) MsgUpdateCommunity {
+	return MsgUpdateCommunity{
+		ID:          id,
+		Name:        name,
+		Description: description,
+		IconURI:     iconURI,
+		Rules:       rules,
+		Updater:     updater,
+	}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgUpdateCommunity) ValidateBasic() sdk.Error {
+	if len(msg.ID) == 0 {
+		return ErrInvalidCommunityMsg("ID is required")
+	}
+
+	if len(msg.Updater) == 0 {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Invalid address: %s", msg.Updater.String()))
+	}
+
+	return nil
+}
+
+// Route implements Msg
+func (msg MsgUpdateCommunity) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgUpdateCommunity) Type() string { return TypeMsgUpdateCommunity }
+
+// GetSignBytes implements Msg
+func (msg MsgUpdateCommunity) GetSignBytes() []byte {
+	msgBytes := ModuleCodec.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(msgBytes)
+}
+
+// GetSigners implements Msg. Returns the updater as the signer.
+func (msg MsgUpdateCommunity) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Updater)}
+}
+
+// MsgArchiveCommunity defines the message to archive a community
+type MsgArchiveCommunity struct {
+	ID    string         `json:"id"`
+	Admin sdk.AccAddress `json:"admin"`
+}
+
+// NewMsgArchiveCommunity returns the message to archive a community
+func NewMsgArchiveCommunity(id string, admin sdk.AccAddress) MsgArchiveCommunity {
+	return MsgArchiveCommunity{
+		ID:    id,
+		Admin: admin,
+	}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgArchiveCommunity) ValidateBasic() sdk.Error {
+	if len(msg.ID) == 0 {
+		return ErrInvalidCommunityMsg("ID is required")
+	}
+
+	if len(msg.Admin) == 0 {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Invalid address: %s", msg.Admin.String()))
+	}
+
+	return nil
+}
+
+// Route implements Msg
+func (msg MsgArchiveCommunity) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgArchiveCommunity) Type() string { return TypeMsgArchiveCommunity }
+
+// GetSignBytes implements Msg
+func (msg MsgArchiveCommunity) GetSignBytes() []byte {
+	msgBytes := ModuleCodec.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(msgBytes)
+}
+
+// GetSigners implements Msg. Returns the admin as the signer.
+func (msg MsgArchiveCommunity) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Admin)}
+}
+
+// MsgSetCommunityParent defines the message to nest a community under a parent community
+type MsgSetCommunityParent struct {
+	ID       string         `json:"id"`
+	ParentID string         `json:"parent_id"`
+	Admin    sdk.AccAddress `json:"admin"`
+}
+
+// NewMsgSetCommunityParent returns the message to nest a community under a parent community.
+// An empty parentID moves the community back to the top level.
+func NewMsgSetCommunityParent(id, parentID string, admin sdk.AccAddress) MsgSetCommunityParent {
+	return MsgSetCommunityParent{
+		ID:       id,
+		ParentID: parentID,
+		Admin:    admin,
+	}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgSetCommunityParent) ValidateBasic() sdk.Error {
+	if len(msg.ID) == 0 {
+		return ErrInvalidCommunityMsg("ID is required")
+	}
+
+	if msg.ID == msg.ParentID {
+		return ErrInvalidParent(msg.ID, msg.ParentID)
+	}
+
+	if len(msg.Admin) == 0 {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Invalid address: %s", msg.Admin.String()))
+	}
+
+	return nil
+}
+
+// Route implements Msg
+func (msg MsgSetCommunityParent) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgSetCommunityParent) Type() string { return TypeMsgSetCommunityParent }
+
+// GetSignBytes implements Msg
+func (msg MsgSetCommunityParent) GetSignBytes() []byte {
+	msgBytes := ModuleCodec.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(msgBytes)
+}
+
+// GetSigners implements Msg. Returns the admin as the signer.
+func (msg MsgSetCommunityParent) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Admin)}
+}
+
+// MsgSetParamOverrides defines the message to override params inside a community
+type MsgSetParamOverrides struct {
+	Overrides ParamOverrides `json:"overrides"`
+	Admin     sdk.AccAddress `json:"admin"`
+}
+
+// NewMsgSetParamOverrides returns the message to override params inside a community
+func NewMsgSetParamOverrides(overrides ParamOverrides, admin sdk.AccAddress) MsgSetParamOverrides {
+	return MsgSetParamOverrides{
+		Overrides: overrides,
+		Admin:     admin,
+	}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgSetParamOverrides) ValidateBasic() sdk.Error {
+	if len(msg.Overrides.CommunityID) == 0 {
+		return ErrInvalidCommunityMsg("ID is required")
+	}
+
+	if err := msg.Overrides.Validate(); err != nil {
+		return ErrInvalidCommunityMsg(err.Error())
+	}
+
+	if len(msg.Admin) == 0 {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Invalid address: %s", msg.Admin.String()))
+	}
+
+	return nil
+}
+
+// Route implements Msg
+func (msg MsgSetParamOverrides) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgSetParamOverrides) Type() string { return TypeMsgSetParamOverrides }
+
+// GetSignBytes implements Msg
+func (msg MsgSetParamOverrides) GetSignBytes() []byte {
+	msgBytes := ModuleCodec.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(msgBytes)
+}
+
+// GetSigners implements Msg. Returns the admin as the signer.
+func (msg MsgSetParamOverrides) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Admin)}
+}
+
+// MsgClearParamOverrides defines the message to clear the param overrides of a community
+type MsgClearParamOverrides struct {
+	CommunityID string         `json:"community_id"`
+	Admin       sdk.AccAddress `json:"admin"`
+}
+
+// NewMsgClearParamOverrides returns the message to clear the param overrides of a community
+func NewMsgClearParamOverrides(communityID string, admin sdk.AccAddress) MsgClearParamOverrides {
+	return MsgClearParamOverrides{
+		CommunityID: communityID,
+		Admin:       admin,
+	}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgClearParamOverrides) ValidateBasic() sdk.Error {
+	if len(msg.CommunityID) == 0 {
+		return ErrInvalidCommunityMsg("ID is required")
+	}
+
+	if len(msg.Admin) == 0 {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Invalid address: %s", msg.Admin.String()))
+	}
+
+	return nil
+}
+
+// Route implements Msg
+func (msg MsgClearParamOverrides) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgClearParamOverrides) Type() string { return TypeMsgClearParamOverrides }
+
+// GetSignBytes implements Msg
+func (msg MsgClearParamOverrides) GetSignBytes() []byte {
+	msgBytes := ModuleCodec.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(msgBytes)
+}
+
+// GetSigners implements Msg. Returns the admin as the signer.
+func (msg MsgClearParamOverrides) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Admin)}
+}
+
+// MsgTreasurySpend defines the message to send coins from a community treasury
+type MsgTreasurySpend struct {
+	CommunityID string         `json:"community_id"`
+	Recipient   sdk.AccAddress `json:"recipient"`
+	Amount      sdk.Coin       `json:"amount"`
+	Admin       sdk.AccAddress `json:"admin"`
+}
+
+// NewMsgTreasurySpend returns the message to send coins from a community treasury
+func NewMsgTreasurySpend(communityID string, recipient sdk.AccAddress, amount sdk.Coin, admin sdk.AccAddress) MsgTreasurySpend {
+	return MsgTreasurySpend{
+		CommunityID: communityID,
+		Recipient:   recipient,
+		Amount:      amount,
+		Admin:       admin,
+	}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgTreasurySpend) ValidateBasic() sdk.Error {
+	if len(msg.CommunityID) == 0 {
+		return ErrInvalidCommunityMsg("ID is required")
+	}
+
+	if len(msg.Recipient) == 0 {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Invalid recipient address: %s", msg.Recipient.String()))
+	}
+
+	if msg.Amount.Denom != app.StakeDenom || !msg.Amount.IsPositive() {
+		return sdk.ErrInvalidCoins(fmt.Sprintf("Invalid treasury spend %s", msg.Amount))
+	}
+
+	if len(msg.Admin) == 0 {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Invalid address: %s", msg.Admin.String()))
+	}
+
+	return nil
+}
+
+// Route implements Msg
+func (msg MsgTreasurySpend) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgTreasurySpend) Type() string { return TypeMsgTreasurySpend }
+
+// GetSignBytes implements Msg
+func (msg MsgTreasurySpend) GetSignBytes() []byte {
+	msgBytes := ModuleCodec.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(msgBytes)
+}
+
+// GetSigners implements Msg. Returns the admin as the signer.
+func (msg MsgTreasurySpend) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Admin)}
+}
+
+// MsgAddModerator defines the message to appoint a moderator of a community
+type MsgAddModerator struct {
+	CommunityID string         `json:"community_id"`
+	Moderator   sdk.AccAddress `json:"moderator"`
+	Creator     sdk.AccAddress `json:"creator"`
+}
+
+// NewMsgAddModerator returns the message to appoint a moderator of a community
+func NewMsgAddModerator(communityID string, moderator, creator sdk.AccAddress) MsgAddModerator {
+	return MsgAddModerator{
+		CommunityID: communityID,
+		Moderator:   moderator,
+		Creator:     creator,
+	}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgAddModerator) ValidateBasic() sdk.Error {
+	if len(msg.CommunityID) == 0 {
+		return ErrInvalidCommunityMsg("ID is required")
+	}
+
+	if len(msg.Moderator) == 0 {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Invalid address: %s", msg.Moderator.String()))
+	}
+
+	if len(msg.Creator) == 0 {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Invalid address: %s", msg.Creator.String()))
+	}
+
+	return nil
+}
+
+// Route implements Msg
+func (msg MsgAddModerator) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgAddModerator) Type() string { return TypeMsgAddModerator }
+
+// GetSignBytes implements Msg
+func (msg MsgAddModerator) GetSignBytes() []byte {
+	msgBytes := ModuleCodec.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(msgBytes)
+}
+
+// GetSigners implements Msg. Returns the creator as the signer.
+func (msg MsgAddModerator) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Creator)}
+}
+
+// MsgRemoveModerator defines the message to remove a moderator of a community
+type MsgRemoveModerator struct {
+	CommunityID string         `json:"community_id"`
+	Moderator   sdk.AccAddress `json:"moderator"`
+	Remover     sdk.AccAddress `json:"remover"`
+}
+
+// NewMsgRemoveModerator returns the message to remove a moderator of a community
+func NewMsgRemoveModerator(communityID string, moderator, remover sdk.AccAddress) MsgRemoveModerator {
+	return MsgRemoveModerator{
+		CommunityID: communityID,
+		Moderator:   moderator,
+		Remover:     remover,
+	}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgRemoveModerator) ValidateBasic() sdk.Error {
+	if len(msg.CommunityID) == 0 {
+		return ErrInvalidCommunityMsg("ID is required")
+	}
+
+	if len(msg.Moderator) == 0 {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Invalid address: %s", msg.Moderator.String()))
+	}
+
+	if len(msg.Remover) == 0 {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Invalid address: %s", msg.Remover.String()))
+	}
+
+	return nil
+}
+
+// Route implements Msg
+func (msg MsgRemoveModerator) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgRemoveModerator) Type() string { return TypeMsgRemoveModerator }
+
+// GetSignBytes implements Msg
+func (msg MsgRemoveModerator) GetSignBytes() []byte {
+	msgBytes := ModuleCodec.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(msgBytes)
+}
+
+// GetSigners implements Msg. Returns the remover as the signer.
+func (msg MsgRemoveModerator) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Remover)}
+}
+
+// MsgFollowCommunity defines the message to follow a community
+type MsgFollowCommunity struct {
+	CommunityID string         `json:"community_id"`
+	Follower    sdk.AccAddress `json:"follower"`
+}
+
+// NewMsgFollowCommunity returns the message to follow a community
+func NewMsgFollowCommunity(communityID string, follower sdk.AccAddress) MsgFollowCommunity {
+	return MsgFollowCommunity{
+		CommunityID: communityID,
+		Follower:    follower,
+	}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgFollowCommunity) ValidateBasic() sdk.Error {
+	if len(msg.CommunityID) == 0 {
+		return ErrInvalidCommunityMsg("ID is required")
+	}
+
+	if len(msg.Follower) == 0 {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Invalid address: %s", msg.Follower.String()))
+	}
+
+	return nil
+}
+
+// Route implements Msg
+func (msg MsgFollowCommunity) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgFollowCommunity) Type() string { return TypeMsgFollowCommunity }
+
+// GetSignBytes implements Msg
+func (msg MsgFollowCommunity) GetSignBytes() []byte {
+	msgBytes := ModuleCodec.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(msgBytes)
+}
+
+// GetSigners implements Msg. Returns the follower as the signer.
+func (msg MsgFollowCommunity) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Follower)}
+}
+
+// MsgUnfollowCommunity defines the message to unfollow a community
+type MsgUnfollowCommunity struct {
+	CommunityID string         `json:"community_id"`
+	Follower    sdk.AccAddress `json:"follower"`
+}
+
+// NewMsgUnfollowCommunity returns the message to unfollow a community
+func NewMsgUnfollowCommunity(communityID string, follower sdk.AccAddress) MsgUnfollowCommunity {
+	return MsgUnfollowCommunity{
+		CommunityID: communityID,
+		Follower:    follower,
+	}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgUnfollowCommunity) ValidateBasic() sdk.Error {
+	if len(msg.CommunityID) == 0 {
+		return ErrInvalidCommunityMsg("ID is required")
+	}
+
+	if len(msg.Follower) == 0 {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Invalid address: %s", msg.Follower.String()))
+	}
+
+	return nil
+}
+
+// Route implements Msg
+func (msg MsgUnfollowCommunity) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgUnfollowCommunity) Type() string { return TypeMsgUnfollowCommunity }
+
+// GetSignBytes implements Msg
+func (msg MsgUnfollowCommunity) GetSignBytes() []byte {
+	msgBytes := ModuleCodec.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(msgBytes)
+}
+
+// GetSigners implements Msg. Returns the follower as the signer.
+func (msg MsgUnfollowCommunity) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Follower)}
+}
+
 // MsgAddAdmin defines the message to add a new admin
 type MsgAddAdmin struct {
 	Admin   sdk.AccAddress `json:"admin"`
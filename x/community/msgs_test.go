@@ -3,6 +3,7 @@ package community
 import (
 	"testing"
 
+	app "github.com/TruStory/truchain/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
 )
@@ -103,3 +104,82 @@ func TestMsgRemoveAdmin_InvalidAdmin(t *testing.T) {
 	assert.NotNil(t, err)
 	assert.Equal(t, sdk.ErrInvalidAddress("").Code(), err.Code())
 }
+
+func TestMsgUpdateCommunity_InvalidID(t *testing.T) {
+	updater := sdk.AccAddress([]byte{1, 2})
+
+	msg := NewMsgUpdateCommunity("", "Randomness", "", "", "", updater)
+	err := msg.ValidateBasic()
+	assert.NotNil(t, err)
+	assert.Equal(t, ErrorCodeInvalidCommunityMsg, err.Code())
+	assert.Equal(t, TypeMsgUpdateCommunity, msg.Type())
+}
+
+func TestMsgArchiveCommunity_Success(t *testing.T) {
+	admin := sdk.AccAddress([]byte{1, 2})
+
+	msg := NewMsgArchiveCommunity("randomness", admin)
+	assert.Nil(t, msg.ValidateBasic())
+	assert.Equal(t, ModuleName, msg.Route())
+	assert.Equal(t, TypeMsgArchiveCommunity, msg.Type())
+}
+
+func TestMsgFollowCommunity(t *testing.T) {
+	follower := sdk.AccAddress([]byte{1, 2})
+
+	msg := NewMsgFollowCommunity("crypto", follower)
+	assert.Nil(t, msg.ValidateBasic())
+	assert.Equal(t, ModuleName, msg.Route())
+	assert.Equal(t, TypeMsgFollowCommunity, msg.Type())
+
+	msg = NewMsgFollowCommunity("", follower)
+	assert.Equal(t, ErrorCodeInvalidCommunityMsg, msg.ValidateBasic().Code())
+
+	unfollow := NewMsgUnfollowCommunity("crypto", nil)
+	assert.Equal(t, sdk.ErrInvalidAddress("").Code(), unfollow.ValidateBasic().Code())
+	assert.Equal(t, TypeMsgUnfollowCommunity, unfollow.Type())
+}
+
+func TestMsgSetCommunityParent(t *testing.T) {
+	admin := sdk.AccAddress([]byte{1, 2})
+
+	msg := NewMsgSetCommunityParent("football", "sports", admin)
+	assert.Nil(t, msg.ValidateBasic())
+	assert.Equal(t, ModuleName, msg.Route())
+	assert.Equal(t, TypeMsgSetCommunityParent, msg.Type())
+
+	msg = NewMsgSetCommunityParent("sports", "sports", admin)
+	assert.Equal(t, ErrorCodeInvalidParent, msg.ValidateBasic().Code())
+}
+
+func TestMsgParamOverrides(t *testing.T) {
+	admin := sdk.AccAddress([]byte{1, 2})
+	maxArguments, zero := 5, 0
+
+	msg := NewMsgSetParamOverrides(ParamOverrides{CommunityID: "crypto", MaxArgumentsPerClaim: &maxArguments}, admin)
+	assert.Nil(t, msg.ValidateBasic())
+	assert.Equal(t, ModuleName, msg.Route())
+	assert.Equal(t, TypeMsgSetParamOverrides, msg.Type())
+
+	msg = NewMsgSetParamOverrides(ParamOverrides{CommunityID: "crypto", MaxArgumentsPerClaim: &zero}, admin)
+	assert.Equal(t, ErrorCodeInvalidCommunityMsg, msg.ValidateBasic().Code())
+
+	clearMsg := NewMsgClearParamOverrides("", admin)
+	assert.Equal(t, ErrorCodeInvalidCommunityMsg, clearMsg.ValidateBasic().Code())
+	assert.Equal(t, TypeMsgClearParamOverrides, clearMsg.Type())
+}
+
+func TestMsgTreasurySpend(t *testing.T) {
+	admin := sdk.AccAddress([]byte{1, 2})
+	recipient := sdk.AccAddress([]byte{3, 4})
+
+	msg := NewMsgTreasurySpend("crypto", recipient, sdk.NewInt64Coin(app.StakeDenom, 10), admin)
+	assert.Nil(t, msg.ValidateBasic())
+	assert.Equal(t, ModuleName, msg.Route())
+	assert.Equal(t, TypeMsgTreasurySpend, msg.Type())
+
+	msg = NewMsgTreasurySpend("crypto", recipient, sdk.NewInt64Coin("btc", 10), admin)
+	assert.Equal(t, sdk.ErrInvalidCoins("").Code(), msg.ValidateBasic().Code())
+	msg = NewMsgTreasurySpend("crypto", nil, sdk.NewInt64Coin(app.StakeDenom, 10), admin)
+	assert.Equal(t, sdk.ErrInvalidAddress("").Code(), msg.ValidateBasic().Code())
+}
@@ -0,0 +1,234 @@
+package community
+
+import (
+	"fmt"
+
+	app "github.com/TruStory/truchain/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ParamOverrides replace a subset of the global claim and staking params inside a community.
+// Unset fields fall back to the overrides of the parent communities, then to the global params.
+type ParamOverrides struct {
+	CommunityID string `json:"community_id"`
+
+	// claim params
+	MinClaimLength *int `json:"min_claim_length,omitempty"`
+	MaxClaimLength *int `json:"max_claim_length,omitempty"`
+
+	// staking params
+	ArgumentCreationStake    *sdk.Coin `json:"argument_creation_stake,omitempty"`
+	UpvoteStake              *sdk.Coin `json:"upvote_stake,omitempty"`
+	ArgumentBodyMinLength    *int      `json:"argument_body_min_length,omitempty"`
+	ArgumentBodyMaxLength    *int      `json:"argument_body_max_length,omitempty"`
+	ArgumentSummaryMinLength *int      `json:"argument_summary_min_length,omitempty"`
+	ArgumentSummaryMaxLength *int      `json:"argument_summary_max_length,omitempty"`
+	MaxArgumentsPerClaim     *int      `json:"max_arguments_per_claim,omitempty"`
+	// InterestRate can't be set above the global staking interest rate
+	InterestRate *sdk.Dec `json:"interest_rate,omitempty"`
+}
+
+// Merge returns the overrides with the fields set in child replacing the fields of o
+func (o ParamOverrides) Merge(child ParamOverrides) ParamOverrides {
+	merged := o
+	merged.CommunityID = child.CommunityID
+	if child.MinClaimLength != nil {
+		merged.MinClaimLength = child.MinClaimLength
+	}
+	if child.MaxClaimLength != nil {
+		merged.MaxClaimLength = child.MaxClaimLength
+	}
+	if child.ArgumentCreationStake != nil {
+		merged.ArgumentCreationStake = child.ArgumentCreationStake
+	}
+	if child.UpvoteStake != nil {
+		merged.UpvoteStake = child.UpvoteStake
+	}
+	if child.ArgumentBodyMinLength != nil {
+		merged.ArgumentBodyMinLength = child.ArgumentBodyMinLength
+	}
+	if child.ArgumentBodyMaxLength != nil {
+		merged.ArgumentBodyMaxLength = child.ArgumentBodyMaxLength
+	}
+	if child.ArgumentSummaryMinLength != nil {
+		merged.ArgumentSummaryMinLength = child.ArgumentSummaryMinLength
+	}
+	if child.ArgumentSummaryMaxLength != nil {
+		merged.ArgumentSummaryMaxLength = child.ArgumentSummaryMaxLength
+	}
+	if child.MaxArgumentsPerClaim != nil {
+		merged.MaxArgumentsPerClaim = child.MaxArgumentsPerClaim
+	}
+	if child.InterestRate != nil {
+		merged.InterestRate = child.InterestRate
+	}
+
+	return merged
+}
+
+// Validate checks that every set field has a usable value
+func (o ParamOverrides) Validate() error {
+	lengths := []struct {
+		name  string
+		value *int
+	}{
+		{"min_claim_length", o.MinClaimLength},
+		{"max_claim_length", o.MaxClaimLength},
+		{"argument_body_min_length", o.ArgumentBodyMinLength},
+		{"argument_body_max_length", o.ArgumentBodyMaxLength},
+		{"argument_summary_min_length", o.ArgumentSummaryMinLength},
+		{"argument_summary_max_length", o.ArgumentSummaryMaxLength},
+		{"max_arguments_per_claim", o.MaxArgumentsPerClaim},
+	}
+	for _, length := range lengths {
+		if length.value != nil && *length.value < 1 {
+			return fmt.Errorf("%s must have a positive value", length.name)
+		}
+	}
+	if o.MinClaimLength != nil && o.MaxClaimLength != nil && *o.MinClaimLength > *o.MaxClaimLength {
+		return fmt.Errorf("min_claim_length must not be larger than max_claim_length")
+	}
+	if o.ArgumentBodyMinLength != nil && o.ArgumentBodyMaxLength != nil && *o.ArgumentBodyMinLength > *o.ArgumentBodyMaxLength {
+		return fmt.Errorf("argument_body_min_length must not be larger than argument_body_max_length")
+	}
+	if o.ArgumentSummaryMinLength != nil && o.ArgumentSummaryMaxLength != nil &&
+		*o.ArgumentSummaryMinLength > *o.ArgumentSummaryMaxLength {
+		return fmt.Errorf("argument_summary_min_length must not be larger than argument_summary_max_length")
+	}
+	stakes := []struct {
+		name  string
+		value *sdk.Coin
+	}{
+		{"argument_creation_stake", o.ArgumentCreationStake},
+		{"upvote_stake", o.UpvoteStake},
+	}
+	for _, stake := range stakes {
+		if stake.value != nil && (stake.value.Denom != app.StakeDenom || !stake.value.IsPositive()) {
+			return fmt.Errorf("%s must be a positive amount of %s", stake.name, app.StakeDenom)
+		}
+	}
+	if o.InterestRate != nil && o.InterestRate.IsNegative() {
+		return fmt.Errorf("interest_rate must not be negative")
+	}
+
+	return nil
+}
+
+// SetParamOverrides replaces the param overrides of a community
+func (k Keeper) SetParamOverrides(ctx sdk.Context, overrides ParamOverrides, admin sdk.AccAddress) sdk.Error {
+	if !k.isAdmin(ctx, admin) {
+		return ErrAddressNotAuthorised()
+	}
+	_, err := k.Community(ctx, overrides.CommunityID)
+	if err != nil {
+		return err
+	}
+	if validationErr := overrides.Validate(); validationErr != nil {
+		return ErrInvalidCommunityMsg(validationErr.Error())
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	k.setParamOverrides(cacheCtx, overrides)
+	err = k.validateEffectiveOverrides(cacheCtx, overrides.CommunityID)
+	if err != nil {
+		return err
+	}
+	write()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeParamOverridesSet,
+			sdk.NewAttribute(AttributeKeyCommunityID, overrides.CommunityID),
+		),
+	)
+
+	return nil
+}
+
+// ClearParamOverrides removes the param overrides of a community
+func (k Keeper) ClearParamOverrides(ctx sdk.Context, communityID string, admin sdk.AccAddress) sdk.Error {
+	if !k.isAdmin(ctx, admin) {
+		return ErrAddressNotAuthorised()
+	}
+	_, ok := k.ParamOverrides(ctx, communityID)
+	if !ok {
+		return ErrParamOverridesNotFound(communityID)
+	}
+
+	k.store(ctx).Delete(paramOverridesKey(communityID))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeParamOverridesCleared,
+			sdk.NewAttribute(AttributeKeyCommunityID, communityID),
+		),
+	)
+
+	return nil
+}
+
+// ParamOverrides returns the param overrides set on a community itself
+func (k Keeper) ParamOverrides(ctx sdk.Context, communityID string) (overrides ParamOverrides, ok bool) {
+	bz := k.store(ctx).Get(paramOverridesKey(communityID))
+	if bz == nil {
+		return ParamOverrides{CommunityID: communityID}, false
+	}
+	k.codec.MustUnmarshalBinaryLengthPrefixed(bz, &overrides)
+
+	return overrides, true
+}
+
+// EffectiveOverrides returns the param overrides of a community merged with the
+// overrides inherited from its ancestors, the closest community winning
+func (k Keeper) EffectiveOverrides(ctx sdk.Context, communityID string) ParamOverrides {
+	effective := ParamOverrides{}
+	ancestors := k.Ancestors(ctx, communityID)
+	for i := len(ancestors) - 1; i >= 0; i-- {
+		overrides, _ := k.ParamOverrides(ctx, ancestors[i].ID)
+		effective = effective.Merge(overrides)
+	}
+	overrides, _ := k.ParamOverrides(ctx, communityID)
+
+	return effective.Merge(overrides)
+}
+
+// validateEffectiveOverrides checks the params in effect inside a community and its descendants,
+// the global params with the overrides of the ancestors and the community itself applied
+func (k Keeper) validateEffectiveOverrides(ctx sdk.Context, communityID string) sdk.Error {
+	global := ParamOverrides{}
+	for _, paramsKeeper := range k.paramsKeepers {
+		global = global.Merge(paramsKeeper.GlobalParamOverrides(ctx))
+	}
+	communityIDs := append([]string{communityID}, k.Descendants(ctx, communityID)...)
+	for _, id := range communityIDs {
+		effective := global.Merge(k.EffectiveOverrides(ctx, id))
+		if validationErr := effective.Validate(); validationErr != nil {
+			return ErrInvalidCommunityMsg(fmt.Sprintf("Effective params of %s: %s", id, validationErr))
+		}
+		// communities can lower the interest rate, but not raise it
+		if global.InterestRate != nil && effective.InterestRate.GT(*global.InterestRate) {
+			return ErrInvalidCommunityMsg(fmt.Sprintf("Effective params of %s: interest_rate must not be larger than %s",
+				id, global.InterestRate))
+		}
+	}
+
+	return nil
+}
+
+// allParamOverrides returns the overrides of every community, used for genesis export
+func (k Keeper) allParamOverrides(ctx sdk.Context) []ParamOverrides {
+	allOverrides := make([]ParamOverrides, 0)
+	iterator := sdk.KVStorePrefixIterator(k.store(ctx), ParamOverridesKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var overrides ParamOverrides
+		k.codec.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &overrides)
+		allOverrides = append(allOverrides, overrides)
+	}
+
+	return allOverrides
+}
+
+func (k Keeper) setParamOverrides(ctx sdk.Context, overrides ParamOverrides) {
+	k.store(ctx).Set(paramOverridesKey(overrides.CommunityID), k.codec.MustMarshalBinaryLengthPrefixed(overrides))
+}
@@ -16,6 +16,10 @@ var (
 	KeyMaxNameLength        = []byte("maxNameLength")
 	KeyMaxDescriptionLength = []byte("maxDescriptionLength")
 	KeyCommunityAdmins      = []byte("communityAdmins")
+	KeyMaxIconURILength     = []byte("maxIconURILength")
+	KeyMaxRulesLength       = []byte("maxRulesLength")
+	KeyMaxCommunityDepth    = []byte("maxCommunityDepth")
+	KeyTreasuryShare        = []byte("treasuryShare")
 )
 
 // Params holds parameters for a Community
@@ -26,6 +30,11 @@ type Params struct {
 	MaxNameLength        int              `json:"max_name_length"`
 	MaxDescriptionLength int              `json:"max_description_length"`
 	CommunityAdmins      []sdk.AccAddress `json:"community_admins"`
+	MaxIconURILength     int              `json:"max_icon_uri_length"`
+	MaxRulesLength       int              `json:"max_rules_length"`
+	MaxCommunityDepth    int              `json:"max_community_depth"`
+	// TreasuryShare is the share of the slashed coins of a community going to its treasury
+	TreasuryShare sdk.Dec `json:"treasury_share"`
 }
 
 // DefaultParams is the Community params for testing
@@ -37,6 +46,10 @@ func DefaultParams() Params {
 		MaxIDLength:          15,
 		MaxDescriptionLength: 140,
 		CommunityAdmins:      []sdk.AccAddress{},
+		MaxIconURILength:     2048,
+		MaxRulesLength:       2000,
+		MaxCommunityDepth:    3,
+		TreasuryShare:        sdk.NewDecWithPrec(10, 2),
 	}
 }
 
@@ -49,6 +62,10 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		{Key: KeyMaxIDLength, Value: &p.MaxIDLength},
 		{Key: KeyMaxDescriptionLength, Value: &p.MaxDescriptionLength},
 		{Key: KeyCommunityAdmins, Value: &p.CommunityAdmins},
+		{Key: KeyMaxIconURILength, Value: &p.MaxIconURILength},
+		{Key: KeyMaxRulesLength, Value: &p.MaxRulesLength},
+		{Key: KeyMaxCommunityDepth, Value: &p.MaxCommunityDepth},
+		{Key: KeyTreasuryShare, Value: &p.TreasuryShare},
 	}
 }
 
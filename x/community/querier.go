@@ -10,9 +10,17 @@ import (
 
 // query endpoints supported by the truchain Querier
 const (
-	QueryCommunity   = "community"
-	QueryCommunities = "communities"
-	QueryParams      = "params"
+	QueryCommunity           = "community"
+	QueryCommunities         = "communities"
+	QueryCommunityModerators = "community_moderators"
+	QueryCommunityFollowers  = "community_followers"
+	QueryFollowedCommunities = "followed_communities"
+	QueryCommunityChildren   = "community_children"
+	QueryCommunityAncestors  = "community_ancestors"
+	QueryCommunityStats      = "community_stats"
+	QueryParamOverrides      = "param_overrides"
+	QueryCommunityTreasury   = "community_treasury"
+	QueryParams              = "params"
 )
 
 // QueryCommunityParams are params for querying communities by id queries
@@ -20,6 +28,11 @@ type QueryCommunityParams struct {
 	ID string
 }
 
+// QueryFollowedCommunitiesParams are params for querying the communities a user follows
+type QueryFollowedCommunitiesParams struct {
+	Address sdk.AccAddress
+}
+
 // NewQuerier returns a function that handles queries on the KVStore
 func NewQuerier(k Keeper) sdk.Querier {
 	return func(ctx sdk.Context, path []string, request abci.RequestQuery) (result []byte, err sdk.Error) {
@@ -28,6 +41,22 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return queryCommunity(ctx, request, k)
 		case QueryCommunities:
 			return queryCommunities(ctx, k)
+		case QueryCommunityModerators:
+			return queryCommunityModerators(ctx, request, k)
+		case QueryCommunityFollowers:
+			return queryCommunityFollowers(ctx, request, k)
+		case QueryFollowedCommunities:
+			return queryFollowedCommunities(ctx, request, k)
+		case QueryCommunityChildren:
+			return queryCommunityChildren(ctx, request, k)
+		case QueryCommunityAncestors:
+			return queryCommunityAncestors(ctx, request, k)
+		case QueryCommunityStats:
+			return queryCommunityStats(ctx, request, k)
+		case QueryParamOverrides:
+			return queryParamOverrides(ctx, request, k)
+		case QueryCommunityTreasury:
+			return queryCommunityTreasury(ctx, request, k)
 		case QueryParams:
 			return queryParams(ctx, k)
 		default:
@@ -56,6 +85,131 @@ func queryCommunities(ctx sdk.Context, k Keeper) (result []byte, err sdk.Error)
 	return mustMarshal(communities)
 }
 
+func queryCommunityModerators(ctx sdk.Context, req abci.RequestQuery, k Keeper) (result []byte, err sdk.Error) {
+	var params QueryCommunityParams
+	codecErr := ModuleCodec.UnmarshalJSON(req.Data, &params)
+	if codecErr != nil {
+		return nil, ErrJSONParse(codecErr)
+	}
+
+	_, err = k.Community(ctx, params.ID)
+	if err != nil {
+		return
+	}
+
+	return mustMarshal(k.Moderators(ctx, params.ID))
+}
+
+func queryCommunityFollowers(ctx sdk.Context, req abci.RequestQuery, k Keeper) (result []byte, err sdk.Error) {
+	var params QueryCommunityParams
+	codecErr := ModuleCodec.UnmarshalJSON(req.Data, &params)
+	if codecErr != nil {
+		return nil, ErrJSONParse(codecErr)
+	}
+
+	_, err = k.Community(ctx, params.ID)
+	if err != nil {
+		return
+	}
+
+	return mustMarshal(k.Followers(ctx, params.ID))
+}
+
+func queryFollowedCommunities(ctx sdk.Context, req abci.RequestQuery, k Keeper) (result []byte, err sdk.Error) {
+	var params QueryFollowedCommunitiesParams
+	codecErr := ModuleCodec.UnmarshalJSON(req.Data, &params)
+	if codecErr != nil {
+		return nil, ErrJSONParse(codecErr)
+	}
+
+	communities := make([]Community, 0)
+	for _, id := range k.FollowedCommunities(ctx, params.Address) {
+		community, err := k.Community(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		communities = append(communities, community)
+	}
+
+	return mustMarshal(communities)
+}
+
+func queryCommunityChildren(ctx sdk.Context, req abci.RequestQuery, k Keeper) (result []byte, err sdk.Error) {
+	var params QueryCommunityParams
+	codecErr := ModuleCodec.UnmarshalJSON(req.Data, &params)
+	if codecErr != nil {
+		return nil, ErrJSONParse(codecErr)
+	}
+
+	_, err = k.Community(ctx, params.ID)
+	if err != nil {
+		return
+	}
+
+	return mustMarshal(k.Children(ctx, params.ID))
+}
+
+func queryCommunityAncestors(ctx sdk.Context, req abci.RequestQuery, k Keeper) (result []byte, err sdk.Error) {
+	var params QueryCommunityParams
+	codecErr := ModuleCodec.UnmarshalJSON(req.Data, &params)
+	if codecErr != nil {
+		return nil, ErrJSONParse(codecErr)
+	}
+
+	_, err = k.Community(ctx, params.ID)
+	if err != nil {
+		return
+	}
+
+	return mustMarshal(k.Ancestors(ctx, params.ID))
+}
+
+func queryCommunityStats(ctx sdk.Context, req abci.RequestQuery, k Keeper) (result []byte, err sdk.Error) {
+	var params QueryCommunityParams
+	codecErr := ModuleCodec.UnmarshalJSON(req.Data, &params)
+	if codecErr != nil {
+		return nil, ErrJSONParse(codecErr)
+	}
+
+	_, err = k.Community(ctx, params.ID)
+	if err != nil {
+		return
+	}
+
+	return mustMarshal(k.Stats(ctx, params.ID))
+}
+
+// queryParamOverrides returns the overrides of a community merged with the ones inherited from its ancestors
+func queryParamOverrides(ctx sdk.Context, req abci.RequestQuery, k Keeper) (result []byte, err sdk.Error) {
+	var params QueryCommunityParams
+	codecErr := ModuleCodec.UnmarshalJSON(req.Data, &params)
+	if codecErr != nil {
+		return nil, ErrJSONParse(codecErr)
+	}
+
+	_, err = k.Community(ctx, params.ID)
+	if err != nil {
+		return
+	}
+
+	return mustMarshal(k.EffectiveOverrides(ctx, params.ID))
+}
+
+func queryCommunityTreasury(ctx sdk.Context, req abci.RequestQuery, k Keeper) (result []byte, err sdk.Error) {
+	var params QueryCommunityParams
+	codecErr := ModuleCodec.UnmarshalJSON(req.Data, &params)
+	if codecErr != nil {
+		return nil, ErrJSONParse(codecErr)
+	}
+
+	_, err = k.Community(ctx, params.ID)
+	if err != nil {
+		return
+	}
+
+	return mustMarshal(k.Treasury(ctx, params.ID))
+}
+
 func queryParams(ctx sdk.Context, keeper Keeper) (result []byte, err sdk.Error) {
 	params := keeper.GetParams(ctx)
 
@@ -5,6 +5,7 @@ import (
 	"strings"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -99,3 +100,125 @@ func TestQueryParams_Success(t *testing.T) {
 	assert.Nil(t, sdkErr)
 	assert.Equal(t, returnedParams, onChainParams)
 }
+
+func TestQueryCommunityModerators(t *testing.T) {
+	ctx, keeper := mockDB()
+
+	admin := keeper.GetParams(ctx).CommunityAdmins[0]
+	moderator := getFakeAdmin()
+	err := keeper.AddModerator(ctx, "crypto", moderator, admin)
+	require.NoError(t, err)
+
+	params, jsonErr := ModuleCodec.MarshalJSON(QueryCommunityParams{ID: "crypto"})
+	require.NoError(t, jsonErr)
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, QueryCommunityModerators}, "/"),
+		Data: params,
+	}
+
+	querier := NewQuerier(keeper)
+	resBytes, err := querier(ctx, []string{QueryCommunityModerators}, query)
+	require.NoError(t, err)
+
+	var moderators []sdk.AccAddress
+	require.NoError(t, ModuleCodec.UnmarshalJSON(resBytes, &moderators))
+	assert.Equal(t, []sdk.AccAddress{moderator}, moderators)
+}
+
+func TestQueryFollowedCommunities(t *testing.T) {
+	ctx, keeper := mockDB()
+
+	follower := getFakeAdmin()
+	err := keeper.FollowCommunity(ctx, "meme", follower)
+	require.NoError(t, err)
+
+	params, jsonErr := ModuleCodec.MarshalJSON(QueryFollowedCommunitiesParams{Address: follower})
+	require.NoError(t, jsonErr)
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, QueryFollowedCommunities}, "/"),
+		Data: params,
+	}
+
+	querier := NewQuerier(keeper)
+	resBytes, err := querier(ctx, []string{QueryFollowedCommunities}, query)
+	require.NoError(t, err)
+
+	var communities []Community
+	require.NoError(t, ModuleCodec.UnmarshalJSON(resBytes, &communities))
+	require.Len(t, communities, 1)
+	assert.Equal(t, "meme", communities[0].ID)
+	assert.Equal(t, uint64(1), communities[0].FollowerCount)
+
+	params, jsonErr = ModuleCodec.MarshalJSON(QueryCommunityParams{ID: "meme"})
+	require.NoError(t, jsonErr)
+	query.Data = params
+	resBytes, err = querier(ctx, []string{QueryCommunityFollowers}, query)
+	require.NoError(t, err)
+
+	var followers []sdk.AccAddress
+	require.NoError(t, ModuleCodec.UnmarshalJSON(resBytes, &followers))
+	assert.Equal(t, []sdk.AccAddress{follower}, followers)
+}
+
+func TestQueryCommunityChildrenAndAncestors(t *testing.T) {
+	ctx, keeper := mockDB()
+
+	admin := keeper.GetParams(ctx).CommunityAdmins[0]
+	_, err := keeper.SetParent(ctx, "meme", "crypto", admin)
+	require.NoError(t, err)
+
+	querier := NewQuerier(keeper)
+	params, jsonErr := ModuleCodec.MarshalJSON(QueryCommunityParams{ID: "crypto"})
+	require.NoError(t, jsonErr)
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, QueryCommunityChildren}, "/"),
+		Data: params,
+	}
+	resBytes, err := querier(ctx, []string{QueryCommunityChildren}, query)
+	require.NoError(t, err)
+
+	var communities []Community
+	require.NoError(t, ModuleCodec.UnmarshalJSON(resBytes, &communities))
+	require.Len(t, communities, 1)
+	assert.Equal(t, "meme", communities[0].ID)
+
+	params, jsonErr = ModuleCodec.MarshalJSON(QueryCommunityParams{ID: "meme"})
+	require.NoError(t, jsonErr)
+	query = abci.RequestQuery{
+		Path: strings.Join([]string{custom, QueryCommunityAncestors}, "/"),
+		Data: params,
+	}
+	resBytes, err = querier(ctx, []string{QueryCommunityAncestors}, query)
+	require.NoError(t, err)
+
+	require.NoError(t, ModuleCodec.UnmarshalJSON(resBytes, &communities))
+	require.Len(t, communities, 1)
+	assert.Equal(t, "crypto", communities[0].ID)
+}
+
+func TestQueryCommunityStats(t *testing.T) {
+	ctx, keeper := mockDB()
+
+	stats := keeper.Stats(ctx, "crypto")
+	assert.Equal(t, NewStats("crypto"), stats)
+	stats.ClaimCount = 2
+	keeper.SetStats(ctx, stats)
+
+	params, jsonErr := ModuleCodec.MarshalJSON(QueryCommunityParams{ID: "crypto"})
+	require.NoError(t, jsonErr)
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, QueryCommunityStats}, "/"),
+		Data: params,
+	}
+
+	querier := NewQuerier(keeper)
+	resBytes, err := querier(ctx, []string{QueryCommunityStats}, query)
+	require.NoError(t, err)
+
+	var returned Stats
+	require.NoError(t, ModuleCodec.UnmarshalJSON(resBytes, &returned))
+	assert.Equal(t, stats, returned)
+
+	// stats are carried over through genesis
+	assert.Equal(t, []Stats{stats}, ExportGenesis(ctx, keeper).Stats)
+}
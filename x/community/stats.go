@@ -0,0 +1,63 @@
+package community
+
+import (
+	app "github.com/TruStory/truchain/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Stats aggregates the activity of a community so clients don't have to
+// pull every claim and stake. The claim, staking and slashing keepers update
+// it as activity happens, and each module registers an invariant recounting its part.
+type Stats struct {
+	CommunityID   string `json:"community_id"`
+	ClaimCount    uint64 `json:"claim_count"`
+	ArgumentCount uint64 `json:"argument_count"`
+	// ActiveStake is the amount staked on stakes that haven't expired yet
+	ActiveStake   sdk.Coin `json:"active_stake"`
+	LifetimeStake sdk.Coin `json:"lifetime_stake"`
+	UniqueStakers uint64   `json:"unique_stakers"`
+	SlashCount    uint64   `json:"slash_count"`
+	// TotalEarned is the amount of earned coins distributed in the community, before any slashing
+	TotalEarned sdk.Coin `json:"total_earned"`
+}
+
+// NewStats returns empty stats for a community
+func NewStats(communityID string) Stats {
+	return Stats{
+		CommunityID:   communityID,
+		ActiveStake:   sdk.NewInt64Coin(app.StakeDenom, 0),
+		LifetimeStake: sdk.NewInt64Coin(app.StakeDenom, 0),
+		TotalEarned:   sdk.NewInt64Coin(app.StakeDenom, 0),
+	}
+}
+
+// Stats returns the aggregated stats of a community
+func (k Keeper) Stats(ctx sdk.Context, communityID string) Stats {
+	bz := k.store(ctx).Get(statsKey(communityID))
+	if bz == nil {
+		return NewStats(communityID)
+	}
+	var stats Stats
+	k.codec.MustUnmarshalBinaryLengthPrefixed(bz, &stats)
+
+	return stats
+}
+
+// SetStats stores the aggregated stats of a community
+func (k Keeper) SetStats(ctx sdk.Context, stats Stats) {
+	k.store(ctx).Set(statsKey(stats.CommunityID), k.codec.MustMarshalBinaryLengthPrefixed(stats))
+}
+
+// allStats returns the stats of every community with activity, used for genesis export
+func (k Keeper) allStats(ctx sdk.Context) []Stats {
+	allStats := make([]Stats, 0)
+	iterator := sdk.KVStorePrefixIterator(k.store(ctx), StatsKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var stats Stats
+		k.codec.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &stats)
+		allStats = append(allStats, stats)
+	}
+
+	return allStats
+}
@@ -1,10 +1,12 @@
 package community
 
 import (
+	bankexported "github.com/TruStory/truchain/x/bank/exported"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/supply"
 	abci "github.com/tendermint/tendermint/abci/types"
 	cryptoAmino "github.com/tendermint/tendermint/crypto/encoding/amino"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
@@ -12,7 +14,79 @@ import (
 	dbm "github.com/tendermint/tm-db"
 )
 
+// interface conformance check
+var _ BankKeeper = &bankKeeper{}
+
+// bankKeeper keeps balances by address, module accounts included
+type bankKeeper struct {
+	Balances     map[string]sdk.Coins
+	Transactions []bankexported.Transaction
+}
+
+// AddCoin ...
+func (bk *bankKeeper) AddCoin(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coin,
+	referenceID uint64, txType bankexported.TransactionType, setters ...bankexported.TransactionSetter) (sdk.Coins, sdk.Error) {
+	tx := bk.record(addr, amt, referenceID, txType, setters...)
+	if tx.FromModuleAccount != "" {
+		bk.move(supply.NewModuleAddress(tx.FromModuleAccount), addr, amt)
+	} else {
+		bk.Balances[addr.String()] = bk.Balances[addr.String()].Add(sdk.Coins{amt})
+	}
+	return bk.Balances[addr.String()], nil
+}
+
+// SendCoinBetweenModules ...
+func (bk *bankKeeper) SendCoinBetweenModules(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coin,
+	referenceID uint64, txType bankexported.TransactionType, setters ...bankexported.TransactionSetter) sdk.Error {
+	tx := bk.record(addr, amt, referenceID, txType, setters...)
+	bk.move(supply.NewModuleAddress(tx.FromModuleAccount), supply.NewModuleAddress(tx.ToModuleAccount), amt)
+	return nil
+}
+
+// GetCoins ...
+func (bk *bankKeeper) GetCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
+	return bk.Balances[addr.String()]
+}
+
+func (bk *bankKeeper) move(from, to sdk.AccAddress, amt sdk.Coin) {
+	bk.Balances[from.String()] = bk.Balances[from.String()].Sub(sdk.Coins{amt})
+	bk.Balances[to.String()] = bk.Balances[to.String()].Add(sdk.Coins{amt})
+}
+
+func (bk *bankKeeper) record(addr sdk.AccAddress, amt sdk.Coin,
+	referenceID uint64, txType bankexported.TransactionType, setters ...bankexported.TransactionSetter) bankexported.Transaction {
+	tx := bankexported.Transaction{
+		Type:              txType,
+		AppAccountAddress: addr,
+		ReferenceID:       referenceID,
+		Amount:            amt,
+	}
+	for _, setter := range setters {
+		setter(&tx)
+	}
+	bk.Transactions = append(bk.Transactions, tx)
+	return tx
+}
+
+// interface conformance check
+var _ ParamsKeeper = paramsKeeper{}
+
+// paramsKeeper stands in for the modules whose params communities can override
+type paramsKeeper struct {
+	Global ParamOverrides
+}
+
+// GlobalParamOverrides ...
+func (pk paramsKeeper) GlobalParamOverrides(ctx sdk.Context) ParamOverrides {
+	return pk.Global
+}
+
 func mockDB() (sdk.Context, Keeper) {
+	ctx, keeper, _ := mockDBWithBank()
+	return ctx, keeper
+}
+
+func mockDBWithBank() (sdk.Context, Keeper, *bankKeeper) {
 	db := dbm.NewMemDB()
 
 	communityKey := sdk.NewKVStoreKey(ModuleName)
@@ -32,7 +106,8 @@ func mockDB() (sdk.Context, Keeper) {
 	RegisterCodec(codec)
 
 	paramsKeeper := params.NewKeeper(codec, paramsKey, transientParamsKey, params.DefaultCodespace)
-	communityKeeper := NewKeeper(communityKey, paramsKeeper.Subspace(ModuleName), codec)
+	bankKeeper := &bankKeeper{Balances: make(map[string]sdk.Coins)}
+	communityKeeper := NewKeeper(communityKey, paramsKeeper.Subspace(ModuleName), codec, bankKeeper)
 
 	admin1 := getFakeAdmin()
 	admin2 := getFakeAdmin()
@@ -40,7 +115,7 @@ func mockDB() (sdk.Context, Keeper) {
 	genesis.Params.CommunityAdmins = append(genesis.Params.CommunityAdmins, admin1, admin2)
 	InitGenesis(ctx, communityKeeper, genesis)
 
-	return ctx, communityKeeper
+	return ctx, communityKeeper, bankKeeper
 }
 
 func getFakeAdmin() (address sdk.AccAddress) {
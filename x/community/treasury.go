@@ -0,0 +1,144 @@
+package community
+
+import (
+	"fmt"
+
+	app "github.com/TruStory/truchain/types"
+	bankexported "github.com/TruStory/truchain/x/bank/exported"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Treasury is the share of the treasury module account belonging to a community.
+// Every community treasury is held by the single TreasuryPoolName module account,
+// the balances are kept here so that they can't be spent across communities.
+type Treasury struct {
+	CommunityID string   `json:"community_id"`
+	Balance     sdk.Coin `json:"balance"`
+}
+
+// NewTreasury returns an empty treasury for a community
+func NewTreasury(communityID string) Treasury {
+	return Treasury{
+		CommunityID: communityID,
+		Balance:     sdk.NewInt64Coin(app.StakeDenom, 0),
+	}
+}
+
+// Treasury returns the treasury of a community
+func (k Keeper) Treasury(ctx sdk.Context, communityID string) Treasury {
+	bz := k.store(ctx).Get(treasuryKey(communityID))
+	if bz == nil {
+		return NewTreasury(communityID)
+	}
+	var treasury Treasury
+	k.codec.MustUnmarshalBinaryLengthPrefixed(bz, &treasury)
+
+	return treasury
+}
+
+// TreasuryShare returns the part of slashed coins that goes to a community treasury
+func (k Keeper) TreasuryShare(ctx sdk.Context, slashed sdk.Coin) sdk.Coin {
+	share := k.GetParams(ctx).TreasuryShare
+	if share.IsNil() {
+		return sdk.NewInt64Coin(slashed.Denom, 0)
+	}
+
+	return sdk.NewCoin(slashed.Denom, slashed.Amount.ToDec().Mul(share).TruncateInt())
+}
+
+// DepositToTreasury moves coins from a module account into the treasury of a community.
+// funder is the account whose coins are deposited, referenceID the ID of what caused the deposit.
+func (k Keeper) DepositToTreasury(ctx sdk.Context, communityID string, amount sdk.Coin,
+	funder sdk.AccAddress, referenceID uint64, fromModuleAccount string) sdk.Error {
+
+	_, err := k.Community(ctx, communityID)
+	if err != nil {
+		return err
+	}
+	if amount.Denom != app.StakeDenom || !amount.IsPositive() {
+		return sdk.ErrInvalidCoins(fmt.Sprintf("Invalid treasury deposit %s", amount))
+	}
+	err = k.bankKeeper.SendCoinBetweenModules(ctx, funder, amount, referenceID,
+		bankexported.TransactionTreasuryDeposit,
+		bankexported.WithCommunityID(communityID),
+		bankexported.FromModuleAccount(fromModuleAccount),
+		bankexported.ToModuleAccount(TreasuryPoolName),
+	)
+	if err != nil {
+		return err
+	}
+
+	treasury := k.Treasury(ctx, communityID)
+	treasury.Balance = treasury.Balance.Add(amount)
+	k.setTreasury(ctx, treasury)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeTreasuryDeposit,
+			sdk.NewAttribute(AttributeKeyCommunityID, communityID),
+			sdk.NewAttribute(AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	return nil
+}
+
+// SpendTreasury sends coins from the treasury of a community to a recipient, to fund bounties or gifts
+func (k Keeper) SpendTreasury(ctx sdk.Context, communityID string, recipient sdk.AccAddress,
+	amount sdk.Coin, admin sdk.AccAddress) (Treasury, sdk.Error) {
+
+	if !k.isAdmin(ctx, admin) {
+		return Treasury{}, ErrAddressNotAuthorised()
+	}
+	_, err := k.Community(ctx, communityID)
+	if err != nil {
+		return Treasury{}, err
+	}
+	if amount.Denom != app.StakeDenom || !amount.IsPositive() {
+		return Treasury{}, sdk.ErrInvalidCoins(fmt.Sprintf("Invalid treasury spend %s", amount))
+	}
+	treasury := k.Treasury(ctx, communityID)
+	if treasury.Balance.IsLT(amount) {
+		return treasury, ErrInsufficientTreasury(communityID, treasury.Balance)
+	}
+	_, err = k.bankKeeper.AddCoin(ctx, recipient, amount, 0,
+		bankexported.TransactionTreasurySpend,
+		bankexported.WithCommunityID(communityID),
+		bankexported.FromModuleAccount(TreasuryPoolName),
+	)
+	if err != nil {
+		return treasury, err
+	}
+
+	treasury.Balance = treasury.Balance.Sub(amount)
+	k.setTreasury(ctx, treasury)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeTreasurySpend,
+			sdk.NewAttribute(AttributeKeyCommunityID, communityID),
+			sdk.NewAttribute(AttributeKeyRecipient, recipient.String()),
+			sdk.NewAttribute(AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	return treasury, nil
+}
+
+// allTreasuries returns the treasury of every community holding coins, used for genesis export
+func (k Keeper) allTreasuries(ctx sdk.Context) []Treasury {
+	treasuries := make([]Treasury, 0)
+	iterator := sdk.KVStorePrefixIterator(k.store(ctx), TreasuryKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var treasury Treasury
+		k.codec.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &treasury)
+		treasuries = append(treasuries, treasury)
+	}
+
+	return treasuries
+}
+
+func (k Keeper) setTreasury(ctx sdk.Context, treasury Treasury) {
+	k.store(ctx).Set(treasuryKey(treasury.CommunityID), k.codec.MustMarshalBinaryLengthPrefixed(treasury))
+}
@@ -3,6 +3,8 @@ package community
 import (
 	"fmt"
 	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // Defines module constants
@@ -10,6 +12,32 @@ const (
 	RouterKey    = ModuleName
 	QuerierRoute = ModuleName
 	StoreKey     = ModuleName
+
+	EventTypeCommunityUpdated  = "community-updated"
+	EventTypeCommunityArchived = "community-archived"
+	AttributeKeyCommunityID    = "community-id"
+
+	EventTypeModeratorAdded   = "community-moderator-added"
+	EventTypeModeratorRemoved = "community-moderator-removed"
+	AttributeKeyModerator     = "moderator"
+
+	EventTypeCommunityFollowed   = "community-followed"
+	EventTypeCommunityUnfollowed = "community-unfollowed"
+	AttributeKeyFollower         = "follower"
+
+	EventTypeCommunityParentUpdated = "community-parent-updated"
+	AttributeKeyParentID            = "parent-id"
+
+	EventTypeParamOverridesSet     = "community-param-overrides-set"
+	EventTypeParamOverridesCleared = "community-param-overrides-cleared"
+
+	EventTypeTreasuryDeposit = "community-treasury-deposit"
+	EventTypeTreasurySpend   = "community-treasury-spend"
+	AttributeKeyRecipient    = "recipient"
+	AttributeKeyAmount       = "amount"
+
+	// TreasuryPoolName is the module account holding the coins of every community treasury
+	TreasuryPoolName = "community_treasury_pool"
 )
 
 // Community represents the state of a community on TruStory
@@ -18,6 +46,28 @@ type Community struct {
 	Name        string    `json:"name"`
 	Description string    `json:"description,omitempty"`
 	CreatedTime time.Time `json:"created_time,omitempty"`
+	IconURI     string    `json:"icon_uri,omitempty"`
+	Rules       string    `json:"rules,omitempty"`
+	UpdatedTime time.Time `json:"updated_time,omitempty"`
+	// Archived communities keep their claims but don't accept new ones
+	Archived     bool      `json:"archived,omitempty"`
+	ArchivedTime time.Time `json:"archived_time,omitempty"`
+	// ParentID is the community this community is nested under, empty for top level communities
+	ParentID string `json:"parent_id,omitempty"`
+	// FollowerCount is the number of users following the community
+	FollowerCount uint64 `json:"follower_count,omitempty"`
+}
+
+// Moderator can moderate claims and arguments inside a single community
+type Moderator struct {
+	CommunityID string         `json:"community_id"`
+	Address     sdk.AccAddress `json:"address"`
+}
+
+// Follow is a user following a community
+type Follow struct {
+	CommunityID string         `json:"community_id"`
+	Follower    sdk.AccAddress `json:"follower"`
 }
 
 // Communities is a slice of communites
@@ -33,6 +83,11 @@ func NewCommunity(id, name, description string, createdTime time.Time) Community
 	}
 }
 
+// IsArchived returns true if the community no longer accepts new claims
+func (c Community) IsArchived() bool {
+	return c.Archived
+}
+
 func (c Community) String() string {
 	return fmt.Sprintf(`Community:
    ID: 			    %s
@@ -2,12 +2,18 @@ package distribution
 
 import "github.com/cosmos/cosmos-sdk/codec"
 
+// RegisterCodec registers all the necessary types and interfaces for the module
+func RegisterCodec(c *codec.Codec) {
+	c.RegisterConcrete(MsgAddAdmin{}, "trudistribution/MsgAddAdmin", nil)
+	c.RegisterConcrete(MsgRemoveAdmin{}, "trudistribution/MsgRemoveAdmin", nil)
+}
+
 // ModuleCodec encodes module codec
 var ModuleCodec *codec.Codec
 
 func init() {
 	ModuleCodec = codec.New()
-	//RegisterCodec(ModuleCodec)
+	RegisterCodec(ModuleCodec)
 	codec.RegisterCrypto(ModuleCodec)
 	ModuleCodec.Seal()
 }
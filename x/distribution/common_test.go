@@ -0,0 +1,75 @@
+package distribution
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	cosmosDist "github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func mockDB() (sdk.Context, Keeper) {
+	db := dbm.NewMemDB()
+	storeKey := sdk.NewKVStoreKey(ModuleName)
+	accKey := sdk.NewKVStoreKey(auth.StoreKey)
+	paramsKey := sdk.NewKVStoreKey(params.StoreKey)
+	transientParamsKey := sdk.NewTransientStoreKey(params.TStoreKey)
+	supplyKey := sdk.NewKVStoreKey(supply.StoreKey)
+
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(accKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(storeKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(paramsKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(transientParamsKey, sdk.StoreTypeTransient, db)
+	ms.MountStoreWithDB(supplyKey, sdk.StoreTypeIAVL, db)
+	ms.LoadLatestVersion()
+
+	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
+
+	// codec registration
+	cdc := codec.New()
+	auth.RegisterCodec(cdc)
+	RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+	supply.RegisterCodec(cdc)
+
+	// Keepers
+	pk := params.NewKeeper(cdc, paramsKey, transientParamsKey, params.DefaultCodespace)
+	accKeeper := auth.NewAccountKeeper(cdc, accKey, pk.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
+	bankKeeper := bank.NewBaseKeeper(accKeeper,
+		pk.Subspace(bank.DefaultParamspace),
+		bank.DefaultCodespace,
+		nil,
+	)
+
+	maccPerms := map[string][]string{
+		UserGrowthPoolName: {supply.Minter, supply.Burner},
+		UserRewardPoolName: {supply.Minter, supply.Burner},
+	}
+	supplyKeeper := supply.NewKeeper(cdc, supplyKey, accKeeper, bankKeeper, maccPerms)
+
+	// the inflation distribution isn't exercised, so the bank and cosmos distribution keepers are left empty
+	keeper := NewKeeper(
+		storeKey,
+		pk.Subspace(DefaultParamspace),
+		cdc,
+		nil,
+		accKeeper,
+		supplyKeeper,
+		cosmosDist.Keeper{},
+	)
+
+	InitGenesis(ctx, keeper, DefaultGenesisState())
+	return ctx, keeper
+}
+
+func fakeAddress() sdk.AccAddress {
+	return sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+}
@@ -0,0 +1,25 @@
+package distribution
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Distribution errors reserve 700 ~ 799.
+const (
+	DefaultCodespace sdk.CodespaceType = ModuleName
+
+	ErrorCodeAddressNotAuthorised sdk.CodeType = 701
+	ErrorCodeInvalidParams        sdk.CodeType = 702
+)
+
+// ErrAddressNotAuthorised throws an error when the address is not admin
+func ErrAddressNotAuthorised() sdk.Error {
+	return sdk.NewError(DefaultCodespace, ErrorCodeAddressNotAuthorised, "This address is not authorised to perform this action.")
+}
+
+// ErrInvalidParams throws an error when updated params have unusable values
+func ErrInvalidParams(message string) sdk.Error {
+	return sdk.NewError(DefaultCodespace, ErrorCodeInvalidParams, fmt.Sprintf("Invalid params: %s", message))
+}
@@ -1,8 +1,6 @@
 package distribution
 
 import (
-	"fmt"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -39,13 +37,5 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
 
 // ValidateGenesis validates the genesis state data
 func ValidateGenesis(data GenesisState) error {
-	if !data.Params.UserGrowthAllocation.IsPositive() {
-		return fmt.Errorf("Param: UserGrowthAllocation must be positive")
-	}
-
-	if !data.Params.UserRewardAllocation.IsPositive() {
-		return fmt.Errorf("Param: UserRewardAllocation must be positive")
-	}
-
-	return nil
+	return data.Params.Validate()
 }
@@ -0,0 +1,62 @@
+package distribution
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewHandler creates a new handler for distribution module
+func NewHandler(keeper Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		switch msg := msg.(type) {
+		case MsgAddAdmin:
+			return handleMsgAddAdmin(ctx, keeper, msg)
+		case MsgRemoveAdmin:
+			return handleMsgRemoveAdmin(ctx, keeper, msg)
+		default:
+			errMsg := fmt.Sprintf("Unrecognized distribution message type: %T", msg)
+			return sdk.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+
+func handleMsgAddAdmin(ctx sdk.Context, k Keeper, msg MsgAddAdmin) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	err := k.AddAdmin(ctx, msg.Admin, msg.Creator)
+	if err != nil {
+		return err.Result()
+	}
+
+	res, jsonErr := ModuleCodec.MarshalJSON(true)
+	if jsonErr != nil {
+		return sdk.ErrInternal(fmt.Sprintf("Marshal result error: %s", jsonErr)).Result()
+	}
+
+	return sdk.Result{
+		Data: res,
+	}
+}
+
+func handleMsgRemoveAdmin(ctx sdk.Context, k Keeper, msg MsgRemoveAdmin) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	err := k.RemoveAdmin(ctx, msg.Admin, msg.Remover)
+	if err != nil {
+		return err.Result()
+	}
+
+	res, jsonErr := ModuleCodec.MarshalJSON(true)
+	if jsonErr != nil {
+		return sdk.ErrInternal(fmt.Sprintf("Marshal result error: %s", jsonErr)).Result()
+	}
+
+	return sdk.Result{
+		Data: res,
+	}
+}
@@ -49,3 +49,52 @@ func NewKeeper(storeKey sdk.StoreKey, paramStore params.Subspace, codec *codec.C
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", ModuleName)
 }
+
+// AddAdmin adds a new admin. The first admins are set in the genesis params.
+func (k Keeper) AddAdmin(ctx sdk.Context, admin, creator sdk.AccAddress) sdk.Error {
+	if !k.isAdmin(ctx, creator) {
+		return ErrAddressNotAuthorised()
+	}
+	params := k.GetParams(ctx)
+
+	// if already present, don't add again
+	for _, currentAdmin := range params.DistributionAdmins {
+		if currentAdmin.Equals(admin) {
+			return nil
+		}
+	}
+
+	params.DistributionAdmins = append(params.DistributionAdmins, admin)
+
+	k.SetParams(ctx, params)
+
+	return nil
+}
+
+// RemoveAdmin removes an admin
+func (k Keeper) RemoveAdmin(ctx sdk.Context, admin, remover sdk.AccAddress) sdk.Error {
+	if !k.isAdmin(ctx, remover) {
+		return ErrAddressNotAuthorised()
+	}
+
+	params := k.GetParams(ctx)
+	for i, currentAdmin := range params.DistributionAdmins {
+		if currentAdmin.Equals(admin) {
+			params.DistributionAdmins = append(params.DistributionAdmins[:i], params.DistributionAdmins[i+1:]...)
+			break
+		}
+	}
+
+	k.SetParams(ctx, params)
+
+	return nil
+}
+
+func (k Keeper) isAdmin(ctx sdk.Context, address sdk.AccAddress) bool {
+	for _, admin := range k.GetParams(ctx).DistributionAdmins {
+		if address.Equals(admin) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,67 @@
+package distribution
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeeper_UpdateParams(t *testing.T) {
+	ctx, keeper := mockDB()
+
+	// the first admin can't be added without authorisation
+	admin := fakeAddress()
+	err := keeper.AddAdmin(ctx, admin, admin)
+	assert.Equal(t, ErrorCodeAddressNotAuthorised, err.Code())
+	assert.Len(t, keeper.GetParams(ctx).DistributionAdmins, 0)
+
+	params := keeper.GetParams(ctx)
+	params.DistributionAdmins = []sdk.AccAddress{admin}
+	keeper.SetParams(ctx, params)
+
+	updates := Params{UserGrowthAllocation: sdk.NewDecWithPrec(30, 2)}
+	invalidUpdater := fakeAddress()
+	err = keeper.UpdateParams(ctx, invalidUpdater, updates, []string{"user_growth_allocation"})
+	assert.Equal(t, ErrorCodeAddressNotAuthorised, err.Code())
+	assert.Equal(t, sdk.NewDecWithPrec(25, 2), keeper.GetParams(ctx).UserGrowthAllocation)
+
+	err = keeper.UpdateParams(ctx, admin, updates, []string{"user_growth_allocation"})
+	assert.NoError(t, err)
+	assert.Equal(t, sdk.NewDecWithPrec(30, 2), keeper.GetParams(ctx).UserGrowthAllocation)
+
+	// allocations can't add up to more than the whole inflation
+	updates = Params{UserRewardAllocation: sdk.NewDecWithPrec(50, 2)}
+	err = keeper.UpdateParams(ctx, admin, updates, []string{"user_reward_allocation"})
+	assert.Equal(t, ErrorCodeInvalidParams, err.Code())
+	assert.Equal(t, sdk.NewDecWithPrec(25, 2), keeper.GetParams(ctx).UserRewardAllocation)
+}
+
+func TestKeeper_AddRemoveAdmin(t *testing.T) {
+	ctx, keeper := mockDB()
+
+	admin := fakeAddress()
+	params := keeper.GetParams(ctx)
+	params.DistributionAdmins = []sdk.AccAddress{admin}
+	keeper.SetParams(ctx, params)
+
+	newAdmin := fakeAddress()
+	err := keeper.AddAdmin(ctx, newAdmin, fakeAddress())
+	assert.Equal(t, ErrorCodeAddressNotAuthorised, err.Code())
+
+	err = keeper.AddAdmin(ctx, newAdmin, admin)
+	assert.NoError(t, err)
+	assert.Equal(t, []sdk.AccAddress{admin, newAdmin}, keeper.GetParams(ctx).DistributionAdmins)
+
+	// the new admin can update params
+	err = keeper.UpdateParams(ctx, newAdmin, Params{StakeholderAllocation: sdk.NewDecWithPrec(20, 2)},
+		[]string{"stakeholder_allocation"})
+	assert.NoError(t, err)
+
+	err = keeper.RemoveAdmin(ctx, admin, fakeAddress())
+	assert.Equal(t, ErrorCodeAddressNotAuthorised, err.Code())
+
+	err = keeper.RemoveAdmin(ctx, admin, newAdmin)
+	assert.NoError(t, err)
+	assert.Equal(t, []sdk.AccAddress{newAdmin}, keeper.GetParams(ctx).DistributionAdmins)
+}
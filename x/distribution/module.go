@@ -32,7 +32,7 @@ func (AppModuleBasic) Name() string {
 
 // RegisterCodec registers the types needed for amino encoding/decoding
 func (AppModuleBasic) RegisterCodec(cdc *codec.Codec) {
-	//RegisterCodec(cdc)
+	RegisterCodec(cdc)
 }
 
 // DefaultGenesis creates the default genesis state for testing
@@ -88,9 +88,9 @@ func (AppModule) Route() string {
 	return RouterKey
 }
 
-// NewHandler creates the handler for the staking module
+// NewHandler creates the handler for the distribution module
 func (am AppModule) NewHandler() sdk.Handler {
-	return nil
+	return NewHandler(am.keeper)
 }
 
 // QuerierRoute defines the querier route
@@ -0,0 +1,102 @@
+package distribution
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// TypeMsgAddAdmin represents the type of message for adding a new admin
+	TypeMsgAddAdmin = "add_admin"
+	// TypeMsgRemoveAdmin represents the type of message for removing an admin
+	TypeMsgRemoveAdmin = "remove_admin"
+)
+
+// MsgAddAdmin defines the message to add a new admin
+type MsgAddAdmin struct {
+	Admin   sdk.AccAddress `json:"admin"`
+	Creator sdk.AccAddress `json:"creator"`
+}
+
+// NewMsgAddAdmin returns the messages to add a new admin
+func NewMsgAddAdmin(admin, creator sdk.AccAddress) MsgAddAdmin {
+	return MsgAddAdmin{
+		Admin:   admin,
+		Creator: creator,
+	}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgAddAdmin) ValidateBasic() sdk.Error {
+	if len(msg.Admin) == 0 {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Invalid address: %s", msg.Admin.String()))
+	}
+
+	if len(msg.Creator) == 0 {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Invalid address: %s", msg.Creator.String()))
+	}
+
+	return nil
+}
+
+// Route implements Msg
+func (msg MsgAddAdmin) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgAddAdmin) Type() string { return TypeMsgAddAdmin }
+
+// GetSignBytes implements Msg
+func (msg MsgAddAdmin) GetSignBytes() []byte {
+	msgBytes := ModuleCodec.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(msgBytes)
+}
+
+// GetSigners implements Msg. Returns the creator as the signer.
+func (msg MsgAddAdmin) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Creator)}
+}
+
+// MsgRemoveAdmin defines the message to remove an admin
+type MsgRemoveAdmin struct {
+	Admin   sdk.AccAddress `json:"admin"`
+	Remover sdk.AccAddress `json:"remover"`
+}
+
+// NewMsgRemoveAdmin returns the messages to remove an admin
+func NewMsgRemoveAdmin(admin, remover sdk.AccAddress) MsgRemoveAdmin {
+	return MsgRemoveAdmin{
+		Admin:   admin,
+		Remover: remover,
+	}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgRemoveAdmin) ValidateBasic() sdk.Error {
+	if len(msg.Admin) == 0 {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Invalid address: %s", msg.Admin.String()))
+	}
+
+	if len(msg.Remover) == 0 {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Invalid address: %s", msg.Remover.String()))
+	}
+
+	return nil
+}
+
+// Route implements Msg
+func (msg MsgRemoveAdmin) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgRemoveAdmin) Type() string { return TypeMsgRemoveAdmin }
+
+// GetSignBytes implements Msg
+func (msg MsgRemoveAdmin) GetSignBytes() []byte {
+	msgBytes := ModuleCodec.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(msgBytes)
+}
+
+// GetSigners implements Msg. Returns the remover as the signer.
+func (msg MsgRemoveAdmin) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Remover)}
+}
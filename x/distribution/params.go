@@ -13,13 +13,15 @@ var (
 	KeyUserGrowthAllocation  = []byte("userGrowthAllocation")
 	KeyUserRewardAllocation  = []byte("userRewardAllocation")
 	KeyStakeholderAllocation = []byte("stakeholderAllocation")
+	KeyDistributionAdmins    = []byte("distributionAdmins")
 )
 
 // Params holds parameters for Auth
 type Params struct {
-	UserGrowthAllocation  sdk.Dec `json:"user_growth_allocation"`
-	UserRewardAllocation  sdk.Dec `json:"user_reward_allocation"`
-	StakeholderAllocation sdk.Dec `json:"stakeholder_allocation"`
+	UserGrowthAllocation  sdk.Dec          `json:"user_growth_allocation"`
+	UserRewardAllocation  sdk.Dec          `json:"user_reward_allocation"`
+	StakeholderAllocation sdk.Dec          `json:"stakeholder_allocation"`
+	DistributionAdmins    []sdk.AccAddress `json:"distribution_admins"`
 }
 
 // DefaultParams is the auth params for testing
@@ -28,6 +30,7 @@ func DefaultParams() Params {
 		UserGrowthAllocation:  sdk.NewDecWithPrec(25, 2),
 		UserRewardAllocation:  sdk.NewDecWithPrec(25, 2),
 		StakeholderAllocation: sdk.NewDecWithPrec(25, 2),
+		DistributionAdmins:    []sdk.AccAddress{},
 	}
 }
 
@@ -37,9 +40,32 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		{Key: KeyUserGrowthAllocation, Value: &p.UserGrowthAllocation},
 		{Key: KeyUserRewardAllocation, Value: &p.UserRewardAllocation},
 		{Key: KeyStakeholderAllocation, Value: &p.StakeholderAllocation},
+		{Key: KeyDistributionAdmins, Value: &p.DistributionAdmins},
 	}
 }
 
+// Validate checks that every param has a usable value
+func (p Params) Validate() error {
+	if p.UserGrowthAllocation.IsNil() || !p.UserGrowthAllocation.IsPositive() {
+		return fmt.Errorf("Param: UserGrowthAllocation must be positive")
+	}
+
+	if p.UserRewardAllocation.IsNil() || !p.UserRewardAllocation.IsPositive() {
+		return fmt.Errorf("Param: UserRewardAllocation must be positive")
+	}
+
+	if p.StakeholderAllocation.IsNil() || p.StakeholderAllocation.IsNegative() {
+		return fmt.Errorf("Param: StakeholderAllocation can't be negative")
+	}
+
+	total := p.UserGrowthAllocation.Add(p.UserRewardAllocation).Add(p.StakeholderAllocation)
+	if total.GT(sdk.OneDec()) {
+		return fmt.Errorf("Params: allocations can't add up to more than 1")
+	}
+
+	return nil
+}
+
 // ParamKeyTable for auth module
 func ParamKeyTable() params.KeyTable {
 	return params.NewKeyTable().RegisterParamSet(&Params{})
@@ -60,9 +86,16 @@ func (k Keeper) SetParams(ctx sdk.Context, params Params) {
 }
 
 // UpdateParams updates the required params
-func (k Keeper) UpdateParams(ctx sdk.Context, updates Params, updatedFields []string) sdk.Error {
+func (k Keeper) UpdateParams(ctx sdk.Context, updater sdk.AccAddress, updates Params, updatedFields []string) sdk.Error {
+	if !k.isAdmin(ctx, updater) {
+		return ErrAddressNotAuthorised()
+	}
+
 	current := k.GetParams(ctx)
 	updated := k.getUpdatedParams(current, updates, updatedFields)
+	if err := updated.Validate(); err != nil {
+		return ErrInvalidParams(err.Error())
+	}
 	k.SetParams(ctx, updated)
 
 	return nil
@@ -9,4 +9,5 @@ const (
 
 	UserGrowthPoolName = "user_growth_tokens_pool"
 	UserRewardPoolName = "user_reward_tokens_pool"
+	UserStakesPoolName = "user_stakes_tokens_pool"
 )
@@ -0,0 +1,18 @@
+package search
+
+import "github.com/cosmos/cosmos-sdk/codec"
+
+// RegisterCodec registers messages into the codec
+func RegisterCodec(c *codec.Codec) {
+	c.RegisterConcrete(MsgRebuildIndex{}, "search/MsgRebuildIndex", nil)
+}
+
+// ModuleCodec encodes module codec
+var ModuleCodec *codec.Codec
+
+func init() {
+	ModuleCodec = codec.New()
+	RegisterCodec(ModuleCodec)
+	codec.RegisterCrypto(ModuleCodec)
+	ModuleCodec.Seal()
+}
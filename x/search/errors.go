@@ -0,0 +1,37 @@
+package search
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Search errors reserve 900 ~ 999.
+const (
+	DefaultCodespace sdk.CodespaceType = ModuleName
+
+	ErrorCodeAddressNotAuthorised sdk.CodeType = 901
+	ErrorCodeJSONParsing          sdk.CodeType = 902
+	ErrorCodeEmptySearchQuery     sdk.CodeType = 903
+	ErrorCodeInvalidDocumentType  sdk.CodeType = 904
+)
+
+// ErrAddressNotAuthorised throws an error when the address is not admin
+func ErrAddressNotAuthorised() sdk.Error {
+	return sdk.NewError(DefaultCodespace, ErrorCodeAddressNotAuthorised, "This address is not authorised to perform this action.")
+}
+
+// ErrJSONParse throws an error on failed JSON parsing
+func ErrJSONParse(err error) sdk.Error {
+	return sdk.NewError(DefaultCodespace, ErrorCodeJSONParsing, "JSON parsing error: "+err.Error())
+}
+
+// ErrEmptySearchQuery throws an error when a query has no searchable terms
+func ErrEmptySearchQuery(query string) sdk.Error {
+	return sdk.NewError(DefaultCodespace, ErrorCodeEmptySearchQuery, fmt.Sprintf("No searchable terms in query: %s", query))
+}
+
+// ErrInvalidDocumentType throws an error on an unknown document type
+func ErrInvalidDocumentType(docType DocumentType) sdk.Error {
+	return sdk.NewError(DefaultCodespace, ErrorCodeInvalidDocumentType, fmt.Sprintf("Invalid document type: %d", docType))
+}
@@ -0,0 +1,17 @@
+package search
+
+import (
+	"github.com/TruStory/truchain/x/claim"
+	"github.com/TruStory/truchain/x/staking"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ClaimKeeper is the expected claim keeper interface for rebuilding the index
+type ClaimKeeper interface {
+	Claims(ctx sdk.Context) claim.Claims
+}
+
+// StakingKeeper is the expected staking keeper interface for rebuilding the index
+type StakingKeeper interface {
+	Arguments(ctx sdk.Context) []staking.Argument
+}
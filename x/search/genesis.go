@@ -0,0 +1,58 @@
+package search
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GenesisState defines genesis data for the module.
+// The index itself isn't exported, it is rebuilt from claims and arguments.
+type GenesisState struct {
+	Params Params `json:"params"`
+}
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params) GenesisState {
+	return GenesisState{
+		Params: params,
+	}
+}
+
+// DefaultGenesisState returns a default genesis state
+func DefaultGenesisState() GenesisState { return NewGenesisState(DefaultParams()) }
+
+// InitGenesis initializes search state from genesis file
+func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
+	keeper.SetParams(ctx, data.Params)
+}
+
+// ExportGenesis exports the genesis state
+func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
+	return GenesisState{
+		Params: keeper.GetParams(ctx),
+	}
+}
+
+// ValidateGenesis validates the genesis state data
+func ValidateGenesis(data GenesisState) error {
+	if data.Params.MinTokenLength < 1 {
+		return fmt.Errorf("Param: MinTokenLength must have a positive value")
+	}
+	// the postings key stores the token length in a single byte; the tokenizer
+	// also skips multi-byte tokens that would overflow it
+	if data.Params.MaxTokenLength < data.Params.MinTokenLength || data.Params.MaxTokenLength > 255 {
+		return fmt.Errorf("Param: MaxTokenLength must be between MinTokenLength and 255")
+	}
+	if data.Params.MaxDocumentTokens < 1 {
+		return fmt.Errorf("Param: MaxDocumentTokens must have a positive value")
+	}
+	if data.Params.MaxQueryTokens < 1 {
+		return fmt.Errorf("Param: MaxQueryTokens must have a positive value")
+	}
+	if data.Params.DefaultPageLimit < 1 || data.Params.DefaultPageLimit > data.Params.MaxPageLimit {
+		return fmt.Errorf("Param: DefaultPageLimit must be between 1 and MaxPageLimit")
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package search
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewHandler creates a new handler
+func NewHandler(keeper Keeper, claimKeeper ClaimKeeper, stakingKeeper StakingKeeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		switch msg := msg.(type) {
+		case MsgRebuildIndex:
+			return handleMsgRebuildIndex(ctx, keeper, claimKeeper, stakingKeeper, msg)
+		default:
+			errMsg := fmt.Sprintf("Unrecognized search message type: %T", msg)
+			return sdk.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+
+func handleMsgRebuildIndex(ctx sdk.Context, keeper Keeper, claimKeeper ClaimKeeper,
+	stakingKeeper StakingKeeper, msg MsgRebuildIndex) sdk.Result {
+
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	docs := make([]Document, 0)
+	for _, c := range claimKeeper.Claims(ctx) {
+		if c.Hidden {
+			continue
+		}
+		docs = append(docs, Document{Type: DocumentClaim, ID: c.ID, Text: c.Body})
+	}
+	for _, a := range stakingKeeper.Arguments(ctx) {
+		if a.IsDeleted {
+			continue
+		}
+		docs = append(docs, Document{Type: DocumentArgument, ID: a.ID, Text: a.Summary})
+	}
+
+	err := keeper.RebuildIndex(ctx, msg.Admin, docs)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeIndexRebuilt,
+			sdk.NewAttribute(AttributeKeyDocumentsCount, fmt.Sprintf("%d", len(docs))),
+		),
+	)
+
+	res, jsonErr := ModuleCodec.MarshalJSON(len(docs))
+	if jsonErr != nil {
+		return sdk.ErrInternal(fmt.Sprintf("Marshal result error: %s", jsonErr)).Result()
+	}
+
+	return sdk.Result{
+		Data: res,
+	}
+}
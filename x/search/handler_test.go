@@ -0,0 +1,34 @@
+package search
+
+import (
+	"testing"
+
+	"github.com/TruStory/truchain/x/claim"
+	"github.com/TruStory/truchain/x/staking"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandle_RebuildIndex(t *testing.T) {
+	ctx, keeper := mockDB()
+
+	claims := claim.Claims{
+		{ID: 1, Body: "Vaccines cause autism"},
+		{ID: 2, Body: "Vaccines are a hoax", Hidden: true},
+	}
+	arguments := []staking.Argument{
+		{ID: 1, Summary: "Vaccines are tested for safety"},
+		{ID: 2, Summary: "Vaccines are a conspiracy", IsDeleted: true},
+	}
+	handler := NewHandler(keeper, claimKeeper{claims}, stakingKeeper{arguments})
+
+	admin := keeper.GetParams(ctx).SearchAdmins[0]
+	res := handler(ctx, NewMsgRebuildIndex(admin))
+	assert.True(t, res.IsOK())
+
+	results, err := keeper.Search(ctx, "vaccines", DocumentAny)
+	assert.Nil(t, err)
+	assert.Len(t, results, 2)
+	for _, r := range results {
+		assert.Equal(t, uint64(1), r.ID)
+	}
+}
@@ -0,0 +1,197 @@
+package search
+
+import (
+	"sort"
+
+	app "github.com/TruStory/truchain/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/gaskv"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	log "github.com/tendermint/tendermint/libs/log"
+)
+
+// Keeper is the model object for the module
+type Keeper struct {
+	storeKey   sdk.StoreKey
+	codec      *codec.Codec
+	paramStore params.Subspace
+}
+
+// NewKeeper creates a new search keeper
+func NewKeeper(storeKey sdk.StoreKey, paramStore params.Subspace, codec *codec.Codec) Keeper {
+	return Keeper{
+		storeKey,
+		codec,
+		paramStore.WithKeyTable(ParamKeyTable()),
+	}
+}
+
+// IndexClaim indexes the body of a claim, replacing any previous version
+func (k Keeper) IndexClaim(ctx sdk.Context, id uint64, body string) {
+	k.IndexDocument(ctx, Document{Type: DocumentClaim, ID: id, Text: body})
+}
+
+// RemoveClaim removes a claim from the index
+func (k Keeper) RemoveClaim(ctx sdk.Context, id uint64) {
+	k.RemoveDocument(ctx, DocumentClaim, id)
+}
+
+// IndexArgument indexes the summary of an argument, replacing any previous version
+func (k Keeper) IndexArgument(ctx sdk.Context, id uint64, summary string) {
+	k.IndexDocument(ctx, Document{Type: DocumentArgument, ID: id, Text: summary})
+}
+
+// RemoveArgument removes an argument from the index
+func (k Keeper) RemoveArgument(ctx sdk.Context, id uint64) {
+	k.RemoveDocument(ctx, DocumentArgument, id)
+}
+
+// IndexDocument tokenizes a document and adds it to the index, replacing any previous version
+func (k Keeper) IndexDocument(ctx sdk.Context, doc Document) {
+	k.RemoveDocument(ctx, doc.Type, doc.ID)
+
+	params := k.GetParams(ctx)
+	frequencies := termFrequencies(tokenize(doc.Text, params, params.MaxDocumentTokens))
+	if len(frequencies) == 0 {
+		return
+	}
+	tokens := make([]string, 0, len(frequencies))
+	for token := range frequencies {
+		tokens = append(tokens, token)
+	}
+	// keep store writes in a deterministic order
+	sort.Strings(tokens)
+
+	store := k.store(ctx)
+	for _, token := range tokens {
+		bz := k.codec.MustMarshalBinaryLengthPrefixed(frequencies[token])
+		store.Set(postingKey(token, doc.Type, doc.ID), bz)
+	}
+	store.Set(documentKey(doc.Type, doc.ID), k.codec.MustMarshalBinaryLengthPrefixed(tokens))
+}
+
+// RemoveDocument removes a document and all its postings from the index
+func (k Keeper) RemoveDocument(ctx sdk.Context, docType DocumentType, id uint64) {
+	store := k.store(ctx)
+	bz := store.Get(documentKey(docType, id))
+	if bz == nil {
+		return
+	}
+	var tokens []string
+	k.codec.MustUnmarshalBinaryLengthPrefixed(bz, &tokens)
+	for _, token := range tokens {
+		store.Delete(postingKey(token, docType, id))
+	}
+	store.Delete(documentKey(docType, id))
+}
+
+// RebuildIndex allows admins to clear the index and index the given documents again
+func (k Keeper) RebuildIndex(ctx sdk.Context, admin sdk.AccAddress, docs []Document) sdk.Error {
+	if !k.isAdmin(ctx, admin) {
+		return ErrAddressNotAuthorised()
+	}
+
+	k.clearPrefix(ctx, PostingsPrefix)
+	k.clearPrefix(ctx, DocumentsPrefix)
+	for _, doc := range docs {
+		k.IndexDocument(ctx, doc)
+	}
+
+	logger(ctx).Info("Rebuilt search index")
+
+	return nil
+}
+
+// Search returns the documents matching a query, ranked by the number of matched
+// terms, then by the number of occurrences, then newest first.
+// A docType of DocumentAny searches every document type.
+func (k Keeper) Search(ctx sdk.Context, query string, docType DocumentType) ([]Result, sdk.Error) {
+	if !docType.Valid() {
+		return nil, ErrInvalidDocumentType(docType)
+	}
+	params := k.GetParams(ctx)
+	terms := termFrequencies(tokenize(query, params, params.MaxQueryTokens))
+	if len(terms) == 0 {
+		return nil, ErrEmptySearchQuery(query)
+	}
+
+	type docRef struct {
+		Type DocumentType
+		ID   uint64
+	}
+	matches := make(map[docRef]*Result)
+	store := k.store(ctx)
+	for term := range terms {
+		prefix := tokenPostingsKey(term)
+		if docType != DocumentAny {
+			prefix = tokenTypePostingsKey(term, docType)
+		}
+		iterator := sdk.KVStorePrefixIterator(store, prefix)
+		for ; iterator.Valid(); iterator.Next() {
+			matchType, id := splitPostingKey(iterator.Key())
+			var frequency uint64
+			k.codec.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &frequency)
+
+			ref := docRef{matchType, id}
+			result, ok := matches[ref]
+			if !ok {
+				result = &Result{Type: matchType, ID: id}
+				matches[ref] = result
+			}
+			result.MatchedTerms++
+			result.Score += frequency
+		}
+		iterator.Close()
+	}
+
+	results := make([]Result, 0, len(matches))
+	for _, result := range matches {
+		results = append(results, *result)
+	}
+	sort.Slice(results, func(i, j int) bool {
+		a, b := results[i], results[j]
+		if a.MatchedTerms != b.MatchedTerms {
+			return a.MatchedTerms > b.MatchedTerms
+		}
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		if a.ID != b.ID {
+			return a.ID > b.ID
+		}
+		return a.Type < b.Type
+	})
+
+	return results, nil
+}
+
+func (k Keeper) clearPrefix(ctx sdk.Context, prefix []byte) {
+	store := k.store(ctx)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	keys := make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+func (k Keeper) isAdmin(ctx sdk.Context, address sdk.AccAddress) bool {
+	for _, admin := range k.GetParams(ctx).SearchAdmins {
+		if address.Equals(admin) {
+			return true
+		}
+	}
+	return false
+}
+
+func (k Keeper) store(ctx sdk.Context) sdk.KVStore {
+	return gaskv.NewStore(ctx.MultiStore().GetKVStore(k.storeKey), ctx.GasMeter(), app.KVGasConfig())
+}
+
+func logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", ModuleName)
+}
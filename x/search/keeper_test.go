@@ -0,0 +1,117 @@
+package search
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIndexClaim(t *testing.T) {
+	ctx, keeper := mockDB()
+
+	keeper.IndexClaim(ctx, 1, "Vaccines cause autism")
+	keeper.IndexClaim(ctx, 2, "The earth is flat")
+
+	results, err := keeper.Search(ctx, "vaccine", DocumentAny)
+	assert.Nil(t, err)
+	assert.Len(t, results, 1)
+	assert.Equal(t, DocumentClaim, results[0].Type)
+	assert.Equal(t, uint64(1), results[0].ID)
+}
+
+func TestIndexClaim_Reindex(t *testing.T) {
+	ctx, keeper := mockDB()
+
+	keeper.IndexClaim(ctx, 1, "Vaccines cause autism")
+	keeper.IndexClaim(ctx, 1, "The earth is flat")
+
+	results, err := keeper.Search(ctx, "vaccine", DocumentAny)
+	assert.Nil(t, err)
+	assert.Len(t, results, 0)
+	results, _ = keeper.Search(ctx, "earth", DocumentAny)
+	assert.Len(t, results, 1)
+}
+
+func TestRemoveClaim(t *testing.T) {
+	ctx, keeper := mockDB()
+
+	keeper.IndexClaim(ctx, 1, "Vaccines cause autism")
+	keeper.IndexArgument(ctx, 1, "Vaccines are safe")
+	keeper.RemoveClaim(ctx, 1)
+
+	results, err := keeper.Search(ctx, "vaccines", DocumentAny)
+	assert.Nil(t, err)
+	assert.Len(t, results, 1)
+	assert.Equal(t, DocumentArgument, results[0].Type)
+}
+
+func TestSearch_Ranking(t *testing.T) {
+	ctx, keeper := mockDB()
+
+	keeper.IndexClaim(ctx, 1, "Bitcoin is a store of value")
+	keeper.IndexClaim(ctx, 2, "Bitcoin will replace gold as a store of value")
+	keeper.IndexClaim(ctx, 3, "Bitcoin bitcoin bitcoin")
+	keeper.IndexArgument(ctx, 4, "Gold has thousands of years of history as a store of value")
+
+	results, err := keeper.Search(ctx, "bitcoin gold", DocumentAny)
+	assert.Nil(t, err)
+	assert.Len(t, results, 4)
+	// matches both terms
+	assert.Equal(t, uint64(2), results[0].ID)
+	assert.Equal(t, 2, results[0].MatchedTerms)
+	// most occurrences of a single term
+	assert.Equal(t, uint64(3), results[1].ID)
+	assert.Equal(t, uint64(3), results[1].Score)
+	// newest first
+	assert.Equal(t, uint64(4), results[2].ID)
+	assert.Equal(t, uint64(1), results[3].ID)
+}
+
+func TestSearch_DocumentType(t *testing.T) {
+	ctx, keeper := mockDB()
+
+	keeper.IndexClaim(ctx, 1, "Vaccines cause autism")
+	keeper.IndexArgument(ctx, 1, "Vaccines are safe")
+
+	results, err := keeper.Search(ctx, "vaccines", DocumentArgument)
+	assert.Nil(t, err)
+	assert.Len(t, results, 1)
+	assert.Equal(t, DocumentArgument, results[0].Type)
+
+	_, err = keeper.Search(ctx, "vaccines", DocumentType(10))
+	assert.Equal(t, ErrorCodeInvalidDocumentType, err.Code())
+}
+
+func TestSearch_ErrEmptySearchQuery(t *testing.T) {
+	ctx, keeper := mockDB()
+
+	_, err := keeper.Search(ctx, "the and of", DocumentAny)
+	assert.NotNil(t, err)
+	assert.Equal(t, ErrorCodeEmptySearchQuery, err.Code())
+}
+
+func TestRebuildIndex(t *testing.T) {
+	ctx, keeper := mockDB()
+
+	keeper.IndexClaim(ctx, 1, "Vaccines cause autism")
+	admin := keeper.GetParams(ctx).SearchAdmins[0]
+	docs := []Document{
+		{Type: DocumentClaim, ID: 2, Text: "The earth is flat"},
+		{Type: DocumentArgument, ID: 1, Text: "Ships disappear over the horizon"},
+	}
+	err := keeper.RebuildIndex(ctx, admin, docs)
+	assert.Nil(t, err)
+
+	results, _ := keeper.Search(ctx, "vaccines", DocumentAny)
+	assert.Len(t, results, 0)
+	results, _ = keeper.Search(ctx, "earth horizon", DocumentAny)
+	assert.Len(t, results, 2)
+}
+
+func TestRebuildIndex_ErrAddressNotAuthorised(t *testing.T) {
+	ctx, keeper := mockDB()
+
+	err := keeper.RebuildIndex(ctx, getFakeAdmin(), []Document{})
+	assert.NotNil(t, err)
+	assert.Equal(t, ErrorCodeAddressNotAuthorised, err.Code())
+}
@@ -0,0 +1,46 @@
+package search
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Keys for search store
+// Items are stored with the following key: values
+//
+// - 0x10<tokenLength_Byte><token_Bytes><documentType_Byte><documentID_Bytes>: termFrequency_Bytes
+// - 0x11<documentType_Byte><documentID_Bytes>: []token_Bytes
+var (
+	PostingsPrefix  = []byte{0x10}
+	DocumentsPrefix = []byte{0x11}
+)
+
+// tokenPostingsKey gets the first part of the postings key based on the token.
+// Tokens are length prefixed so a token is never a prefix of a longer one.
+func tokenPostingsKey(token string) []byte {
+	key := append(PostingsPrefix, byte(len(token)))
+	return append(key, []byte(token)...)
+}
+
+// tokenTypePostingsKey gets the postings key for a token restricted to a document type
+func tokenTypePostingsKey(token string, docType DocumentType) []byte {
+	return append(tokenPostingsKey(token), byte(docType))
+}
+
+// postingKey key of a specific token <-> document association from the store
+func postingKey(token string, docType DocumentType, id uint64) []byte {
+	return append(tokenTypePostingsKey(token, docType), sdk.Uint64ToBigEndian(id)...)
+}
+
+// documentKey key of the tokens indexed for a document
+func documentKey(docType DocumentType, id uint64) []byte {
+	key := append(DocumentsPrefix, byte(docType))
+	return append(key, sdk.Uint64ToBigEndian(id)...)
+}
+
+// splitPostingKey returns the document type and ID of a posting key
+func splitPostingKey(key []byte) (DocumentType, uint64) {
+	typeIndex := len(key) - 9
+	return DocumentType(key[typeIndex]), binary.BigEndian.Uint64(key[typeIndex+1:])
+}
@@ -0,0 +1,53 @@
+package search
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// TypeMsgRebuildIndex represents the type of message for rebuilding the search index
+	TypeMsgRebuildIndex = "rebuild_index"
+)
+
+// verify interface at compile time
+var _ sdk.Msg = &MsgRebuildIndex{}
+
+// MsgRebuildIndex defines the message to rebuild the search index from existing claims and arguments
+type MsgRebuildIndex struct {
+	Admin sdk.AccAddress `json:"admin"`
+}
+
+// NewMsgRebuildIndex returns the message to rebuild the search index
+func NewMsgRebuildIndex(admin sdk.AccAddress) MsgRebuildIndex {
+	return MsgRebuildIndex{
+		Admin: admin,
+	}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgRebuildIndex) ValidateBasic() sdk.Error {
+	if len(msg.Admin) == 0 {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Invalid address: %s", msg.Admin.String()))
+	}
+
+	return nil
+}
+
+// Route implements Msg
+func (msg MsgRebuildIndex) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgRebuildIndex) Type() string { return TypeMsgRebuildIndex }
+
+// GetSignBytes implements Msg
+func (msg MsgRebuildIndex) GetSignBytes() []byte {
+	msgBytes := ModuleCodec.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(msgBytes)
+}
+
+// GetSigners implements Msg. Returns the admin as the signer.
+func (msg MsgRebuildIndex) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Admin)}
+}
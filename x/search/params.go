@@ -0,0 +1,78 @@
+package search
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+// Keys for params
+var (
+	KeyMinTokenLength    = []byte("minTokenLength")
+	KeyMaxTokenLength    = []byte("maxTokenLength")
+	KeyMaxDocumentTokens = []byte("maxDocumentTokens")
+	KeyMaxQueryTokens    = []byte("maxQueryTokens")
+	KeyDefaultPageLimit  = []byte("defaultPageLimit")
+	KeyMaxPageLimit      = []byte("maxPageLimit")
+	KeySearchAdmins      = []byte("searchAdmins")
+)
+
+// Params holds parameters for search
+type Params struct {
+	// MinTokenLength is the shortest word that is indexed
+	MinTokenLength int `json:"min_token_length"`
+	// MaxTokenLength is the longest word that is indexed
+	MaxTokenLength int `json:"max_token_length"`
+	// MaxDocumentTokens caps the number of words indexed per document
+	MaxDocumentTokens int `json:"max_document_tokens"`
+	// MaxQueryTokens caps the number of words used from a search query
+	MaxQueryTokens   int              `json:"max_query_tokens"`
+	DefaultPageLimit int              `json:"default_page_limit"`
+	MaxPageLimit     int              `json:"max_page_limit"`
+	SearchAdmins     []sdk.AccAddress `json:"search_admins"`
+}
+
+// DefaultParams is the search params for testing
+func DefaultParams() Params {
+	return Params{
+		MinTokenLength:    2,
+		MaxTokenLength:    32,
+		MaxDocumentTokens: 64,
+		MaxQueryTokens:    8,
+		DefaultPageLimit:  20,
+		MaxPageLimit:      100,
+		SearchAdmins:      []sdk.AccAddress{},
+	}
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		{Key: KeyMinTokenLength, Value: &p.MinTokenLength},
+		{Key: KeyMaxTokenLength, Value: &p.MaxTokenLength},
+		{Key: KeyMaxDocumentTokens, Value: &p.MaxDocumentTokens},
+		{Key: KeyMaxQueryTokens, Value: &p.MaxQueryTokens},
+		{Key: KeyDefaultPageLimit, Value: &p.DefaultPageLimit},
+		{Key: KeyMaxPageLimit, Value: &p.MaxPageLimit},
+		{Key: KeySearchAdmins, Value: &p.SearchAdmins},
+	}
+}
+
+// ParamKeyTable for search module
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// GetParams gets the genesis params for search
+func (k Keeper) GetParams(ctx sdk.Context) Params {
+	var paramSet Params
+	k.paramStore.GetParamSet(ctx, &paramSet)
+	return paramSet
+}
+
+// SetParams sets the params for search
+func (k Keeper) SetParams(ctx sdk.Context, params Params) {
+	k.paramStore.SetParamSet(ctx, &params)
+	logger(ctx).Info(fmt.Sprintf("Loaded search params: %+v", params))
+}
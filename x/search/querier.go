@@ -0,0 +1,95 @@
+package search
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// query endpoints
+const (
+	QuerySearch = "search"
+	QueryParams = "params"
+)
+
+// QuerySearchParams for a full-text search
+type QuerySearchParams struct {
+	Query string       `json:"query"`
+	Type  DocumentType `json:"type,omitempty"`
+	// Page starts at 1, 0 is the first page
+	Page int `json:"page,omitempty"`
+	// Limit is the page size, 0 is the default page size
+	Limit int `json:"limit,omitempty"`
+}
+
+// NewQuerier returns a function that handles queries on the KVStore
+func NewQuerier(keeper Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case QuerySearch:
+			return querySearch(ctx, req, keeper)
+		case QueryParams:
+			return queryParams(ctx, keeper)
+		}
+
+		return nil, sdk.ErrUnknownRequest("Unknown search query endpoint")
+	}
+}
+
+func querySearch(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params QuerySearchParams
+	codecErr := ModuleCodec.UnmarshalJSON(req.Data, &params)
+	if codecErr != nil {
+		return nil, ErrJSONParse(codecErr)
+	}
+
+	results, err := keeper.Search(ctx, params.Query, params.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	return mustMarshal(paginate(results, params.Page, params.Limit, keeper.GetParams(ctx)))
+}
+
+// paginate returns a single page of results, capping the page size to the max page limit
+func paginate(results []Result, page, limit int, params Params) Results {
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 {
+		limit = params.DefaultPageLimit
+	}
+	if limit > params.MaxPageLimit {
+		limit = params.MaxPageLimit
+	}
+
+	// bound the page before multiplying so a huge page can't overflow
+	start := len(results)
+	if page-1 <= len(results)/limit {
+		start = (page - 1) * limit
+	}
+	end := start + limit
+	if end > len(results) {
+		end = len(results)
+	}
+
+	return Results{
+		Total:   len(results),
+		Page:    page,
+		Limit:   limit,
+		Results: results[start:end],
+	}
+}
+
+func queryParams(ctx sdk.Context, keeper Keeper) ([]byte, sdk.Error) {
+	return mustMarshal(keeper.GetParams(ctx))
+}
+
+func mustMarshal(v interface{}) (result []byte, err sdk.Error) {
+	result, jsonErr := codec.MarshalJSONIndent(ModuleCodec, v)
+	if jsonErr != nil {
+		return nil, ErrJSONParse(jsonErr)
+	}
+
+	return
+}
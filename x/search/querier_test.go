@@ -0,0 +1,68 @@
+package search
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+const custom = "custom"
+
+func TestQuerySearch(t *testing.T) {
+	ctx, keeper := mockDB()
+
+	for i := uint64(1); i <= 5; i++ {
+		keeper.IndexClaim(ctx, i, fmt.Sprintf("Claim number %d about elections", i))
+	}
+
+	params, jsonErr := ModuleCodec.MarshalJSON(QuerySearchParams{
+		Query: "elections",
+		Page:  2,
+		Limit: 2,
+	})
+	assert.Nil(t, jsonErr)
+
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, QuerierRoute, QuerySearch}, "/"),
+		Data: params,
+	}
+
+	querier := NewQuerier(keeper)
+	resBytes, err := querier(ctx, []string{QuerySearch}, query)
+	require.NoError(t, err)
+
+	var results Results
+	jsonErr = ModuleCodec.UnmarshalJSON(resBytes, &results)
+	assert.Nil(t, jsonErr)
+	assert.Equal(t, 5, results.Total)
+	assert.Equal(t, 2, results.Page)
+	assert.Len(t, results.Results, 2)
+	assert.Equal(t, uint64(3), results.Results[0].ID)
+	assert.Equal(t, uint64(2), results.Results[1].ID)
+}
+
+func TestPaginate(t *testing.T) {
+	params := DefaultParams()
+	results := make([]Result, 150)
+
+	page := paginate(results, 0, 0, params)
+	assert.Equal(t, 1, page.Page)
+	assert.Len(t, page.Results, params.DefaultPageLimit)
+
+	page = paginate(results, 1, 500, params)
+	assert.Equal(t, params.MaxPageLimit, page.Limit)
+	assert.Len(t, page.Results, params.MaxPageLimit)
+
+	page = paginate(results, 10, 20, params)
+	assert.Equal(t, 150, page.Total)
+	assert.Len(t, page.Results, 0)
+
+	page = paginate(results, math.MaxInt64, 20, params)
+	assert.Equal(t, math.MaxInt64, page.Page)
+	assert.Len(t, page.Results, 0)
+}
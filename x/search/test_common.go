@@ -0,0 +1,77 @@
+package search
+
+import (
+	"github.com/TruStory/truchain/x/claim"
+	"github.com/TruStory/truchain/x/staking"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	abci "github.com/tendermint/tendermint/abci/types"
+	cryptoAmino "github.com/tendermint/tendermint/crypto/encoding/amino"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// interface conformance check
+var _ ClaimKeeper = claimKeeper{}
+
+type claimKeeper struct {
+	claims claim.Claims
+}
+
+// Claims ...
+func (ck claimKeeper) Claims(ctx sdk.Context) claim.Claims {
+	return ck.claims
+}
+
+// interface conformance check
+var _ StakingKeeper = stakingKeeper{}
+
+type stakingKeeper struct {
+	arguments []staking.Argument
+}
+
+// Arguments ...
+func (sk stakingKeeper) Arguments(ctx sdk.Context) []staking.Argument {
+	return sk.arguments
+}
+
+func mockDB() (sdk.Context, Keeper) {
+	db := dbm.NewMemDB()
+
+	searchKey := sdk.NewKVStoreKey(ModuleName)
+	paramsKey := sdk.NewKVStoreKey(params.StoreKey)
+	transientParamsKey := sdk.NewTransientStoreKey(params.TStoreKey)
+
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(searchKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(paramsKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(transientParamsKey, sdk.StoreTypeTransient, db)
+	ms.LoadLatestVersion()
+
+	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
+
+	codec := codec.New()
+	cryptoAmino.RegisterAmino(codec)
+	RegisterCodec(codec)
+
+	paramsKeeper := params.NewKeeper(codec, paramsKey, transientParamsKey, params.DefaultCodespace)
+	searchKeeper := NewKeeper(searchKey, paramsKeeper.Subspace(ModuleName), codec)
+
+	admin1 := getFakeAdmin()
+	admin2 := getFakeAdmin()
+	genesis := DefaultGenesisState()
+	genesis.Params.SearchAdmins = append(genesis.Params.SearchAdmins, admin1, admin2)
+	InitGenesis(ctx, searchKeeper, genesis)
+
+	return ctx, searchKeeper
+}
+
+func getFakeAdmin() (address sdk.AccAddress) {
+	key := secp256k1.GenPrivKey()
+	pub := key.PubKey()
+	addr := sdk.AccAddress(pub.Address())
+	return addr
+}
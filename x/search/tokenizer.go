@@ -0,0 +1,133 @@
+package search
+
+import (
+	"strings"
+	"unicode"
+)
+
+// stopWords are common english words that aren't worth indexing
+var stopWords = map[string]bool{
+	"a": true, "about": true, "after": true, "all": true, "also": true, "am": true, "an": true,
+	"and": true, "any": true, "are": true, "as": true, "at": true, "be": true, "because": true,
+	"been": true, "before": true, "being": true, "but": true, "by": true, "can": true, "could": true,
+	"did": true, "do": true, "does": true, "for": true, "from": true, "had": true, "has": true,
+	"have": true, "he": true, "her": true, "him": true, "his": true, "how": true, "if": true,
+	"in": true, "into": true, "is": true, "it": true, "its": true, "just": true, "me": true,
+	"more": true, "most": true, "my": true, "no": true, "not": true, "of": true, "on": true,
+	"or": true, "our": true, "out": true, "she": true, "so": true, "than": true, "that": true,
+	"the": true, "their": true, "them": true, "then": true, "there": true, "these": true,
+	"they": true, "this": true, "those": true, "to": true, "too": true, "up": true, "very": true,
+	"was": true, "we": true, "were": true, "what": true, "when": true, "where": true, "which": true,
+	"while": true, "who": true, "why": true, "will": true, "with": true, "would": true, "you": true,
+	"your": true,
+}
+
+// maxTokenBytes is the longest token that fits the single length byte of the postings key
+const maxTokenBytes = 255
+
+// suffixRule replaces a word suffix during stemming
+type suffixRule struct {
+	suffix      string
+	replacement string
+}
+
+// suffixRules are checked in order, the first matching rule is applied
+var suffixRules = []suffixRule{
+	{"ational", "ate"},
+	{"ization", "ize"},
+	{"fulness", "ful"},
+	{"iveness", "ive"},
+	{"ousness", "ous"},
+	{"ies", "y"},
+	{"ied", "y"},
+	{"sses", "ss"},
+	{"ing", ""},
+	{"edly", ""},
+	{"ed", ""},
+	{"ly", ""},
+	{"s", ""},
+}
+
+// minStemLength is the shortest stem a suffix rule can leave
+const minStemLength = 3
+
+// stem reduces a word to an approximate root with a light suffix stripping stemmer,
+// so that "vaccines" and "vaccine" or "voting" and "votes" match
+func stem(word string) string {
+	return stripFinalE(stripSuffix(word))
+}
+
+func stripSuffix(word string) string {
+	for _, rule := range suffixRules {
+		if !strings.HasSuffix(word, rule.suffix) {
+			continue
+		}
+		root := strings.TrimSuffix(word, rule.suffix)
+		if len(root) < minStemLength {
+			return word
+		}
+		// words like "class" or "virus" don't have a plural suffix
+		if rule.suffix == "s" && (strings.HasSuffix(root, "s") || strings.HasSuffix(root, "u") || strings.HasSuffix(root, "i")) {
+			return word
+		}
+		return stripDoubleConsonant(root) + rule.replacement
+	}
+
+	return word
+}
+
+// stripFinalE turns "vote" into "vot" so it matches the root of "voting"
+func stripFinalE(word string) string {
+	if len(word) <= minStemLength || !strings.HasSuffix(word, "e") {
+		return word
+	}
+	return strings.TrimSuffix(word, "e")
+}
+
+// stripDoubleConsonant turns "stopp" from "stopping" into "stop"
+func stripDoubleConsonant(root string) string {
+	n := len(root)
+	if n < 2 || root[n-1] != root[n-2] {
+		return root
+	}
+	switch root[n-1] {
+	case 'l', 's', 'z', 'a', 'e', 'i', 'o', 'u':
+		return root
+	}
+	return root[:n-1]
+}
+
+// tokenize splits text into lowercase stemmed words, skipping stop words and
+// words outside the configured length limits or longer than maxTokenBytes.
+// At most maxTokens are returned.
+func tokenize(text string, params Params, maxTokens int) []string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	tokens := make([]string, 0, len(words))
+	for _, word := range words {
+		if len(tokens) >= maxTokens {
+			break
+		}
+		if stopWords[word] {
+			continue
+		}
+		length := len([]rune(word))
+		if length < params.MinTokenLength || length > params.MaxTokenLength || len(word) > maxTokenBytes {
+			continue
+		}
+		tokens = append(tokens, stem(word))
+	}
+
+	return tokens
+}
+
+// termFrequencies counts the occurrences of each token
+func termFrequencies(tokens []string) map[string]uint64 {
+	frequencies := make(map[string]uint64)
+	for _, token := range tokens {
+		frequencies[token]++
+	}
+	return frequencies
+}
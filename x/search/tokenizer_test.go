@@ -0,0 +1,62 @@
+package search
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenize(t *testing.T) {
+	params := DefaultParams()
+	tokens := tokenize("The vaccines are CAUSING autism, and it's a cover-up!", params, params.MaxDocumentTokens)
+	assert.Equal(t, []string{"vaccin", "caus", "autism", "cover"}, tokens)
+}
+
+func TestTokenize_MaxTokens(t *testing.T) {
+	params := DefaultParams()
+	tokens := tokenize("bitcoin ethereum cosmos tendermint", params, 2)
+	assert.Equal(t, []string{"bitcoin", "ethereum"}, tokens)
+}
+
+func TestTokenize_TokenLength(t *testing.T) {
+	params := DefaultParams()
+	params.MinTokenLength = 4
+	params.MaxTokenLength = 6
+	tokens := tokenize("cat horse elephant", params, params.MaxDocumentTokens)
+	assert.Equal(t, []string{"hors"}, tokens)
+}
+
+func TestTokenize_TokenBytes(t *testing.T) {
+	params := DefaultParams()
+	params.MaxTokenLength = 255
+	// 200 runes of 2 bytes each overflow the postings key length byte
+	word := strings.Repeat("é", 200)
+	tokens := tokenize("bitcoin "+word, params, params.MaxDocumentTokens)
+	assert.Equal(t, []string{"bitcoin"}, tokens)
+}
+
+func TestStem(t *testing.T) {
+	tests := map[string]string{
+		"claims":    "claim",
+		"stories":   "story",
+		"running":   "run",
+		"stopped":   "stop",
+		"class":     "class",
+		"bus":       "bus",
+		"quickly":   "quick",
+		"arguments": "argument",
+		"voting":    "vot",
+		"votes":     "vot",
+		"vaccine":   "vaccin",
+	}
+	for word, stemmed := range tests {
+		assert.Equal(t, stemmed, stem(word), word)
+	}
+}
+
+func TestTermFrequencies(t *testing.T) {
+	frequencies := termFrequencies([]string{"earth", "flat", "earth"})
+	assert.Equal(t, uint64(2), frequencies["earth"])
+	assert.Equal(t, uint64(1), frequencies["flat"])
+}
@@ -0,0 +1,72 @@
+package search
+
+import (
+	"fmt"
+)
+
+// Defines module constants
+const (
+	RouterKey         = ModuleName
+	QuerierRoute      = ModuleName
+	StoreKey          = ModuleName
+	DefaultParamspace = ModuleName
+
+	EventTypeIndexRebuilt      = "search-index-rebuilt"
+	AttributeKeyDocumentsCount = "documents-count"
+)
+
+// DocumentType enum for the kinds of indexed documents
+type DocumentType byte
+
+const (
+	// DocumentAny matches every document type in a search
+	DocumentAny DocumentType = iota
+	// DocumentClaim represents a claim body
+	DocumentClaim
+	// DocumentArgument represents an argument summary
+	DocumentArgument
+)
+
+// DocumentTypeName maps a document type to its name
+var DocumentTypeName = []string{
+	DocumentAny:      "any",
+	DocumentClaim:    "claim",
+	DocumentArgument: "argument",
+}
+
+// Valid returns true if the document type is a known type
+func (t DocumentType) Valid() bool {
+	return int(t) < len(DocumentTypeName)
+}
+
+func (t DocumentType) String() string {
+	if !t.Valid() {
+		return fmt.Sprintf("DocumentType(%d)", t)
+	}
+	return DocumentTypeName[t]
+}
+
+// Document is a piece of text to index
+type Document struct {
+	Type DocumentType `json:"type"`
+	ID   uint64       `json:"id"`
+	Text string       `json:"text"`
+}
+
+// Result is a document matching a search query
+type Result struct {
+	Type DocumentType `json:"type"`
+	ID   uint64       `json:"id"`
+	// MatchedTerms is the number of distinct query terms found in the document
+	MatchedTerms int `json:"matched_terms"`
+	// Score is the number of occurrences of the query terms in the document
+	Score uint64 `json:"score"`
+}
+
+// Results is a page of search results
+type Results struct {
+	Total   int      `json:"total"`
+	Page    int      `json:"page"`
+	Limit   int      `json:"limit"`
+	Results []Result `json:"results"`
+}
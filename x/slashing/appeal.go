@@ -0,0 +1,268 @@
+package slashing
+
+import (
+	"fmt"
+
+	"github.com/TruStory/truchain/x/bank"
+	"github.com/TruStory/truchain/x/staking"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AppealJail files an appeal against the jailing of a user, holding the appeal stake until it's reviewed.
+// The stake is paid by the signing key, which can be a linked key of the user.
+// A user can only have one pending appeal.
+func (k Keeper) AppealJail(ctx sdk.Context, creator sdk.AccAddress, reason string) (appeal Appeal, err sdk.Error) {
+	params := k.GetParams(ctx)
+	if len(reason) == 0 {
+		return appeal, ErrInvalidAppealReason("Reason can't be empty.")
+	}
+	if len(reason) > params.MaxAppealReasonLength {
+		return appeal, ErrInvalidAppealReason(fmt.Sprintf("Reason must be under %d chars.", params.MaxAppealReasonLength))
+	}
+
+	jailed, err := k.accountKeeper.IsJailed(ctx, creator)
+	if err != nil {
+		return appeal, err
+	}
+	if !jailed {
+		return appeal, ErrNotJailed(creator)
+	}
+	user := k.accountKeeper.PrimaryAddress(ctx, creator)
+	if _, ok := k.pendingAppealID(ctx, user); ok {
+		return appeal, ErrAppealExists(user)
+	}
+
+	appealID, err := k.appealID(ctx)
+	if err != nil {
+		return appeal, err
+	}
+	appeal = Appeal{
+		ID:          appealID,
+		Creator:     user,
+		Staker:      creator,
+		Reason:      reason,
+		Stake:       params.AppealStake,
+		Status:      AppealPending,
+		CreatedTime: ctx.BlockHeader().Time,
+	}
+
+	if appeal.Stake.IsPositive() {
+		_, err = k.bankKeeper.SubtractCoin(ctx, creator, appeal.Stake, appealID,
+			bank.TransactionJailAppealStake, ToModuleAccount(AppealStakesPoolName))
+		if err != nil {
+			return appeal, err
+		}
+	}
+
+	k.setAppeal(ctx, appeal)
+	k.setAppealID(ctx, appealID+1)
+	k.setPendingAppeal(ctx, appeal)
+
+	k.emitAppealEvent(ctx, EventTypeJailAppealFiled, appeal)
+	k.Logger(ctx).Info(fmt.Sprintf("Filed %s", appeal.String()))
+
+	return appeal, nil
+}
+
+// ApproveAppeal releases the user from jail and refunds the appeal stake.
+// When pardon is set, the jailing is dropped from the jail history of the user,
+// so it won't count towards the duration of the next jailings.
+func (k Keeper) ApproveAppeal(ctx sdk.Context, appealID uint64, reviewer sdk.AccAddress, pardon bool) (Appeal, sdk.Error) {
+	appeal, err := k.reviewAppeal(ctx, appealID, reviewer, AppealApproved)
+	if err != nil {
+		return appeal, err
+	}
+
+	err = k.accountKeeper.UnJail(ctx, appeal.Creator)
+	if err != nil {
+		return appeal, err
+	}
+	if pardon {
+		err = k.accountKeeper.PardonLastJail(ctx, appeal.Creator)
+		if err != nil {
+			return appeal, err
+		}
+	}
+	if appeal.Stake.IsPositive() {
+		_, err = k.bankKeeper.AddCoin(ctx, appeal.Staker, appeal.Stake, appeal.ID,
+			bank.TransactionJailAppealStakeReturned, FromModuleAccount(AppealStakesPoolName))
+		if err != nil {
+			return appeal, err
+		}
+	}
+
+	k.emitAppealEvent(ctx, EventTypeJailAppealApproved, appeal)
+	k.Logger(ctx).Info(fmt.Sprintf("Approved %s", appeal.String()))
+
+	return appeal, nil
+}
+
+// DenyAppeal keeps the user in jail and forfeits the appeal stake to the user reward pool
+func (k Keeper) DenyAppeal(ctx sdk.Context, appealID uint64, reviewer sdk.AccAddress) (Appeal, sdk.Error) {
+	appeal, err := k.reviewAppeal(ctx, appealID, reviewer, AppealDenied)
+	if err != nil {
+		return appeal, err
+	}
+
+	if appeal.Stake.IsPositive() {
+		err = k.bankKeeper.SendCoinBetweenModules(ctx, appeal.Staker, appeal.Stake, appeal.ID,
+			bank.TransactionJailAppealStakeForfeited,
+			FromModuleAccount(AppealStakesPoolName),
+			ToModuleAccount(staking.UserRewardPoolName))
+		if err != nil {
+			return appeal, err
+		}
+	}
+
+	k.emitAppealEvent(ctx, EventTypeJailAppealDenied, appeal)
+	k.Logger(ctx).Info(fmt.Sprintf("Denied %s", appeal.String()))
+
+	return appeal, nil
+}
+
+// expirePendingAppeal closes the pending appeal of a user released at the end of the jail time
+// and refunds the appeal stake, so it doesn't block a new appeal or get forfeited afterwards
+func (k Keeper) expirePendingAppeal(ctx sdk.Context, user sdk.AccAddress) sdk.Error {
+	appealID, ok := k.pendingAppealID(ctx, user)
+	if !ok {
+		return nil
+	}
+	appeal, err := k.Appeal(ctx, appealID)
+	if err != nil {
+		return err
+	}
+
+	appeal.Status = AppealExpired
+	appeal.ReviewedTime = ctx.BlockHeader().Time
+	k.setAppeal(ctx, appeal)
+	k.deletePendingAppeal(ctx, appeal)
+
+	if appeal.Stake.IsPositive() {
+		_, err = k.bankKeeper.AddCoin(ctx, appeal.Staker, appeal.Stake, appeal.ID,
+			bank.TransactionJailAppealStakeReturned, FromModuleAccount(AppealStakesPoolName))
+		if err != nil {
+			return err
+		}
+	}
+
+	k.emitAppealEvent(ctx, EventTypeJailAppealExpired, appeal)
+	k.Logger(ctx).Info(fmt.Sprintf("Expired %s", appeal.String()))
+
+	return nil
+}
+
+// Appeal returns an appeal by its ID
+func (k Keeper) Appeal(ctx sdk.Context, id uint64) (appeal Appeal, err sdk.Error) {
+	bz := k.store(ctx).Get(appealKey(id))
+	if bz == nil {
+		return appeal, ErrAppealNotFound(id)
+	}
+	k.codec.MustUnmarshalBinaryLengthPrefixed(bz, &appeal)
+
+	return appeal, nil
+}
+
+// Appeals gets all appeals from the KVStore
+func (k Keeper) Appeals(ctx sdk.Context) Appeals {
+	appeals := make(Appeals, 0)
+	iterator := sdk.KVStorePrefixIterator(k.store(ctx), AppealsKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var appeal Appeal
+		k.codec.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &appeal)
+		appeals = append(appeals, appeal)
+	}
+
+	return appeals
+}
+
+// PendingAppeals gets the appeals waiting for a review, oldest first
+func (k Keeper) PendingAppeals(ctx sdk.Context) Appeals {
+	appeals := make(Appeals, 0)
+	iterator := sdk.KVStorePrefixIterator(k.store(ctx), PendingAppealsPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var appealID uint64
+		k.codec.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &appealID)
+		appeal, err := k.Appeal(ctx, appealID)
+		if err != nil {
+			panic(err)
+		}
+		appeals = append(appeals, appeal)
+	}
+
+	return appeals
+}
+
+// reviewAppeal closes a pending appeal with the given status
+func (k Keeper) reviewAppeal(ctx sdk.Context, appealID uint64, reviewer sdk.AccAddress, status AppealStatus) (Appeal, sdk.Error) {
+	if !k.isAdmin(ctx, reviewer) {
+		return Appeal{}, ErrAddressNotAuthorised()
+	}
+	appeal, err := k.Appeal(ctx, appealID)
+	if err != nil {
+		return appeal, err
+	}
+	if appeal.Status != AppealPending {
+		return appeal, ErrAppealReviewed(appealID)
+	}
+
+	appeal.Status = status
+	appeal.Reviewer = reviewer
+	appeal.ReviewedTime = ctx.BlockHeader().Time
+	k.setAppeal(ctx, appeal)
+	k.deletePendingAppeal(ctx, appeal)
+
+	return appeal, nil
+}
+
+func (k Keeper) emitAppealEvent(ctx sdk.Context, eventType string, appeal Appeal) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(AttributeKeyAppealID, fmt.Sprintf("%d", appeal.ID)),
+			sdk.NewAttribute(AttributeKeyUser, appeal.Creator.String()),
+		),
+	)
+}
+
+// appealID gets the next appeal ID
+func (k Keeper) appealID(ctx sdk.Context) (appealID uint64, err sdk.Error) {
+	bz := k.store(ctx).Get(AppealIDKey)
+	if bz == nil {
+		return 0, ErrAppealNotFound(appealID)
+	}
+	k.codec.MustUnmarshalBinaryLengthPrefixed(bz, &appealID)
+	return appealID, nil
+}
+
+func (k Keeper) setAppealID(ctx sdk.Context, appealID uint64) {
+	k.store(ctx).Set(AppealIDKey, k.codec.MustMarshalBinaryLengthPrefixed(appealID))
+}
+
+func (k Keeper) setAppeal(ctx sdk.Context, appeal Appeal) {
+	k.store(ctx).Set(appealKey(appeal.ID), k.codec.MustMarshalBinaryLengthPrefixed(appeal))
+}
+
+// pendingAppealID gets the ID of the pending appeal of a user
+func (k Keeper) pendingAppealID(ctx sdk.Context, creator sdk.AccAddress) (appealID uint64, ok bool) {
+	bz := k.store(ctx).Get(creatorPendingAppealKey(creator))
+	if bz == nil {
+		return 0, false
+	}
+	k.codec.MustUnmarshalBinaryLengthPrefixed(bz, &appealID)
+	return appealID, true
+}
+
+func (k Keeper) setPendingAppeal(ctx sdk.Context, appeal Appeal) {
+	store := k.store(ctx)
+	bz := k.codec.MustMarshalBinaryLengthPrefixed(appeal.ID)
+	store.Set(pendingAppealKey(appeal.ID), bz)
+	store.Set(creatorPendingAppealKey(appeal.Creator), bz)
+}
+
+func (k Keeper) deletePendingAppeal(ctx sdk.Context, appeal Appeal) {
+	store := k.store(ctx)
+	store.Delete(pendingAppealKey(appeal.ID))
+	store.Delete(creatorPendingAppealKey(appeal.Creator))
+}
@@ -0,0 +1,216 @@
+package slashing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/TruStory/truchain/x/account"
+	"github.com/TruStory/truchain/x/bank"
+	"github.com/TruStory/truchain/x/staking"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func jailedUser(ctx sdk.Context, keeper Keeper) sdk.AccAddress {
+	_, publicKey, address, coins := getFakeAppAccountParams()
+	_, err := keeper.accountKeeper.CreateAppAccount(ctx, address, coins, publicKey)
+	if err != nil {
+		panic(err)
+	}
+	err = keeper.accountKeeper.JailUntil(ctx, address, ctx.BlockHeader().Time.Add(time.Hour))
+	if err != nil {
+		panic(err)
+	}
+	return address
+}
+
+func TestAppealJail_Approve(t *testing.T) {
+	ctx, keeper := mockDB()
+	user := jailedUser(ctx, keeper)
+	stake := keeper.GetParams(ctx).AppealStake
+	balance := keeper.bankKeeper.GetCoins(ctx, user)
+
+	_, err := keeper.AppealJail(ctx, user, "")
+	assert.Equal(t, ErrorCodeInvalidAppealReason, err.Code())
+
+	appeal, err := keeper.AppealJail(ctx, user, "I was wrongly jailed")
+	assert.NoError(t, err)
+	assert.Equal(t, AppealPending, appeal.Status)
+	assert.Equal(t, balance.Sub(sdk.NewCoins(stake)), keeper.bankKeeper.GetCoins(ctx, user))
+	assert.Len(t, keeper.PendingAppeals(ctx), 1)
+
+	_, err = keeper.AppealJail(ctx, user, "Please")
+	assert.Equal(t, ErrorCodeAppealExists, err.Code())
+
+	_, err = keeper.ApproveAppeal(ctx, appeal.ID, user, false)
+	assert.Equal(t, ErrorCodeAddressNotAuthorised, err.Code())
+
+	admin := keeper.GetParams(ctx).SlashAdmins[0]
+	appeal, err = keeper.ApproveAppeal(ctx, appeal.ID, admin, false)
+	assert.NoError(t, err)
+	assert.Equal(t, AppealApproved, appeal.Status)
+	assert.Equal(t, admin, appeal.Reviewer)
+	assert.Equal(t, balance, keeper.bankKeeper.GetCoins(ctx, user))
+	assert.Len(t, keeper.PendingAppeals(ctx), 0)
+	jailed, err := keeper.accountKeeper.IsJailed(ctx, user)
+	assert.NoError(t, err)
+	assert.False(t, jailed)
+
+	_, err = keeper.DenyAppeal(ctx, appeal.ID, admin)
+	assert.Equal(t, ErrorCodeAppealReviewed, err.Code())
+
+	_, err = keeper.AppealJail(ctx, user, "I'm not jailed anymore")
+	assert.Equal(t, ErrorCodeNotJailed, err.Code())
+}
+
+func TestAppealJail_Deny(t *testing.T) {
+	ctx, keeper := mockDB()
+	user := jailedUser(ctx, keeper)
+	stake := keeper.GetParams(ctx).AppealStake
+	balance := keeper.bankKeeper.GetCoins(ctx, user)
+
+	appeal, err := keeper.AppealJail(ctx, user, "I was wrongly jailed")
+	assert.NoError(t, err)
+
+	admin := keeper.GetParams(ctx).SlashAdmins[0]
+	appeal, err = keeper.DenyAppeal(ctx, appeal.ID, admin)
+	assert.NoError(t, err)
+	assert.Equal(t, AppealDenied, appeal.Status)
+	assert.Equal(t, balance.Sub(sdk.NewCoins(stake)), keeper.bankKeeper.GetCoins(ctx, user))
+	forfeited := false
+	for _, tx := range keeper.bankKeeper.Transactions(ctx) {
+		if tx.Type == bank.TransactionJailAppealStakeForfeited {
+			forfeited = true
+			assert.Equal(t, stake, tx.Amount)
+			assert.Equal(t, staking.UserRewardPoolName, tx.ToModuleAccount)
+		}
+	}
+	assert.True(t, forfeited)
+	jailed, err := keeper.accountKeeper.IsJailed(ctx, user)
+	assert.NoError(t, err)
+	assert.True(t, jailed)
+
+	// appeals survive an export
+	genesis := ExportGenesis(ctx, keeper)
+	assert.Len(t, genesis.Appeals, 1)
+	assert.NoError(t, ValidateGenesis(genesis))
+
+	// a denied appeal doesn't block a new one
+	_, err = keeper.AppealJail(ctx, user, "Please reconsider")
+	assert.NoError(t, err)
+	assert.Len(t, keeper.PendingAppeals(ctx), 1)
+}
+
+func TestAppealJail_GenesisFundsStakesPool(t *testing.T) {
+	ctx, keeper := mockDB()
+	user := jailedUser(ctx, keeper)
+	stake := keeper.GetParams(ctx).AppealStake
+
+	_, err := keeper.AppealJail(ctx, user, "I was wrongly jailed")
+	assert.NoError(t, err)
+	assert.Equal(t, sdk.NewCoins(stake), keeper.supplyKeeper.GetModuleAccount(ctx, AppealStakesPoolName).GetCoins())
+
+	// an imported chain starts with an empty appeal stakes pool
+	genesis := ExportGenesis(ctx, keeper)
+	ctx, keeper = mockDB()
+	assert.True(t, keeper.supplyKeeper.GetModuleAccount(ctx, AppealStakesPoolName).GetCoins().Empty())
+	InitGenesis(ctx, keeper, genesis)
+	assert.Equal(t, sdk.NewCoins(stake), keeper.supplyKeeper.GetModuleAccount(ctx, AppealStakesPoolName).GetCoins())
+}
+
+func TestAppealJail_LinkedKey(t *testing.T) {
+	ctx, keeper := mockDB()
+	user := jailedUser(ctx, keeper)
+	stake := keeper.GetParams(ctx).AppealStake
+	_, publicKey, linked, coins := getFakeAppAccountParams()
+	_, err := keeper.accountKeeper.AddAccountKey(ctx, user, linked, publicKey)
+	assert.NoError(t, err)
+	_, err = keeper.bankKeeper.AddCoin(ctx, linked, coins[0], 0, bank.TransactionGift)
+	assert.NoError(t, err)
+	balance := keeper.bankKeeper.GetCoins(ctx, user)
+	linkedBalance := keeper.bankKeeper.GetCoins(ctx, linked)
+
+	// the linked key pays the stake
+	appeal, err := keeper.AppealJail(ctx, linked, "I was wrongly jailed")
+	assert.NoError(t, err)
+	assert.Equal(t, user, appeal.Creator)
+	assert.Equal(t, linked, appeal.Staker)
+	assert.Equal(t, balance, keeper.bankKeeper.GetCoins(ctx, user))
+	assert.Equal(t, linkedBalance.Sub(sdk.NewCoins(stake)), keeper.bankKeeper.GetCoins(ctx, linked))
+
+	// one pending appeal per user, from any of its keys
+	_, err = keeper.AppealJail(ctx, user, "Please")
+	assert.Equal(t, ErrorCodeAppealExists, err.Code())
+
+	// and gets it back
+	admin := keeper.GetParams(ctx).SlashAdmins[0]
+	_, err = keeper.ApproveAppeal(ctx, appeal.ID, admin, false)
+	assert.NoError(t, err)
+	assert.Equal(t, balance, keeper.bankKeeper.GetCoins(ctx, user))
+	assert.Equal(t, linkedBalance, keeper.bankKeeper.GetCoins(ctx, linked))
+}
+
+func TestAppealJail_ApprovePardon(t *testing.T) {
+	ctx, keeper := mockDB()
+	_, publicKey, user, coins := getFakeAppAccountParams()
+	_, err := keeper.accountKeeper.CreateAppAccount(ctx, user, coins, publicKey)
+	assert.NoError(t, err)
+	accountParams := keeper.accountKeeper.GetParams(ctx)
+	jail := func() {
+		for i := 0; i < accountParams.MaxSlashCount; i++ {
+			_, err := keeper.accountKeeper.IncrementSlashCount(ctx, user)
+			assert.NoError(t, err)
+		}
+	}
+
+	jail()
+	appeal, err := keeper.AppealJail(ctx, user, "I was wrongly jailed")
+	assert.NoError(t, err)
+	admin := keeper.GetParams(ctx).SlashAdmins[0]
+	_, err = keeper.ApproveAppeal(ctx, appeal.ID, admin, true)
+	assert.NoError(t, err)
+
+	// the pardoned jailing doesn't make the next one longer
+	jail()
+	acc, err := keeper.accountKeeper.PrimaryAccount(ctx, user)
+	assert.NoError(t, err)
+	assert.True(t, acc.IsJailed)
+	assert.Equal(t, ctx.BlockHeader().Time.Add(accountParams.JailDuration), acc.JailEndTime)
+
+	appeal, err = keeper.AppealJail(ctx, user, "I was wrongly jailed again")
+	assert.NoError(t, err)
+	_, err = keeper.ApproveAppeal(ctx, appeal.ID, admin, false)
+	assert.NoError(t, err)
+
+	// without a pardon, the next jailing lasts twice as long
+	jail()
+	acc, err = keeper.accountKeeper.PrimaryAccount(ctx, user)
+	assert.NoError(t, err)
+	assert.Equal(t, ctx.BlockHeader().Time.Add(2*accountParams.JailDuration), acc.JailEndTime)
+}
+
+func TestAppealJail_Expired(t *testing.T) {
+	ctx, keeper := mockDB()
+	accountKeeper := keeper.accountKeeper
+	accountKeeper.SetHooks(account.NewMultiAccountHooks(
+		keeper.claimKeeper.Hooks(), keeper.stakingKeeper.Hooks(), keeper.Hooks(),
+	))
+	user := jailedUser(ctx, keeper)
+	balance := keeper.bankKeeper.GetCoins(ctx, user)
+
+	appeal, err := keeper.AppealJail(ctx, user, "I was wrongly jailed")
+	assert.NoError(t, err)
+
+	// the user serves the jail time before the appeal is reviewed
+	account.EndBlocker(ctx.WithBlockTime(ctx.BlockHeader().Time.Add(2*time.Hour)), accountKeeper)
+
+	appeal, err = keeper.Appeal(ctx, appeal.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, AppealExpired, appeal.Status)
+	assert.Equal(t, balance, keeper.bankKeeper.GetCoins(ctx, user))
+	assert.Len(t, keeper.PendingAppeals(ctx), 0)
+
+	admin := keeper.GetParams(ctx).SlashAdmins[0]
+	_, err = keeper.DenyAppeal(ctx, appeal.ID, admin)
+	assert.Equal(t, ErrorCodeAppealReviewed, err.Code())
+}
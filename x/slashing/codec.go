@@ -8,6 +8,9 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgAddAdmin{}, "slashing/MsgAddAdmin", nil)
 	cdc.RegisterConcrete(MsgRemoveAdmin{}, "slashing/MsgRemoveAdmin", nil)
 	cdc.RegisterConcrete(MsgUpdateParams{}, "slashing/MsgUpdateParams", nil)
+	cdc.RegisterConcrete(MsgAppealJail{}, "slashing/MsgAppealJail", nil)
+	cdc.RegisterConcrete(MsgApproveAppeal{}, "slashing/MsgApproveAppeal", nil)
+	cdc.RegisterConcrete(MsgDenyAppeal{}, "slashing/MsgDenyAppeal", nil)
 
 	cdc.RegisterConcrete(Slash{}, "truchain/Slash", nil)
 }
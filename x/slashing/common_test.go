@@ -18,6 +18,7 @@ import (
 	trubank "github.com/TruStory/truchain/x/bank"
 	"github.com/TruStory/truchain/x/claim"
 	"github.com/TruStory/truchain/x/community"
+	"github.com/TruStory/truchain/x/search"
 
 	app "github.com/TruStory/truchain/types"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -40,6 +41,7 @@ func mockDB() (sdk.Context, Keeper) {
 	authKey := sdk.NewKVStoreKey(auth.StoreKey)
 	accountKey := sdk.NewKVStoreKey(account.StoreKey)
 	claimKey := sdk.NewKVStoreKey(claim.ModuleName)
+	searchKey := sdk.NewKVStoreKey(search.ModuleName)
 	bankKey := sdk.NewKVStoreKey(trubank.ModuleName)
 	slashKey := sdk.NewKVStoreKey(ModuleName)
 	stakingKey := sdk.NewKVStoreKey(staking.ModuleName)
@@ -57,6 +59,7 @@ func mockDB() (sdk.Context, Keeper) {
 	ms.MountStoreWithDB(bankKey, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(communityKey, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(claimKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(searchKey, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(supplyKey, sdk.StoreTypeIAVL, db)
 	ms.LoadLatestVersion()
 
@@ -77,6 +80,9 @@ func mockDB() (sdk.Context, Keeper) {
 		distribution.UserGrowthPoolName: {supply.Burner, supply.Staking},
 		distribution.UserRewardPoolName: {supply.Burner},
 		staking.UserStakesPoolName:      {supply.Minter, supply.Burner},
+		claim.ClaimStakesPoolName:       {supply.Minter},
+		AppealStakesPoolName:            {supply.Minter},
+		community.TreasuryPoolName:      nil,
 	}
 
 	paramsKeeper := params.NewKeeper(codec, paramsKey, transientParamsKey, params.DefaultCodespace)
@@ -115,7 +121,8 @@ func mockDB() (sdk.Context, Keeper) {
 	communityKeeper := community.NewKeeper(
 		communityKey,
 		paramsKeeper.Subspace(community.ModuleName),
-		codec)
+		codec,
+		trubankKeeper)
 	_, _, cAdmin1, _ := getFakeAppAccountParams()
 	_, _, cAdmin2, _ := getFakeAppAccountParams()
 	cGenesis := community.DefaultGenesisState()
@@ -143,12 +150,22 @@ func mockDB() (sdk.Context, Keeper) {
 		panic(err)
 	}
 
+	searchKeeper := search.NewKeeper(
+		searchKey,
+		paramsKeeper.Subspace(search.DefaultParamspace),
+		codec,
+	)
+	search.InitGenesis(ctx, searchKeeper, search.DefaultGenesisState())
+
 	claimKeeper := claim.NewKeeper(
 		claimKey,
 		paramsKeeper.Subspace(claim.DefaultParamspace),
 		codec,
 		accountKeeper,
+		trubankKeeper,
 		communityKeeper,
+		searchKeeper,
+		supplyKeeper,
 	)
 	claim.InitGenesis(ctx, claimKeeper, claim.DefaultGenesisState())
 
@@ -163,18 +180,24 @@ func mockDB() (sdk.Context, Keeper) {
 		accountKeeper,
 		trubankKeeper,
 		claimKeeper,
+		communityKeeper,
+		searchKeeper,
 		supplyKeeper,
 		paramsKeeper.Subspace(staking.DefaultParamspace),
 		staking.DefaultCodespace,
 	)
-	staking.InitGenesis(ctx, stakingKeeper, staking.DefaultGenesisState())
+	stakingGenesis := staking.DefaultGenesisState()
+	// short bodies and summaries keep the tests readable
+	stakingGenesis.Params.ArgumentBodyMinLength = 1
+	stakingGenesis.Params.ArgumentSummaryMinLength = 1
+	staking.InitGenesis(ctx, stakingKeeper, stakingGenesis)
 
 	_, err = stakingKeeper.SubmitArgument(ctx, "argument", "summary", creator, claim1.ID, staking.StakeBacking)
 	if err != nil {
 		panic(err)
 	}
 
-	slashKeeper := NewKeeper(slashKey, paramsKeeper.Subspace(ModuleName), codec, trubankKeeper, stakingKeeper, accountKeeper, claimKeeper)
+	slashKeeper := NewKeeper(slashKey, paramsKeeper.Subspace(ModuleName), codec, trubankKeeper, stakingKeeper, accountKeeper, claimKeeper, communityKeeper, supplyKeeper)
 	// create fake admins
 	_, pubKey, addr1, coins := getFakeAppAccountParams()
 	accountKeeper.CreateAppAccount(ctx, addr1, coins, pubKey)
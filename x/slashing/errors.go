@@ -20,6 +20,11 @@ const (
 	ErrorCodeInvalidSlashReason   sdk.CodeType = 508
 	ErrorCodeAddressNotAuthorised sdk.CodeType = 509
 	ErrorCodeAlreadyUnhelpful     sdk.CodeType = 510
+	ErrorCodeNotJailed            sdk.CodeType = 511
+	ErrorCodeAppealNotFound       sdk.CodeType = 512
+	ErrorCodeAppealExists         sdk.CodeType = 513
+	ErrorCodeAppealReviewed       sdk.CodeType = 514
+	ErrorCodeInvalidAppealReason  sdk.CodeType = 515
 )
 
 // ErrSlashNotFound throws an error when the searched slash is not found
@@ -71,3 +76,28 @@ func ErrAddressNotAuthorised() sdk.Error {
 func ErrAlreadyUnhelpful() sdk.Error {
 	return sdk.NewError(DefaultCodespace, ErrorCodeAlreadyUnhelpful, "The argument is already slashed")
 }
+
+// ErrNotJailed throws an error when a user who is not jailed appeals
+func ErrNotJailed(address sdk.AccAddress) sdk.Error {
+	return sdk.NewError(DefaultCodespace, ErrorCodeNotJailed, fmt.Sprintf("User is not jailed: %s", address))
+}
+
+// ErrAppealNotFound throws an error when the searched appeal is not found
+func ErrAppealNotFound(id uint64) sdk.Error {
+	return sdk.NewError(DefaultCodespace, ErrorCodeAppealNotFound, fmt.Sprintf("Appeal not found with ID: %d", id))
+}
+
+// ErrAppealExists throws an error when the user already has a pending appeal
+func ErrAppealExists(address sdk.AccAddress) sdk.Error {
+	return sdk.NewError(DefaultCodespace, ErrorCodeAppealExists, fmt.Sprintf("User already has a pending appeal: %s", address))
+}
+
+// ErrAppealReviewed throws an error when the appeal was already approved or denied
+func ErrAppealReviewed(id uint64) sdk.Error {
+	return sdk.NewError(DefaultCodespace, ErrorCodeAppealReviewed, fmt.Sprintf("Appeal with ID: %d is already reviewed", id))
+}
+
+// ErrInvalidAppealReason throws an error when the appeal reason is not valid
+func ErrInvalidAppealReason(because string) sdk.Error {
+	return sdk.NewError(DefaultCodespace, ErrorCodeInvalidAppealReason, fmt.Sprintf("Appeal reason is not valid: %s", because))
+}
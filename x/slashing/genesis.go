@@ -8,14 +8,16 @@ import (
 
 // GenesisState defines genesis data for the module
 type GenesisState struct {
-	Slashes []Slash `json:"slashes"`
-	Params  Params  `json:"params"`
+	Slashes []Slash  `json:"slashes"`
+	Appeals []Appeal `json:"appeals"`
+	Params  Params   `json:"params"`
 }
 
 // NewGenesisState creates a new genesis state.
 func NewGenesisState() GenesisState {
 	return GenesisState{
 		Slashes: []Slash{},
+		Appeals: []Appeal{},
 		Params:  DefaultParams(),
 	}
 }
@@ -42,13 +44,42 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
 
 	}
 	keeper.setSlashID(ctx, uint64(len(data.Slashes)+1))
+	mintStakesPool := keeper.supplyKeeper.GetModuleAccount(ctx, AppealStakesPoolName).GetCoins().Empty()
+	for _, appeal := range data.Appeals {
+		// appeals exported before linked keys could pay the stake were paid by the creator
+		if appeal.Staker.Empty() {
+			appeal.Staker = appeal.Creator
+		}
+		keeper.setAppeal(ctx, appeal)
+		if appeal.Status == AppealPending {
+			keeper.setPendingAppeal(ctx, appeal)
+			// the stakes of pending appeals are held in the appeal stakes pool
+			if mintStakesPool && appeal.Stake.IsPositive() {
+				err := keeper.supplyKeeper.MintCoins(ctx, AppealStakesPoolName, sdk.NewCoins(appeal.Stake))
+				if err != nil {
+					panic(err)
+				}
+			}
+		}
+	}
+	keeper.setAppealID(ctx, uint64(len(data.Appeals)+1))
 	keeper.SetParams(ctx, data.Params)
+
+	// rebuild the slash counts, so genesis files without community stats still pass the invariants
+	// NOTE: this InitGenesis must run *after* staking InitGenesis
+	counts := keeper.communitySlashCounts(ctx)
+	for _, community := range keeper.communityKeeper.Communities(ctx) {
+		stats := keeper.communityKeeper.Stats(ctx, community.ID)
+		stats.SlashCount = counts[community.ID]
+		keeper.communityKeeper.SetStats(ctx, stats)
+	}
 }
 
 // ExportGenesis exports the genesis state
 func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
 	return GenesisState{
 		Slashes: keeper.Slashes(ctx),
+		Appeals: keeper.Appeals(ctx),
 		Params:  keeper.GetParams(ctx),
 	}
 }
@@ -75,5 +106,13 @@ func ValidateGenesis(data GenesisState) error {
 		return fmt.Errorf("Param: CuratorShare, cannot be a negative value")
 	}
 
+	if data.Params.AppealStake.IsNegative() {
+		return fmt.Errorf("Param: AppealStake, cannot be a negative value")
+	}
+
+	if data.Params.MaxAppealReasonLength < 1 {
+		return fmt.Errorf("Param: MaxAppealReasonLength, must have a positive value")
+	}
+
 	return nil
 }
@@ -19,6 +19,12 @@ func NewHandler(keeper Keeper) sdk.Handler {
 			return handleMsgRemoveAdmin(ctx, keeper, msg)
 		case MsgUpdateParams:
 			return handleMsgUpdateParams(ctx, keeper, msg)
+		case MsgAppealJail:
+			return handleMsgAppealJail(ctx, keeper, msg)
+		case MsgApproveAppeal:
+			return handleMsgApproveAppeal(ctx, keeper, msg)
+		case MsgDenyAppeal:
+			return handleMsgDenyAppeal(ctx, keeper, msg)
 		default:
 			errMsg := fmt.Sprintf("Unrecognized slashing message type: %T", msg)
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -129,3 +135,54 @@ func handleMsgUpdateParams(ctx sdk.Context, k Keeper, msg MsgUpdateParams) sdk.R
 		Data: res,
 	}
 }
+
+func handleMsgAppealJail(ctx sdk.Context, k Keeper, msg MsgAppealJail) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	appeal, err := k.AppealJail(ctx, msg.Creator, msg.Reason)
+	if err != nil {
+		return err.Result()
+	}
+
+	return appealResult(ctx, appeal)
+}
+
+func handleMsgApproveAppeal(ctx sdk.Context, k Keeper, msg MsgApproveAppeal) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	appeal, err := k.ApproveAppeal(ctx, msg.AppealID, msg.Reviewer, msg.Pardon)
+	if err != nil {
+		return err.Result()
+	}
+
+	return appealResult(ctx, appeal)
+}
+
+func handleMsgDenyAppeal(ctx sdk.Context, k Keeper, msg MsgDenyAppeal) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	appeal, err := k.DenyAppeal(ctx, msg.AppealID, msg.Reviewer)
+	if err != nil {
+		return err.Result()
+	}
+
+	return appealResult(ctx, appeal)
+}
+
+func appealResult(ctx sdk.Context, appeal Appeal) sdk.Result {
+	res, jsonErr := ModuleCodec.MarshalJSON(appeal)
+	if jsonErr != nil {
+		return sdk.ErrInternal(fmt.Sprintf("Marshal result error: %s", jsonErr)).Result()
+	}
+
+	return sdk.Result{
+		Data:   res,
+		Events: ctx.EventManager().Events(),
+	}
+}
@@ -0,0 +1,82 @@
+package slashing
+
+import (
+	"fmt"
+
+	"github.com/TruStory/truchain/x/account"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Hooks wrapper struct for the slashing keeper
+type Hooks struct {
+	k Keeper
+}
+
+var _ account.AccountHooks = Hooks{}
+
+// Hooks returns the account hooks implemented by the slashing keeper
+func (k Keeper) Hooks() Hooks { return Hooks{k} }
+
+// AfterAccountRecovered moves the slashes and the pending appeal of a recovered account to its new address
+func (h Hooks) AfterAccountRecovered(ctx sdk.Context, oldAddress, newAddress sdk.AccAddress) sdk.Error {
+	h.k.migrateCreator(ctx, oldAddress, newAddress)
+	return h.k.migratePendingAppeal(ctx, oldAddress, newAddress)
+}
+
+// AfterJailExpired closes the pending appeal of a user who served the jail time and refunds the appeal stake
+func (h Hooks) AfterJailExpired(ctx sdk.Context, address sdk.AccAddress) sdk.Error {
+	return h.k.expirePendingAppeal(ctx, address)
+}
+
+// migrateCreator changes the creator of every slash created by a user
+func (k Keeper) migrateCreator(ctx sdk.Context, oldAddress, newAddress sdk.AccAddress) {
+	store := k.store(ctx)
+	slashIDs := make([]uint64, 0)
+	iterator := sdk.KVStorePrefixIterator(store, creatorSlashesKey(oldAddress))
+	for ; iterator.Valid(); iterator.Next() {
+		var slashID uint64
+		k.codec.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &slashID)
+		slashIDs = append(slashIDs, slashID)
+	}
+	iterator.Close()
+
+	for _, slashID := range slashIDs {
+		slash, err := k.Slash(ctx, slashID)
+		if err != nil {
+			panic(err)
+		}
+		store.Delete(creatorSlashKey(oldAddress, slashID))
+		store.Delete(argumentSlasherSlashKey(slash.ArgumentID, oldAddress, slashID))
+		slash.Creator = newAddress
+		k.setSlash(ctx, slash)
+		k.setCreatorSlash(ctx, newAddress, slashID)
+		k.setArgumentSlasherSlash(ctx, slash.ArgumentID, slashID, newAddress)
+	}
+
+	k.Logger(ctx).Info(fmt.Sprintf("Moved %d slashes from %s to %s", len(slashIDs), oldAddress, newAddress))
+}
+
+// migratePendingAppeal changes the creator of the pending appeal of a user,
+// so the appeal stake is returned to the new address when it's approved.
+// Stakes paid by a linked key keep going back to it, as linked keys survive the recovery.
+func (k Keeper) migratePendingAppeal(ctx sdk.Context, oldAddress, newAddress sdk.AccAddress) sdk.Error {
+	appealID, ok := k.pendingAppealID(ctx, oldAddress)
+	if !ok {
+		return nil
+	}
+	appeal, err := k.Appeal(ctx, appealID)
+	if err != nil {
+		return err
+	}
+	k.deletePendingAppeal(ctx, appeal)
+	appeal.Creator = newAddress
+	if appeal.Staker.Equals(oldAddress) {
+		appeal.Staker = newAddress
+	}
+	k.setAppeal(ctx, appeal)
+	k.setPendingAppeal(ctx, appeal)
+
+	k.Logger(ctx).Info(fmt.Sprintf("Moved pending appeal %d from %s to %s", appealID, oldAddress, newAddress))
+
+	return nil
+}
@@ -0,0 +1,101 @@
+package slashing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/TruStory/truchain/x/account"
+	"github.com/TruStory/truchain/x/bank"
+	"github.com/TruStory/truchain/x/claim"
+	"github.com/TruStory/truchain/x/staking"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAfterAccountRecovered(t *testing.T) {
+	ctx, keeper := mockDB()
+	accountKeeper := keeper.accountKeeper
+	accountKeeper.SetHooks(account.NewMultiAccountHooks(
+		keeper.claimKeeper.Hooks(), keeper.stakingKeeper.Hooks(), keeper.Hooks(),
+	))
+	_, _, registrar, _ := getFakeAppAccountParams()
+	accountParams := accountKeeper.GetParams(ctx)
+	accountParams.Registrar = registrar
+	accountKeeper.SetParams(ctx, accountParams)
+
+	c := keeper.claimKeeper.Claims(ctx)[0]
+	oldAddress := c.Creator
+	// the claim creator is only rewarded for the stakes of other users
+	staker := keeper.GetParams(ctx).SlashAdmins[0]
+	_, err := keeper.stakingKeeper.SubmitArgument(ctx, "other argument", "other summary", staker, c.ID, staking.StakeChallenge)
+	assert.NoError(t, err)
+
+	_, newKey, newAddress := getFakeKeyPubAddr()
+	recovery, err := accountKeeper.RequestRecovery(ctx, oldAddress, newKey, registrar)
+	assert.NoError(t, err)
+	account.EndBlocker(ctx.WithBlockTime(recovery.ExecuteTime), accountKeeper)
+
+	c, _ = keeper.claimKeeper.Claim(ctx, c.ID)
+	assert.Equal(t, newAddress, c.Creator)
+	assert.Len(t, keeper.claimKeeper.CreatorClaims(ctx, oldAddress), 0)
+	assert.Len(t, keeper.claimKeeper.CreatorClaims(ctx, newAddress), 1)
+
+	// the claim creator reward and the creation stake refund go to the new address
+	stake := keeper.stakingKeeper.UserStakes(ctx, staker)[0]
+	blockTime := stake.EndTime
+	if c.ClosingTime.After(blockTime) {
+		blockTime = c.ClosingTime
+	}
+	ctx = ctx.WithBlockTime(blockTime.Add(time.Second))
+	staking.EndBlocker(ctx, keeper.stakingKeeper)
+	claim.EndBlocker(ctx, keeper.claimKeeper)
+
+	paid := make(map[bank.TransactionType]sdk.AccAddress)
+	for _, tx := range keeper.bankKeeper.Transactions(ctx) {
+		if tx.Type == staking.TransactionInterestClaimCreation || tx.Type == claim.TransactionClaimCreationReturned {
+			paid[tx.Type] = tx.AppAccountAddress
+		}
+	}
+	assert.Equal(t, newAddress, paid[staking.TransactionInterestClaimCreation])
+	assert.Equal(t, newAddress, paid[claim.TransactionClaimCreationReturned])
+}
+
+func TestAfterAccountRecovered_Slashes(t *testing.T) {
+	ctx, keeper := mockDB()
+	admin := keeper.GetParams(ctx).SlashAdmins[0]
+	slash, _, err := keeper.CreateSlash(ctx, 1, SlashTypeUnhelpful, SlashReasonLogicOrEvidenceAbsent, "", admin)
+	assert.NoError(t, err)
+
+	_, _, newAddress := getFakeKeyPubAddr()
+	err = keeper.Hooks().AfterAccountRecovered(ctx, admin, newAddress)
+	assert.NoError(t, err)
+
+	slash, err = keeper.Slash(ctx, slash.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, newAddress, slash.Creator)
+	assert.True(t, keeper.hasPreviouslySlashed(ctx, 1, newAddress))
+	assert.True(t, keeper.store(ctx).Has(creatorSlashKey(newAddress, slash.ID)))
+	assert.False(t, keeper.store(ctx).Has(creatorSlashKey(admin, slash.ID)))
+	assert.True(t, keeper.store(ctx).Has(argumentSlasherSlashKey(1, newAddress, slash.ID)))
+}
+
+func TestAfterAccountRecovered_PendingAppeal(t *testing.T) {
+	ctx, keeper := mockDB()
+	user := jailedUser(ctx, keeper)
+	appeal, err := keeper.AppealJail(ctx, user, "I was wrongly jailed")
+	assert.NoError(t, err)
+
+	_, _, newAddress := getFakeKeyPubAddr()
+	err = keeper.Hooks().AfterAccountRecovered(ctx, user, newAddress)
+	assert.NoError(t, err)
+
+	appeal, err = keeper.Appeal(ctx, appeal.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, newAddress, appeal.Creator)
+	_, ok := keeper.pendingAppealID(ctx, user)
+	assert.False(t, ok)
+	appealID, ok := keeper.pendingAppealID(ctx, newAddress)
+	assert.True(t, ok)
+	assert.Equal(t, appeal.ID, appealID)
+	assert.Len(t, keeper.PendingAppeals(ctx), 1)
+}
@@ -0,0 +1,46 @@
+package slashing
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterInvariants registers the slashing module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(ModuleName, "community-stats", CommunityStatsInvariant(k))
+}
+
+// CommunityStatsInvariant checks the slash counts of the community stats by recounting slashes
+func CommunityStatsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		counts := k.communitySlashCounts(ctx)
+
+		var msg string
+		broken := false
+		for _, community := range k.communityKeeper.Communities(ctx) {
+			stats := k.communityKeeper.Stats(ctx, community.ID)
+			if stats.SlashCount != counts[community.ID] {
+				broken = true
+				msg += fmt.Sprintf("\tcommunity %s has %d slashes, stats count %d\n",
+					community.ID, counts[community.ID], stats.SlashCount)
+			}
+		}
+
+		return sdk.FormatInvariant(ModuleName, "community stats", msg), broken
+	}
+}
+
+// communitySlashCounts recounts the slashes of every community
+func (k Keeper) communitySlashCounts(ctx sdk.Context) map[string]uint64 {
+	counts := make(map[string]uint64)
+	for _, slash := range k.Slashes(ctx) {
+		argument, ok := k.stakingKeeper.Argument(ctx, slash.ArgumentID)
+		if !ok {
+			continue
+		}
+		counts[argument.CommunityID]++
+	}
+
+	return counts
+}
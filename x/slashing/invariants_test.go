@@ -0,0 +1,31 @@
+package slashing
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCommunityStatsInvariant(t *testing.T) {
+	ctx, keeper := mockDB()
+
+	creator := keeper.GetParams(ctx).SlashAdmins[0]
+	_, _, err := keeper.CreateSlash(ctx, 1, SlashTypeUnhelpful, SlashReasonPlagiarism, "", creator)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), keeper.communityKeeper.Stats(ctx, "furry").SlashCount)
+
+	invariant := CommunityStatsInvariant(keeper)
+	_, broken := invariant(ctx)
+	assert.False(t, broken)
+
+	stats := keeper.communityKeeper.Stats(ctx, "furry")
+	stats.SlashCount = 0
+	keeper.communityKeeper.SetStats(ctx, stats)
+	_, broken = invariant(ctx)
+	assert.True(t, broken)
+
+	// InitGenesis rebuilds the stats, e.g. for genesis files exported without them
+	InitGenesis(ctx, keeper, ExportGenesis(ctx, keeper))
+	_, broken = invariant(ctx)
+	assert.False(t, broken)
+}
@@ -7,11 +7,13 @@ import (
 	"github.com/TruStory/truchain/x/account"
 	"github.com/TruStory/truchain/x/bank"
 	"github.com/TruStory/truchain/x/claim"
+	"github.com/TruStory/truchain/x/community"
 	"github.com/TruStory/truchain/x/staking"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/gaskv"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/supply"
 	log "github.com/tendermint/tendermint/libs/log"
 )
 
@@ -21,16 +23,19 @@ type Keeper struct {
 	codec      *codec.Codec
 	paramStore params.Subspace
 
-	bankKeeper    bank.Keeper
-	stakingKeeper staking.Keeper
-	accountKeeper account.Keeper
-	claimKeeper   claim.Keeper
+	bankKeeper      bank.Keeper
+	stakingKeeper   staking.Keeper
+	accountKeeper   account.Keeper
+	claimKeeper     claim.Keeper
+	communityKeeper community.Keeper
+	supplyKeeper    supply.Keeper
 }
 
 // NewKeeper creates a new keeper of the slashing Keeper
 func NewKeeper(
 	storeKey sdk.StoreKey, paramStore params.Subspace, codec *codec.Codec,
 	bankKeeper bank.Keeper, stakingKeeper staking.Keeper, accountKeeper account.Keeper, claimKeeper claim.Keeper,
+	communityKeeper community.Keeper, supplyKeeper supply.Keeper,
 ) Keeper {
 	return Keeper{
 		storeKey,
@@ -40,6 +45,8 @@ func NewKeeper(
 		stakingKeeper,
 		accountKeeper,
 		claimKeeper,
+		communityKeeper,
+		supplyKeeper,
 	}
 }
 
@@ -88,8 +95,13 @@ func (k Keeper) CreateSlash(ctx sdk.Context,
 		return slash, results, err
 	}
 
+	argument, _ := k.stakingKeeper.Argument(ctx, argumentID)
+	stats := k.communityKeeper.Stats(ctx, argument.CommunityID)
+	stats.SlashCount++
+	k.communityKeeper.SetStats(ctx, stats)
+
 	slashCount := k.getSlashCount(ctx, argumentID)
-	if slashCount >= k.GetParams(ctx).MinSlashCount || k.isAdmin(ctx, creator) {
+	if slashCount >= k.GetParams(ctx).MinSlashCount || k.isModerator(ctx, creator, argument.CommunityID) {
 		err = k.stakingKeeper.MarkUnhelpfulArgument(ctx, argumentID)
 		if err != nil {
 			return slash, results, err
@@ -180,6 +192,15 @@ func (k Keeper) punish(ctx sdk.Context, argumentID uint64) ([]PunishmentResult,
 		if err != nil {
 			return punishmentResults, err
 		}
+		// part of the slashed stake funds the treasury of the community
+		treasuryCoin := k.communityKeeper.TreasuryShare(ctx, amount)
+		if treasuryCoin.IsPositive() {
+			err = k.communityKeeper.DepositToTreasury(ctx, communityID, treasuryCoin,
+				stake.Creator, stake.ID, staking.UserRewardPoolName)
+			if err != nil {
+				return punishmentResults, err
+			}
+		}
 
 		argument, ok := k.stakingKeeper.Argument(ctx, argumentID)
 		if !ok {
@@ -479,10 +500,10 @@ func (k Keeper) validateParams(ctx sdk.Context, argumentID uint64, detailedReaso
 	}
 
 	// validating creator
-	isAdmin := k.isAdmin(ctx, creator)
+	isModerator := k.isModerator(ctx, creator, a.CommunityID)
 	hasEnoughCoins := k.hasEnoughEarnedStake(ctx, creator, params.SlashMinStake)
 
-	if !isAdmin && !hasEnoughCoins {
+	if !isModerator && !hasEnoughCoins {
 		return ErrNotEnoughEarnedStake(creator)
 	}
 
@@ -506,6 +527,11 @@ func (k Keeper) hasPreviouslySlashed(ctx sdk.Context, argumentID uint64, creator
 	return false
 }
 
+// isModerator returns true for slash admins and the moderators of the community
+func (k Keeper) isModerator(ctx sdk.Context, address sdk.AccAddress, communityID string) bool {
+	return k.isAdmin(ctx, address) || k.communityKeeper.IsModerator(ctx, communityID, address)
+}
+
 func (k Keeper) isAdmin(ctx sdk.Context, address sdk.AccAddress) bool {
 	for _, admin := range k.GetParams(ctx).SlashAdmins {
 		if address.Equals(admin) {
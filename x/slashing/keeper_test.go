@@ -3,6 +3,7 @@ package slashing
 import (
 	"testing"
 
+	"github.com/TruStory/truchain/x/community"
 	"github.com/TruStory/truchain/x/staking"
 
 	app "github.com/TruStory/truchain/types"
@@ -72,6 +73,29 @@ func TestNewSlash_ErrAlreadyUnhelpful(t *testing.T) {
 	assert.Equal(t, ErrAlreadyUnhelpful().Code(), err.Code())
 }
 
+func TestNewSlash_CommunityModerator(t *testing.T) {
+	ctx, keeper := mockDB()
+
+	argumentID := uint64(1)
+	_, _, moderator, _ := getFakeAppAccountParams()
+	communityAdmin := keeper.communityKeeper.GetParams(ctx).CommunityAdmins[0]
+	err := keeper.communityKeeper.AddModerator(ctx, "crypto", moderator, communityAdmin)
+	assert.NoError(t, err)
+
+	// moderators of other communities need earned stake like everyone else
+	_, _, err = keeper.CreateSlash(ctx, argumentID, SlashTypeUnhelpful, SlashReasonPlagiarism, "", moderator)
+	assert.Equal(t, ErrorCodeNotEnoughEarnedStake, err.Code())
+
+	err = keeper.communityKeeper.AddModerator(ctx, "furry", moderator, communityAdmin)
+	assert.NoError(t, err)
+	_, _, err = keeper.CreateSlash(ctx, argumentID, SlashTypeUnhelpful, SlashReasonPlagiarism, "", moderator)
+	assert.NoError(t, err)
+
+	// a moderator slash marks the argument unhelpful right away
+	argument, _ := keeper.stakingKeeper.Argument(ctx, argumentID)
+	assert.True(t, argument.IsUnhelpful)
+}
+
 func TestSlash_Success(t *testing.T) {
 	ctx, keeper := mockDB()
 
@@ -109,6 +133,7 @@ func TestSlashes_Success(t *testing.T) {
 		staking.UserEarnedCoins{Address: addr2, Coins: earned},
 	}
 	genesis := staking.DefaultGenesisState()
+	genesis.Params = keeper.stakingKeeper.GetParams(ctx)
 	genesis.UsersEarnings = usersEarnings
 	staking.InitGenesis(ctx, keeper.stakingKeeper, genesis)
 
@@ -184,6 +209,14 @@ func Test_punishment(t *testing.T) {
 
 	claim, _ = keeper.claimKeeper.Claim(ctx, 1)
 	assert.Equal(t, "0utru", claim.TotalChallenged.String())
+
+	// community treasury should have = slash penalty * treasury share
+	treasuryShare := keeper.communityKeeper.GetParams(ctx).TreasuryShare
+	treasuryAmount := slashPenalty.Amount.ToDec().Mul(treasuryShare).TruncateInt()
+	treasury := keeper.communityKeeper.Treasury(ctx, claim.CommunityID)
+	assert.Equal(t, treasuryAmount, treasury.Balance.Amount)
+	_, broken := community.TreasuryInvariant(keeper.communityKeeper)(ctx)
+	assert.False(t, broken)
 }
 
 func TestAddAdmin_Success(t *testing.T) {
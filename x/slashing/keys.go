@@ -12,18 +12,26 @@ import (
 // - 0x00<slashID>: Slash{}
 // - 0x01: nextSlashID
 // - 0x02<argumentID>: slashCount
+// - 0x03<appealID>: Appeal{}
+// - 0x04: nextAppealID
 //
 // - 0x10<creator><slashID>: slashID
 // - 0x11<argumentID><slashID>: slashID
 // - 0x12<argumentID><slashCreator><slashID>: slashID
+// - 0x13<appealID>: appealID
+// - 0x14<creator>: appealID
 var (
 	SlashesKeyPrefix = []byte{0x00}
 	SlashIDKey       = []byte{0x01}
 	SlashCountPrefix = []byte{0x02}
+	AppealsKeyPrefix = []byte{0x03}
+	AppealIDKey      = []byte{0x04}
 
-	CreatorSlashesPrefix  = []byte{0x10}
-	ArgumentSlashesPrefix = []byte{0x11}
-	ArgumentCreatorPrefix = []byte{0x12}
+	CreatorSlashesPrefix       = []byte{0x10}
+	ArgumentSlashesPrefix      = []byte{0x11}
+	ArgumentCreatorPrefix      = []byte{0x12}
+	PendingAppealsPrefix       = []byte{0x13}
+	CreatorPendingAppealPrefix = []byte{0x14}
 )
 
 // key for getting a specific slash from the store
@@ -67,3 +75,18 @@ func argumentSlasherPrefix(argumentID uint64, slasher sdk.AccAddress) []byte {
 func argumentSlasherSlashKey(argumentID uint64, slasher sdk.AccAddress, slashID uint64) []byte {
 	return append(argumentSlasherPrefix(argumentID, slasher), sdk.Uint64ToBigEndian(slashID)...)
 }
+
+// appealKey gets the key of a specific appeal
+func appealKey(appealID uint64) []byte {
+	return append(AppealsKeyPrefix, sdk.Uint64ToBigEndian(appealID)...)
+}
+
+// pendingAppealKey gets the key of an appeal in the queue of pending appeals
+func pendingAppealKey(appealID uint64) []byte {
+	return append(PendingAppealsPrefix, sdk.Uint64ToBigEndian(appealID)...)
+}
+
+// creatorPendingAppealKey gets the key of the pending appeal of a creator
+func creatorPendingAppealKey(creator sdk.AccAddress) []byte {
+	return append(CreatorPendingAppealPrefix, creator.Bytes()...)
+}
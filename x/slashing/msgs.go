@@ -15,6 +15,12 @@ const (
 	TypeMsgRemoveAdmin = "remove_admin"
 	// TypeMsgUpdateParams represents the type of
 	TypeMsgUpdateParams = "update_params"
+	// TypeMsgAppealJail represents the type of message for appealing a jailing
+	TypeMsgAppealJail = "appeal_jail"
+	// TypeMsgApproveAppeal represents the type of message for approving a jail appeal
+	TypeMsgApproveAppeal = "approve_appeal"
+	// TypeMsgDenyAppeal represents the type of message for denying a jail appeal
+	TypeMsgDenyAppeal = "deny_appeal"
 )
 
 // MsgSlashArgument defines the message to slash an argument
@@ -192,3 +198,132 @@ func (msg MsgUpdateParams) GetSignBytes() []byte {
 func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sdk.AccAddress(msg.Updater)}
 }
+
+// MsgAppealJail defines the message to appeal the jailing of the creator
+type MsgAppealJail struct {
+	Reason  string         `json:"reason"`
+	Creator sdk.AccAddress `json:"creator"`
+}
+
+// NewMsgAppealJail returns the message to appeal a jailing
+func NewMsgAppealJail(reason string, creator sdk.AccAddress) MsgAppealJail {
+	return MsgAppealJail{
+		Reason:  reason,
+		Creator: creator,
+	}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgAppealJail) ValidateBasic() sdk.Error {
+	if len(msg.Creator) == 0 {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Invalid address: %s", msg.Creator.String()))
+	}
+
+	if len(msg.Reason) == 0 {
+		return ErrInvalidAppealReason("Reason can't be empty.")
+	}
+
+	return nil
+}
+
+// Route implements Msg
+func (msg MsgAppealJail) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgAppealJail) Type() string { return TypeMsgAppealJail }
+
+// GetSignBytes implements Msg
+func (msg MsgAppealJail) GetSignBytes() []byte {
+	msgBytes := ModuleCodec.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(msgBytes)
+}
+
+// GetSigners implements Msg. Returns the creator as the signer.
+func (msg MsgAppealJail) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Creator)}
+}
+
+// MsgApproveAppeal defines the message to approve a jail appeal.
+// The slash count of a user is reset when they're jailed, so there's no slash count
+// increment left to reverse. Instead, Pardon drops the appealed jailing from the
+// jail history of the user, so it doesn't make their next jailings longer.
+type MsgApproveAppeal struct {
+	AppealID uint64         `json:"appeal_id"`
+	Pardon   bool           `json:"pardon"`
+	Reviewer sdk.AccAddress `json:"reviewer"`
+}
+
+// NewMsgApproveAppeal returns the message to approve a jail appeal
+func NewMsgApproveAppeal(appealID uint64, pardon bool, reviewer sdk.AccAddress) MsgApproveAppeal {
+	return MsgApproveAppeal{
+		AppealID: appealID,
+		Pardon:   pardon,
+		Reviewer: reviewer,
+	}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgApproveAppeal) ValidateBasic() sdk.Error {
+	if len(msg.Reviewer) == 0 {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Invalid address: %s", msg.Reviewer.String()))
+	}
+
+	return nil
+}
+
+// Route implements Msg
+func (msg MsgApproveAppeal) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgApproveAppeal) Type() string { return TypeMsgApproveAppeal }
+
+// GetSignBytes implements Msg
+func (msg MsgApproveAppeal) GetSignBytes() []byte {
+	msgBytes := ModuleCodec.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(msgBytes)
+}
+
+// GetSigners implements Msg. Returns the reviewer as the signer.
+func (msg MsgApproveAppeal) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Reviewer)}
+}
+
+// MsgDenyAppeal defines the message to deny a jail appeal
+type MsgDenyAppeal struct {
+	AppealID uint64         `json:"appeal_id"`
+	Reviewer sdk.AccAddress `json:"reviewer"`
+}
+
+// NewMsgDenyAppeal returns the message to deny a jail appeal
+func NewMsgDenyAppeal(appealID uint64, reviewer sdk.AccAddress) MsgDenyAppeal {
+	return MsgDenyAppeal{
+		AppealID: appealID,
+		Reviewer: reviewer,
+	}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgDenyAppeal) ValidateBasic() sdk.Error {
+	if len(msg.Reviewer) == 0 {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Invalid address: %s", msg.Reviewer.String()))
+	}
+
+	return nil
+}
+
+// Route implements Msg
+func (msg MsgDenyAppeal) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgDenyAppeal) Type() string { return TypeMsgDenyAppeal }
+
+// GetSignBytes implements Msg
+func (msg MsgDenyAppeal) GetSignBytes() []byte {
+	msgBytes := ModuleCodec.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(msgBytes)
+}
+
+// GetSigners implements Msg. Returns the reviewer as the signer.
+func (msg MsgDenyAppeal) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Reviewer)}
+}
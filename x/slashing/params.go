@@ -17,6 +17,8 @@ var (
 	KeySlashAdmins             = []byte("slashAdmins")
 	KeyCuratorShare            = []byte("curatorShare")
 	KeyMaxDetailedReasonLength = []byte("maxDetailedReasonLength")
+	KeyAppealStake             = []byte("appealStake")
+	KeyMaxAppealReasonLength   = []byte("maxAppealReasonLength")
 )
 
 // Params holds parameters for Slashing
@@ -27,6 +29,8 @@ type Params struct {
 	SlashAdmins             []sdk.AccAddress `json:"slash_admins"`
 	CuratorShare            sdk.Dec          `json:"curator_share"`
 	MaxDetailedReasonLength int              `json:"max_detailed_reason_length"`
+	AppealStake             sdk.Coin         `json:"appeal_stake"`
+	MaxAppealReasonLength   int              `json:"max_appeal_reason_length"`
 }
 
 // DefaultParams is the Slashing params for testing
@@ -39,6 +43,8 @@ func DefaultParams() Params {
 		SlashAdmins:             []sdk.AccAddress{},
 		CuratorShare:            sdk.NewDecWithPrec(25, 2),
 		MaxDetailedReasonLength: 140,
+		AppealStake:             sdk.NewCoin(app.StakeDenom, sdk.NewInt(10*app.Shanev)),
+		MaxAppealReasonLength:   1000,
 	}
 }
 
@@ -51,6 +57,8 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		{Key: KeySlashAdmins, Value: &p.SlashAdmins},
 		{Key: KeyCuratorShare, Value: &p.CuratorShare},
 		{Key: KeyMaxDetailedReasonLength, Value: &p.MaxDetailedReasonLength},
+		{Key: KeyAppealStake, Value: &p.AppealStake},
+		{Key: KeyMaxAppealReasonLength, Value: &p.MaxAppealReasonLength},
 	}
 }
 
@@ -14,6 +14,8 @@ const (
 	QueryArgumentSlashes        = "argument_slashes"
 	QueryArgumentSlasherSlashes = "argument_slasher_slashes"
 	QueryParams                 = "params"
+	QueryAppeal                 = "appeal"
+	QueryPendingAppeals         = "pending_appeals"
 )
 
 // QuerySlashParams are params for querying slashes by id queries
@@ -32,6 +34,11 @@ type QueryArgumentSlasherSlashesParams struct {
 	Slasher    sdk.AccAddress `json:"slasher"`
 }
 
+// QueryAppealParams are params for querying appeals by id
+type QueryAppealParams struct {
+	ID uint64 `json:"id"`
+}
+
 // NewQuerier creates a new querier
 func NewQuerier(keeper Keeper) sdk.Querier {
 	return func(ctx sdk.Context, path []string, request abci.RequestQuery) ([]byte, sdk.Error) {
@@ -46,6 +53,10 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryArgumentSlasherSlashes(ctx, request, keeper)
 		case QueryParams:
 			return queryParams(ctx, keeper)
+		case QueryAppeal:
+			return queryAppeal(ctx, request, keeper)
+		case QueryPendingAppeals:
+			return queryPendingAppeals(ctx, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest(fmt.Sprintf("Unknown truchain query endpoint: slashing/%s", path[0]))
 		}
@@ -106,6 +117,32 @@ func queryArgumentSlasherSlashes(ctx sdk.Context, request abci.RequestQuery, k K
 	return bz, nil
 }
 
+func queryAppeal(ctx sdk.Context, request abci.RequestQuery, k Keeper) (result []byte, err sdk.Error) {
+	params := QueryAppealParams{}
+	if err = unmarshalQueryParams(request, &params); err != nil {
+		return
+	}
+
+	appeal, err := k.Appeal(ctx, params.ID)
+	if err != nil {
+		return
+	}
+	bz, jsonErr := k.codec.MarshalJSON(appeal)
+	if jsonErr != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", jsonErr.Error()))
+	}
+	return bz, nil
+}
+
+func queryPendingAppeals(ctx sdk.Context, k Keeper) (result []byte, err sdk.Error) {
+	appeals := k.PendingAppeals(ctx)
+	bz, jsonErr := k.codec.MarshalJSON(appeals)
+	if jsonErr != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", jsonErr.Error()))
+	}
+	return bz, nil
+}
+
 func queryParams(ctx sdk.Context, keeper Keeper) (result []byte, err sdk.Error) {
 	params := keeper.GetParams(ctx)
 
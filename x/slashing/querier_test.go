@@ -65,6 +65,7 @@ func TestQuerySlashes_Success(t *testing.T) {
 		staking.UserEarnedCoins{Address: addr2, Coins: earned},
 	}
 	genesis := staking.DefaultGenesisState()
+	genesis.Params = keeper.stakingKeeper.GetParams(ctx)
 	genesis.UsersEarnings = usersEarnings
 	staking.InitGenesis(ctx, keeper.stakingKeeper, genesis)
 
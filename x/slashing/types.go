@@ -14,8 +14,18 @@ const (
 	QuerierRoute      = ModuleName
 	DefaultParamspace = ModuleName
 
+	// AppealStakesPoolName holds the stakes of pending jail appeals
+	AppealStakesPoolName = "appeal_stakes_tokens_pool"
+
 	AttributeKeyMinSlashCountKey = "min-slash-count"
 	AttributeKeySlashResults     = "slash-results"
+
+	EventTypeJailAppealFiled    = "jail_appeal_filed"
+	EventTypeJailAppealApproved = "jail_appeal_approved"
+	EventTypeJailAppealDenied   = "jail_appeal_denied"
+	EventTypeJailAppealExpired  = "jail_appeal_expired"
+	AttributeKeyAppealID        = "appeal-id"
+	AttributeKeyUser            = "user"
 )
 
 // Slash stores data about a slashing
@@ -107,3 +117,58 @@ var SlashReasonName = []string{
 	SlashReasonSpam:                  "Spam",
 	SlashReasonOffensiveContent:      "Offensive Content",
 }
+
+// AppealStatus is the review status of a jail appeal
+type AppealStatus int
+
+const (
+	// AppealPending represents an appeal waiting for an admin review
+	AppealPending AppealStatus = iota
+	// AppealApproved represents an appeal that released the user from jail
+	AppealApproved
+	// AppealDenied represents an appeal that forfeited the appeal stake
+	AppealDenied
+	// AppealExpired represents an appeal closed because the user served the jail time before a review
+	AppealExpired
+)
+
+func (s AppealStatus) String() string {
+	if int(s) >= len(AppealStatusName) {
+		return "Unknown"
+	}
+	return AppealStatusName[s]
+}
+
+// AppealStatusName is the name of the appeal status
+var AppealStatusName = []string{
+	AppealPending:  "Pending",
+	AppealApproved: "Approved",
+	AppealDenied:   "Denied",
+	AppealExpired:  "Expired",
+}
+
+// Appeal stores a request from a jailed user to be released, reviewed by the slash admins.
+// The Staker is the key that paid the appeal stake, the primary address or a linked key of the Creator.
+type Appeal struct {
+	ID           uint64         `json:"id"`
+	Creator      sdk.AccAddress `json:"creator"`
+	Staker       sdk.AccAddress `json:"staker"`
+	Reason       string         `json:"reason"`
+	Stake        sdk.Coin       `json:"stake"`
+	Status       AppealStatus   `json:"status"`
+	Reviewer     sdk.AccAddress `json:"reviewer"`
+	CreatedTime  time.Time      `json:"created_time"`
+	ReviewedTime time.Time      `json:"reviewed_time"`
+}
+
+// Appeals is an array of appeals
+type Appeals []Appeal
+
+func (a Appeal) String() string {
+	return fmt.Sprintf(`Appeal %d:
+  Creator: %s
+  Stake: %s
+  Status: %s
+  CreatedTime: %s`,
+		a.ID, a.Creator.String(), a.Stake.String(), a.Status.String(), a.CreatedTime.String())
+}
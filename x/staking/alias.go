@@ -16,8 +16,11 @@ const (
 	TransactionBackingReturned          = exported.TransactionBackingReturned
 	TransactionChallengeReturned        = exported.TransactionChallengeReturned
 	TransactionUpvoteReturned           = exported.TransactionUpvoteReturned
+	TransactionVerdictBonus             = exported.TransactionVerdictBonus
+	TransactionInterestClaimCreation    = exported.TransactionInterestClaimCreation
 
 	UserRewardPoolName = distribution.UserRewardPoolName
+	UserStakesPoolName = distribution.UserStakesPoolName
 )
 
 type (
@@ -7,6 +7,7 @@ func RegisterCodec(c *codec.Codec) {
 	c.RegisterConcrete(MsgSubmitArgument{}, "truchain/MsgSubmitArgument", nil)
 	c.RegisterConcrete(MsgSubmitUpvote{}, "truchain/MsgUpvoteArgument", nil)
 	c.RegisterConcrete(MsgEditArgument{}, "truchain/MsgEditArgument", nil)
+	c.RegisterConcrete(MsgEditArgumentEvidence{}, "staking/MsgEditArgumentEvidence", nil)
 	c.RegisterConcrete(MsgAddAdmin{}, "staking/MsgAddAdmin", nil)
 	c.RegisterConcrete(MsgRemoveAdmin{}, "staking/MsgRemoveAdmin", nil)
 	c.RegisterConcrete(MsgUpdateParams{}, "staking/MsgUpdateParams", nil)
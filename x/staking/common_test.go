@@ -8,6 +8,7 @@ import (
 	app "github.com/TruStory/truchain/types"
 	trubank "github.com/TruStory/truchain/x/bank"
 	"github.com/TruStory/truchain/x/claim"
+	"github.com/TruStory/truchain/x/community"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -25,15 +26,21 @@ import (
 
 type mockedAccountKeeper struct {
 	jailStatus   map[string]bool
+	linked       map[string]sdk.AccAddress
 	forceFailure bool
 }
 
 func newAccountKeeper() *mockedAccountKeeper {
 	return &mockedAccountKeeper{
 		jailStatus: make(map[string]bool),
+		linked:     make(map[string]sdk.AccAddress),
 	}
 }
 
+func (m *mockedAccountKeeper) link(primary, address sdk.AccAddress) {
+	m.linked[address.String()] = primary
+}
+
 func (m *mockedAccountKeeper) jail(address sdk.AccAddress) {
 	m.jailStatus[address.String()] = true
 }
@@ -66,6 +73,26 @@ func (m *mockedAccountKeeper) IterateAppAccounts(ctx sdk.Context, cb func(acc ac
 
 }
 
+func (m *mockedAccountKeeper) PrimaryAddress(ctx sdk.Context, address sdk.AccAddress) sdk.AccAddress {
+	primary, ok := m.linked[address.String()]
+	if !ok {
+		return address
+	}
+	return primary
+}
+
+func (m *mockedAccountKeeper) Addresses(ctx sdk.Context, address sdk.AccAddress) []sdk.AccAddress {
+	primary := m.PrimaryAddress(ctx, address)
+	addresses := []sdk.AccAddress{primary}
+	for linked, p := range m.linked {
+		if p.Equals(primary) {
+			addr, _ := sdk.AccAddressFromBech32(linked)
+			addresses = append(addresses, addr)
+		}
+	}
+	return addresses
+}
+
 type mockClaimKeeper struct {
 	claims           map[uint64]claim.Claim
 	enableTrackStake bool
@@ -155,12 +182,84 @@ func (m *mockClaimKeeper) SubtractChallengeStake(ctx sdk.Context, id uint64, sta
 	return nil
 }
 
+type mockSearchKeeper struct {
+	indexed map[uint64]string
+}
+
+func newMockedSearchKeeper() *mockSearchKeeper {
+	return &mockSearchKeeper{
+		indexed: make(map[uint64]string),
+	}
+}
+
+func (m *mockSearchKeeper) IndexArgument(ctx sdk.Context, id uint64, summary string) {
+	m.indexed[id] = summary
+}
+
+func (m *mockSearchKeeper) RemoveArgument(ctx sdk.Context, id uint64) {
+	delete(m.indexed, id)
+}
+
+type mockCommunityKeeper struct {
+	moderators  map[string]bool
+	descendants map[string][]string
+	stats       map[string]community.Stats
+	overrides   map[string]community.ParamOverrides
+}
+
+func newMockedCommunityKeeper() *mockCommunityKeeper {
+	return &mockCommunityKeeper{
+		moderators:  make(map[string]bool),
+		descendants: make(map[string][]string),
+		stats:       make(map[string]community.Stats),
+		overrides:   make(map[string]community.ParamOverrides),
+	}
+}
+
+func (m *mockCommunityKeeper) EffectiveOverrides(ctx sdk.Context, communityID string) community.ParamOverrides {
+	return m.overrides[communityID]
+}
+
+func (m *mockCommunityKeeper) Communities(ctx sdk.Context) []community.Community {
+	communities := make([]community.Community, 0)
+	for id := range m.stats {
+		communities = append(communities, community.Community{ID: id})
+	}
+	return communities
+}
+
+func (m *mockCommunityKeeper) Stats(ctx sdk.Context, communityID string) community.Stats {
+	stats, ok := m.stats[communityID]
+	if !ok {
+		return community.NewStats(communityID)
+	}
+	return stats
+}
+
+func (m *mockCommunityKeeper) SetStats(ctx sdk.Context, stats community.Stats) {
+	m.stats[stats.CommunityID] = stats
+}
+
+func (m *mockCommunityKeeper) Descendants(ctx sdk.Context, communityID string) []string {
+	return m.descendants[communityID]
+}
+
+func (m *mockCommunityKeeper) IsModerator(ctx sdk.Context, communityID string, address sdk.AccAddress) bool {
+	return m.moderators[communityID+address.String()]
+}
+
+func (m *mockCommunityKeeper) addModerator(communityID string, address sdk.AccAddress) {
+	m.moderators[communityID+address.String()] = true
+}
+
 type mockedDB struct {
-	authAccKeeper auth.AccountKeeper
-	accountKeeper AccountKeeper
-	claimKeeper   ClaimKeeper
-	bankKeeper    BankKeeper
-	supplyKeeper  supply.Keeper
+	authAccKeeper   auth.AccountKeeper
+	accountKeeper   AccountKeeper
+	claimKeeper     ClaimKeeper
+	communityKeeper *mockCommunityKeeper
+	searchKeeper    *mockSearchKeeper
+	bankKeeper      BankKeeper
+	supplyKeeper    supply.Keeper
 }
 
 func mockDB() (sdk.Context, Keeper, *mockedDB) {
@@ -237,20 +336,28 @@ func mockDB() (sdk.Context, Keeper, *mockedDB) {
 	mockedAccountKeeper := newAccountKeeper()
 	mockedClaimKeeper := newMockedClaimKeeper()
 	mockedClaimKeeper.claims = make(map[uint64]claim.Claim)
-	keeper := NewKeeper(cdc, storeKey, mockedAccountKeeper, trubankKeeper, mockedClaimKeeper, supplyKeeper, pk.Subspace(DefaultParamspace), DefaultCodespace)
+	mockedCommunityKeeper := newMockedCommunityKeeper()
+	mockedSearchKeeper := newMockedSearchKeeper()
+	keeper := NewKeeper(cdc, storeKey, mockedAccountKeeper, trubankKeeper, mockedClaimKeeper, mockedCommunityKeeper,
+		mockedSearchKeeper, supplyKeeper, pk.Subspace(DefaultParamspace), DefaultCodespace)
 	_, _, admin1 := keyPubAddr()
 	_, _, admin2 := keyPubAddr()
 	genesis := DefaultGenesisState()
 	genesis.Params.StakingAdmins = append(genesis.Params.StakingAdmins, admin1, admin2)
+	// short bodies and summaries keep the tests readable
+	genesis.Params.ArgumentBodyMinLength = 1
+	genesis.Params.ArgumentSummaryMinLength = 1
 	InitGenesis(ctx, keeper, genesis)
 	trubank.InitGenesis(ctx, trubankKeeper, trubank.DefaultGenesisState())
 
 	mockedDB := &mockedDB{
-		claimKeeper:   mockedClaimKeeper,
-		accountKeeper: mockedAccountKeeper,
-		authAccKeeper: accKeeper,
-		bankKeeper:    trubankKeeper,
-		supplyKeeper:  supplyKeeper,
+		claimKeeper:     mockedClaimKeeper,
+		communityKeeper: mockedCommunityKeeper,
+		searchKeeper:    mockedSearchKeeper,
+		accountKeeper:   mockedAccountKeeper,
+		authAccKeeper:   accKeeper,
+		bankKeeper:      trubankKeeper,
+		supplyKeeper:    supplyKeeper,
 	}
 	return ctx, keeper, mockedDB
 }
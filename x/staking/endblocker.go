@@ -21,6 +21,7 @@ func (k Keeper) processExpiringStakes(ctx sdk.Context) {
 		if err != nil {
 			panic(err)
 		}
+		k.subtractActiveStake(ctx, stake)
 		stake.Expired = true
 		stake.Result = &result
 		k.setStake(ctx, stake)
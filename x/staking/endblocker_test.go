@@ -49,9 +49,13 @@ func TestKeeper_TestEarnedCoins(t *testing.T) {
 	argumentInterest := k.interest(ctx, sdk.NewInt64Coin(app.StakeDenom, app.Shanev*50), time.Hour*24*7).RoundInt()
 	upvoteInterest := k.interest(ctx, sdk.NewInt64Coin(app.StakeDenom, app.Shanev*10), time.Hour*24*7)
 	upvoteAfterSplitInterest := upvoteInterest.Mul(sdk.NewDecWithPrec(50, 2)).RoundInt()
+	// addr created both claims so it also earns a share of the interest generated by arg2,
+	// but not by its own argument and upvote
+	claimCreatorShare := k.GetParams(ctx).ClaimCreatorShare
+	argumentClaimCreatorReward := k.interest(ctx, sdk.NewInt64Coin(app.StakeDenom, app.Shanev*50), time.Hour*24*7).Mul(claimCreatorShare).RoundInt()
 
 	assert.Equal(t, argumentInterest.String(), earnings[addr.String()].Coins.AmountOf("crypto").String())
-	assert.Equal(t, upvoteAfterSplitInterest.String(), earnings[addr.String()].Coins.AmountOf("random").String())
+	assert.Equal(t, upvoteAfterSplitInterest.Add(argumentClaimCreatorReward).String(), earnings[addr.String()].Coins.AmountOf("random").String())
 	t.Log(argumentInterest.String())
 	t.Log(upvoteInterest.String())
 	t.Log(upvoteAfterSplitInterest.String())
@@ -97,8 +101,8 @@ func TestKeeper_TestRefundStake(t *testing.T) {
 	addr1Txs := k.bankKeeper.TransactionsByAddress(ctx, addr)
 	addr2Txs := k.bankKeeper.TransactionsByAddress(ctx, addr2)
 
-	// 3 stakes + 2 interest + 2 refund
-	assert.Len(t, addr1Txs, 7)
+	// 3 stakes + 2 interest + 2 refund + 1 claim creator interest, its own stakes don't pay it
+	assert.Len(t, addr1Txs, 8)
 	txTypes := make([]TransactionType, 0)
 	txCommunities := make([]string, 0)
 	for _, tx := range addr1Txs {
@@ -113,8 +117,9 @@ func TestKeeper_TestRefundStake(t *testing.T) {
 		// second interactions
 		TransactionBacking,
 		// second end block
+		TransactionInterestClaimCreation,
 		TransactionUpvoteReturned, TransactionInterestUpvoteGiven}
-	expectedTxCommunities := []string{"crypto", "random", "crypto", "crypto", "random", "random", "random"}
+	expectedTxCommunities := []string{"crypto", "random", "crypto", "crypto", "random", "random", "random", "random"}
 	assert.Equal(t, expected, txTypes)
 	assert.Equal(t, expectedTxCommunities, txCommunities)
 
@@ -205,9 +210,44 @@ func TestKeeper_TestStakeRewardResult(t *testing.T) {
 	totalRewards = totalRewards.Add(stakes[1].Result.ArgumentCreatorReward)
 	totalRewards = totalRewards.Add(stakes[1].Result.StakeCreatorReward)
 	totalRewards = totalRewards.Add(stakesUser2[0].Result.ArgumentCreatorReward)
+	totalRewards = totalRewards.Add(stakes[0].Result.ClaimCreatorReward)
+	totalRewards = totalRewards.Add(stakes[1].Result.ClaimCreatorReward)
+	totalRewards = totalRewards.Add(stakesUser2[0].Result.ClaimCreatorReward)
 	coins, _ := sdk.ParseCoins("1000000000utru") // 100 TRU
 	result := coins.Sub(sdk.Coins{totalRewards})
 	c = mdb.supplyKeeper.GetModuleAccount(ctx, UserRewardPoolName).GetCoins()
 	assert.Equal(t, c.AmountOf(app.StakeDenom).String(), result.AmountOf(app.StakeDenom).String())
 
 }
+
+func TestKeeper_ClaimCreatorReward(t *testing.T) {
+	ctx, k, mdb := mockDB()
+	mockedClaimKeeper := mdb.claimKeeper.(*mockClaimKeeper)
+	claimCreator := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*250)})
+	linked := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*250)})
+	staker := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*250)})
+	mdb.accountKeeper.(*mockedAccountKeeper).link(claimCreator, linked)
+	mockedClaimKeeper.SetClaims(map[uint64]claim.Claim{
+		1: {ID: 1, CommunityID: "crypto", Body: "body", Creator: claimCreator},
+	})
+
+	// an argument from a linked key of the claim creator
+	ownArgument, err := k.SubmitArgument(ctx.WithBlockTime(mustParseTime("2019-01-01")),
+		"arg1", "summary1", linked, 1, StakeBacking)
+	assert.NoError(t, err)
+	argument, err := k.SubmitArgument(ctx.WithBlockTime(mustParseTime("2019-01-01")),
+		"arg2", "summary2", staker, 1, StakeChallenge)
+	assert.NoError(t, err)
+	_, err = k.SubmitUpvote(ctx.WithBlockTime(mustParseTime("2019-01-02")), ownArgument.ID, staker)
+	assert.NoError(t, err)
+
+	EndBlocker(ctx.WithBlockTime(mustParseTime("2019-01-13")), k)
+	claimCreatorReward := k.interest(ctx, sdk.NewInt64Coin(app.StakeDenom, app.Shanev*50), time.Hour*24*7).
+		Mul(k.GetParams(ctx).ClaimCreatorShare).RoundInt()
+	for _, s := range k.ArgumentStakes(ctx, ownArgument.ID) {
+		assert.True(t, s.Result.ClaimCreatorReward.IsZero())
+	}
+	stakes := k.ArgumentStakes(ctx, argument.ID)
+	assert.Len(t, stakes, 1)
+	assert.Equal(t, claimCreatorReward.String(), stakes[0].Result.ClaimCreatorReward.Amount.String())
+}
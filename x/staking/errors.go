@@ -31,12 +31,18 @@ const (
 	ErrorCodeCannotEditArgumentWrongCreator  sdk.CodeType = 515
 	ErrorCodeMinBalance                      sdk.CodeType = 516
 	ErrorCodeAddressNotAuthorised            sdk.CodeType = 517
+	ErrorCodeClaimNotOpen                    sdk.CodeType = 518
+	ErrorCodeInvalidEvidence                 sdk.CodeType = 519
 )
 
 // GenesisErrors
 const (
 	ErrInvalidArgumentStakeDenom = Error("invalid denomination for argument stake")
 	ErrInvalidUpvoteStakeDenom   = Error("invalid denomination for upvote stake")
+	ErrInvalidVerdictBonusRate   = Error("verdict bonus rate can't be negative")
+	ErrInvalidClaimCreatorShare  = Error("claim creator share must be between 0 and 1")
+	ErrInvalidMaxEvidenceCount   = Error("max evidence count can't be negative")
+	ErrInvalidMaxEvidenceURI     = Error("max evidence URI length must be positive")
 )
 
 // ErrCodeAccountJailed throws an error is in jailed status when performing actions.
@@ -174,3 +180,19 @@ func ErrAddressNotAuthorised() sdk.Error {
 		ErrorCodeAddressNotAuthorised,
 		"This creator is not authorised to perform this action.")
 }
+
+// ErrCodeClaimNotOpen throws an error when staking on a claim that is no longer open
+func ErrCodeClaimNotOpen(claimID uint64) sdk.Error {
+	return sdk.NewError(DefaultCodespace,
+		ErrorCodeClaimNotOpen,
+		fmt.Sprintf("Claim %d is not open", claimID),
+	)
+}
+
+// ErrCodeInvalidEvidence throws an error when the evidence of an argument is invalid
+func ErrCodeInvalidEvidence(err error) sdk.Error {
+	return sdk.NewError(DefaultCodespace,
+		ErrorCodeInvalidEvidence,
+		fmt.Sprintf("Invalid evidence: %s", err),
+	)
+}
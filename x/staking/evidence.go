@@ -0,0 +1,50 @@
+package staking
+
+import (
+	"fmt"
+
+	"github.com/TruStory/truchain/x/claim"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EditArgumentEvidence replaces the evidence attached to an argument.
+// It follows the same rules as editing the argument body.
+func (k Keeper) EditArgumentEvidence(ctx sdk.Context, evidence []claim.Evidence,
+	editor sdk.AccAddress, argumentID uint64) (Argument, sdk.Error) {
+
+	err := k.validateEvidence(ctx, evidence)
+	if err != nil {
+		return Argument{}, err
+	}
+	argument, err := k.editableArgument(ctx, editor, argumentID)
+	if err != nil {
+		return Argument{}, err
+	}
+	if argument.IsDeleted {
+		return Argument{}, ErrCodeUnknownArgument(argumentID)
+	}
+
+	argument.Evidence = evidence
+	argument.EditedTime = ctx.BlockHeader().Time
+	argument.Edited = true
+	k.setArgument(ctx, argument)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeArgumentEvidenceEdited,
+			sdk.NewAttribute(AttributeKeyArgumentID, fmt.Sprintf("%d", argumentID)),
+		),
+	)
+
+	return argument, nil
+}
+
+func (k Keeper) validateEvidence(ctx sdk.Context, evidence []claim.Evidence) sdk.Error {
+	p := k.GetParams(ctx)
+	err := claim.ValidateEvidence(evidence, p.MaxEvidenceCount, p.MaxEvidenceURILength)
+	if err != nil {
+		return ErrCodeInvalidEvidence(err)
+	}
+
+	return nil
+}
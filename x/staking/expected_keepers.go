@@ -6,6 +6,7 @@ import (
 	"github.com/TruStory/truchain/x/account"
 	bankexported "github.com/TruStory/truchain/x/bank/exported"
 	"github.com/TruStory/truchain/x/claim"
+	"github.com/TruStory/truchain/x/community"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -13,6 +14,8 @@ type AccountKeeper interface {
 	IsJailed(ctx sdk.Context, address sdk.AccAddress) (bool, sdk.Error)
 	UnJail(ctx sdk.Context, address sdk.AccAddress) sdk.Error
 	IterateAppAccounts(ctx sdk.Context, cb func(acc account.AppAccount) (stop bool))
+	PrimaryAddress(ctx sdk.Context, address sdk.AccAddress) sdk.AccAddress
+	Addresses(ctx sdk.Context, address sdk.AccAddress) []sdk.AccAddress
 }
 
 type ClaimKeeper interface {
@@ -24,6 +27,16 @@ type ClaimKeeper interface {
 	SetFirstArgumentTime(ctx sdk.Context, id uint64, firstArgumentTime time.Time) sdk.Error
 }
 
+// CommunityKeeper is the expected community keeper interface for this module
+type CommunityKeeper interface {
+	IsModerator(ctx sdk.Context, communityID string, address sdk.AccAddress) bool
+	Descendants(ctx sdk.Context, communityID string) []string
+	Communities(ctx sdk.Context) []community.Community
+	Stats(ctx sdk.Context, communityID string) community.Stats
+	SetStats(ctx sdk.Context, stats community.Stats)
+	EffectiveOverrides(ctx sdk.Context, communityID string) community.ParamOverrides
+}
+
 // BankKeeper is the expected bank keeper interface for this module
 type BankKeeper interface {
 	AddCoin(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coin,
@@ -34,3 +47,9 @@ type BankKeeper interface {
 	TransactionsByAddress(ctx sdk.Context, address sdk.AccAddress, filterSetters ...bankexported.Filter) []bankexported.Transaction
 	IterateUserTransactions(sdk.Context, sdk.AccAddress, bool, func(tx bankexported.Transaction) bool)
 }
+
+// SearchKeeper is the expected search keeper interface for this module
+type SearchKeeper interface {
+	IndexArgument(ctx sdk.Context, id uint64, summary string)
+	RemoveArgument(ctx sdk.Context, id uint64)
+}
@@ -46,6 +46,9 @@ func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
 		k.setArgument(ctx, a)
 		k.setClaimArgument(ctx, a.ClaimID, a.ID)
 		k.setUserArgument(ctx, a.Creator, a.ID)
+		if !a.IsDeleted {
+			k.searchKeeper.IndexArgument(ctx, a.ID, a.Summary)
+		}
 	}
 	mintStakesPool := k.supplyKeeper.GetModuleAccount(ctx, UserStakesPoolName).GetCoins().Empty()
 	for _, s := range data.Stakes {
@@ -87,6 +90,22 @@ func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
 	}
 	k.SetParams(ctx, data.Params)
 
+	// rebuild the staking aggregates, so genesis files without community stats still pass the invariants
+	totals := k.communityTotals(ctx)
+	for _, community := range k.communityKeeper.Communities(ctx) {
+		t, ok := totals[community.ID]
+		if !ok {
+			t = newCommunityTotals()
+		}
+		stats := k.communityKeeper.Stats(ctx, community.ID)
+		stats.ArgumentCount = t.arguments
+		stats.ActiveStake = sdk.NewCoin(app.StakeDenom, t.activeStake)
+		stats.LifetimeStake = sdk.NewCoin(app.StakeDenom, t.lifetimeStake)
+		stats.TotalEarned = sdk.NewCoin(app.StakeDenom, t.totalEarned)
+		stats.UniqueStakers = uint64(len(t.stakers))
+		k.communityKeeper.SetStats(ctx, stats)
+	}
+
 	err := initUserRewardsPool(ctx, k)
 	if err != nil {
 		panic(err)
@@ -123,5 +142,17 @@ func ValidateGenesis(data GenesisState) error {
 	if data.Params.UpvoteStake.Denom != app.StakeDenom {
 		return ErrInvalidUpvoteStakeDenom
 	}
+	if data.Params.VerdictBonusRate.IsNegative() {
+		return ErrInvalidVerdictBonusRate
+	}
+	if data.Params.ClaimCreatorShare.IsNegative() || data.Params.ClaimCreatorShare.GT(sdk.OneDec()) {
+		return ErrInvalidClaimCreatorShare
+	}
+	if data.Params.MaxEvidenceCount < 0 {
+		return ErrInvalidMaxEvidenceCount
+	}
+	if data.Params.MaxEvidenceURILength < 1 {
+		return ErrInvalidMaxEvidenceURI
+	}
 	return nil
 }
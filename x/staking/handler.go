@@ -16,6 +16,8 @@ func NewHandler(keeper Keeper) sdk.Handler {
 			return handleMsgSubmitUpvote(ctx, keeper, msg)
 		case MsgEditArgument:
 			return handleMsgEditArgument(ctx, keeper, msg)
+		case MsgEditArgumentEvidence:
+			return handleMsgEditArgumentEvidence(ctx, keeper, msg)
 		case MsgAddAdmin:
 			return handleMsgAddAdmin(ctx, keeper, msg)
 		case MsgRemoveAdmin:
@@ -33,7 +35,7 @@ func handleMsgSubmitArgument(ctx sdk.Context, keeper Keeper, msg MsgSubmitArgume
 	if err := msg.ValidateBasic(); err != nil {
 		return err.Result()
 	}
-	argument, err := keeper.SubmitArgument(ctx, msg.Body, msg.Summary, msg.Creator, msg.ClaimID, msg.StakeType)
+	argument, err := keeper.SubmitArgument(ctx, msg.Body, msg.Summary, msg.Creator, msg.ClaimID, msg.StakeType, msg.Evidence...)
 	if err != nil {
 		return err.Result()
 	}
@@ -82,6 +84,23 @@ func handleMsgEditArgument(ctx sdk.Context, keeper Keeper, msg MsgEditArgument)
 	}
 }
 
+func handleMsgEditArgumentEvidence(ctx sdk.Context, keeper Keeper, msg MsgEditArgumentEvidence) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+	argument, err := keeper.EditArgumentEvidence(ctx, msg.Evidence, msg.Creator, msg.ArgumentID)
+	if err != nil {
+		return err.Result()
+	}
+	res, codecErr := ModuleCodec.MarshalJSON(argument)
+	if codecErr != nil {
+		return sdk.ErrInternal(fmt.Sprintf("Marshal result error: %s", codecErr)).Result()
+	}
+	return sdk.Result{
+		Data: res,
+	}
+}
+
 func handleMsgAddAdmin(ctx sdk.Context, k Keeper, msg MsgAddAdmin) sdk.Result {
 	if err := msg.ValidateBasic(); err != nil {
 		return err.Result()
@@ -0,0 +1,233 @@
+package staking
+
+import (
+	"fmt"
+
+	"github.com/TruStory/truchain/x/account"
+	"github.com/TruStory/truchain/x/claim"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Hooks wrapper struct for the staking keeper
+type Hooks struct {
+	k Keeper
+}
+
+var _ claim.ClaimHooks = Hooks{}
+var _ account.AccountHooks = Hooks{}
+
+// Hooks returns the claim and account hooks implemented by the staking keeper
+func (k Keeper) Hooks() Hooks { return Hooks{k} }
+
+// AfterClaimClosed pays the verdict bonus to the winning side of a claim
+func (h Hooks) AfterClaimClosed(ctx sdk.Context, c claim.Claim) sdk.Error {
+	h.k.payVerdictBonus(ctx, c)
+	return nil
+}
+
+// AfterClaimDeleted refunds the active stakes on a deleted claim and marks its arguments as deleted
+func (h Hooks) AfterClaimDeleted(ctx sdk.Context, c claim.Claim) sdk.Error {
+	return h.k.refundDeletedClaimStakes(ctx, c)
+}
+
+// AfterClaimEdited emits the argument and stake creators of an edited claim so they can be notified
+func (h Hooks) AfterClaimEdited(ctx sdk.Context, c claim.Claim) sdk.Error {
+	return h.k.emitClaimEditAffectedUsers(ctx, c)
+}
+
+// AfterAccountRecovered moves the stakes, arguments and earned coins of a recovered account to its new address
+func (h Hooks) AfterAccountRecovered(ctx sdk.Context, oldAddress, newAddress sdk.AccAddress) sdk.Error {
+	h.k.migrateUser(ctx, oldAddress, newAddress)
+	return nil
+}
+
+// AfterJailExpired is a no-op for the staking keeper
+func (h Hooks) AfterJailExpired(ctx sdk.Context, address sdk.AccAddress) sdk.Error {
+	return nil
+}
+
+// payVerdictBonus pays the verdict bonus to the stakes of the winning side.
+// A failed payment is logged and skipped, so it never stops the claim from closing.
+func (k Keeper) payVerdictBonus(ctx sdk.Context, c claim.Claim) {
+	if c.Result == nil {
+		return
+	}
+	var winningType StakeType
+	switch c.Result.Verdict {
+	case claim.VerdictBackersWin:
+		winningType = StakeBacking
+	case claim.VerdictChallengersWin:
+		winningType = StakeChallenge
+	default:
+		return
+	}
+	rate := k.GetParams(ctx).VerdictBonusRate
+	if !rate.IsPositive() {
+		return
+	}
+
+	bonusedStakes := make([]Stake, 0)
+	for _, argument := range k.ClaimArguments(ctx, c.ID) {
+		if argument.StakeType != winningType || argument.IsUnhelpful {
+			continue
+		}
+		for _, stake := range k.ArgumentStakes(ctx, argument.ID) {
+			// stakes are only rewarded once, even if the claim is reopened
+			if stake.VerdictBonus != nil {
+				continue
+			}
+			bonus := sdk.NewCoin(stake.Amount.Denom, stake.Amount.Amount.ToDec().Mul(rate).TruncateInt())
+			if !bonus.IsPositive() {
+				continue
+			}
+			cacheCtx, write := ctx.CacheContext()
+			_, err := k.bankKeeper.AddCoin(cacheCtx,
+				stake.Creator,
+				bonus,
+				stake.ID,
+				TransactionVerdictBonus,
+				WithCommunityID(argument.CommunityID),
+				FromModuleAccount(UserRewardPoolName),
+			)
+			if err != nil {
+				k.Logger(ctx).Error(fmt.Sprintf("Failed paying the verdict bonus of stake %d: %s", stake.ID, err))
+				continue
+			}
+			write()
+			k.addEarnedCoin(ctx, stake.Creator, argument.CommunityID, bonus.Amount)
+			stake.VerdictBonus = &bonus
+			k.setStake(ctx, stake)
+			bonusedStakes = append(bonusedStakes, stake)
+		}
+	}
+
+	if len(bonusedStakes) == 0 {
+		return
+	}
+
+	b := k.codec.MustMarshalJSON(bonusedStakes)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeVerdictBonusPaid,
+			sdk.NewAttribute(AttributeKeyBonusedStakes, string(b)),
+		),
+	)
+}
+
+// refundDeletedClaimStakes refunds every active stake on the arguments of a deleted claim.
+// Refunded stakes expire without earning interest.
+func (k Keeper) refundDeletedClaimStakes(ctx sdk.Context, c claim.Claim) sdk.Error {
+	refundedStakes := make([]Stake, 0)
+	for _, argument := range k.ClaimArguments(ctx, c.ID) {
+		for _, stake := range k.ArgumentStakes(ctx, argument.ID) {
+			if stake.Expired {
+				continue
+			}
+			err := k.refundStake(ctx, stake, argument.CommunityID)
+			if err != nil {
+				return err
+			}
+			k.RemoveFromActiveStakeQueue(ctx, stake.ID, stake.EndTime)
+			k.subtractActiveStake(ctx, stake)
+			stake.Expired = true
+			k.setStake(ctx, stake)
+			refundedStakes = append(refundedStakes, stake)
+		}
+		if !argument.IsDeleted {
+			stats := k.communityKeeper.Stats(ctx, argument.CommunityID)
+			stats.ArgumentCount--
+			k.communityKeeper.SetStats(ctx, stats)
+		}
+		argument.IsDeleted = true
+		k.setArgument(ctx, argument)
+		k.searchKeeper.RemoveArgument(ctx, argument.ID)
+	}
+
+	if len(refundedStakes) == 0 {
+		return nil
+	}
+
+	b, jsonErr := k.codec.MarshalJSON(refundedStakes)
+	if jsonErr != nil {
+		return sdk.ErrInternal(fmt.Sprintf("Marshal result error: %s", jsonErr))
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeStakesRefunded,
+			sdk.NewAttribute(AttributeKeyRefundedStakes, string(b)),
+		),
+	)
+
+	return nil
+}
+
+func (k Keeper) emitClaimEditAffectedUsers(ctx sdk.Context, c claim.Claim) sdk.Error {
+	argumentCreators := make([]sdk.AccAddress, 0)
+	stakeCreators := make([]sdk.AccAddress, 0)
+	seenArgumentCreators := make(map[string]bool)
+	seenStakeCreators := make(map[string]bool)
+	for _, argument := range k.ClaimArguments(ctx, c.ID) {
+		if !seenArgumentCreators[argument.Creator.String()] {
+			seenArgumentCreators[argument.Creator.String()] = true
+			argumentCreators = append(argumentCreators, argument.Creator)
+		}
+		for _, stake := range k.ArgumentStakes(ctx, argument.ID) {
+			if !seenStakeCreators[stake.Creator.String()] {
+				seenStakeCreators[stake.Creator.String()] = true
+				stakeCreators = append(stakeCreators, stake.Creator)
+			}
+		}
+	}
+
+	argumentCreatorsJSON, jsonErr := k.codec.MarshalJSON(argumentCreators)
+	if jsonErr != nil {
+		return sdk.ErrInternal(fmt.Sprintf("Marshal result error: %s", jsonErr))
+	}
+	stakeCreatorsJSON, jsonErr := k.codec.MarshalJSON(stakeCreators)
+	if jsonErr != nil {
+		return sdk.ErrInternal(fmt.Sprintf("Marshal result error: %s", jsonErr))
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeClaimEditAffectedUsers,
+			sdk.NewAttribute(AttributeKeyClaimID, fmt.Sprintf("%d", c.ID)),
+			sdk.NewAttribute(AttributeKeyArgumentCreators, string(argumentCreatorsJSON)),
+			sdk.NewAttribute(AttributeKeyStakeCreators, string(stakeCreatorsJSON)),
+		),
+	)
+
+	return nil
+}
+
+// migrateUser changes the owner of every stake and argument of a user, and merges its earned coins
+func (k Keeper) migrateUser(ctx sdk.Context, oldAddress, newAddress sdk.AccAddress) {
+	store := k.store(ctx)
+	stakes := k.UserStakes(ctx, oldAddress)
+	for _, stake := range stakes {
+		store.Delete(userStakeKey(oldAddress, stake.CreatedTime, stake.ID))
+		store.Delete(userCommunityStakeKey(oldAddress, stake.CommunityID, stake.ID))
+		stake.Creator = newAddress
+		k.setStake(ctx, stake)
+		k.setUserStake(ctx, newAddress, stake.CreatedTime, stake.ID)
+		k.setUserCommunityStake(ctx, newAddress, stake.CommunityID, stake.ID)
+	}
+	arguments := k.UserArguments(ctx, oldAddress)
+	for _, argument := range arguments {
+		store.Delete(userArgumentKey(oldAddress, argument.ID))
+		argument.Creator = newAddress
+		k.setArgument(ctx, argument)
+		k.setUserArgument(ctx, newAddress, argument.ID)
+	}
+
+	earnedCoins := k.getEarnedCoins(ctx, oldAddress)
+	store.Delete(userEarnedCoinsKey(oldAddress))
+	if !earnedCoins.Empty() {
+		k.setEarnedCoins(ctx, newAddress, k.getEarnedCoins(ctx, newAddress).Add(earnedCoins))
+	}
+
+	k.Logger(ctx).Info(fmt.Sprintf("Moved %d stakes and %d arguments from %s to %s",
+		len(stakes), len(arguments), oldAddress, newAddress))
+}
@@ -0,0 +1,210 @@
+package staking
+
+import (
+	"testing"
+
+	app "github.com/TruStory/truchain/types"
+	"github.com/TruStory/truchain/x/claim"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHooks_AfterClaimClosedPaysVerdictBonus(t *testing.T) {
+	ctx, k, mdb := mockDB()
+	mockedClaimKeeper := mdb.claimKeeper.(*mockClaimKeeper)
+	claims := make(map[uint64]claim.Claim)
+	claims[1] = claim.Claim{
+		ID:              1,
+		CommunityID:     "crypto",
+		TotalBacked:     sdk.NewInt64Coin(app.StakeDenom, 0),
+		TotalChallenged: sdk.NewInt64Coin(app.StakeDenom, 0),
+	}
+	mockedClaimKeeper.SetClaims(claims)
+	backer := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	upvoter := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	challenger := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+
+	backing, err := k.SubmitArgument(ctx, "body", "summary", backer, 1, StakeBacking)
+	assert.NoError(t, err)
+	_, err = k.SubmitUpvote(ctx, backing.ID, upvoter)
+	assert.NoError(t, err)
+	_, err = k.SubmitArgument(ctx, "body", "summary", challenger, 1, StakeChallenge)
+	assert.NoError(t, err)
+
+	p := k.GetParams(ctx)
+	p.VerdictBonusRate = sdk.NewDecWithPrec(10, 2)
+	k.SetParams(ctx, p)
+
+	closed := claims[1]
+	closed.Status = claim.StatusClosed
+	closed.Result = &claim.ClaimResult{ClaimID: 1, Verdict: claim.VerdictBackersWin}
+
+	err = k.Hooks().AfterClaimClosed(ctx, closed)
+	assert.NoError(t, err)
+
+	argumentBonus := sdk.NewInt64Coin(app.StakeDenom, app.Shanev*5)
+	upvoteBonus := sdk.NewInt64Coin(app.StakeDenom, app.Shanev*1)
+	assert.Equal(t, argumentBonus.Amount, k.TotalEarnedCoins(ctx, backer))
+	assert.Equal(t, upvoteBonus.Amount, k.TotalEarnedCoins(ctx, upvoter))
+	assert.True(t, k.TotalEarnedCoins(ctx, challenger).IsZero())
+
+	stakes := k.ArgumentStakes(ctx, backing.ID)
+	assert.Len(t, stakes, 2)
+	for _, s := range stakes {
+		assert.NotNil(t, s.VerdictBonus)
+	}
+
+	// bonuses are only paid once
+	err = k.Hooks().AfterClaimClosed(ctx, closed)
+	assert.NoError(t, err)
+	assert.Equal(t, argumentBonus.Amount, k.TotalEarnedCoins(ctx, backer))
+}
+
+func TestHooks_AfterClaimClosedSkipsFailedBonus(t *testing.T) {
+	ctx, k, mdb := mockDB()
+	backer := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	upvoter := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	backing, err := k.SubmitArgument(ctx, "body", "summary", backer, 1, StakeBacking)
+	assert.NoError(t, err)
+	_, err = k.SubmitUpvote(ctx, backing.ID, upvoter)
+	assert.NoError(t, err)
+
+	// the argument bonus is larger than the user reward pool
+	p := k.GetParams(ctx)
+	p.VerdictBonusRate = sdk.NewDec(30)
+	k.SetParams(ctx, p)
+
+	c, _ := k.claimKeeper.Claim(ctx, 1)
+	c.ID = 1
+	c.Result = &claim.ClaimResult{ClaimID: 1, Verdict: claim.VerdictBackersWin}
+	err = k.Hooks().AfterClaimClosed(ctx, c)
+	assert.NoError(t, err)
+	assert.True(t, k.TotalEarnedCoins(ctx, backer).IsZero())
+	assert.Equal(t, sdk.NewInt(app.Shanev*300), k.TotalEarnedCoins(ctx, upvoter))
+	for _, s := range k.ArgumentStakes(ctx, backing.ID) {
+		assert.Equal(t, s.Creator.Equals(upvoter), s.VerdictBonus != nil)
+	}
+}
+
+func TestHooks_AfterClaimClosedMajorityNotReached(t *testing.T) {
+	ctx, k, mdb := mockDB()
+	backer := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	_, err := k.SubmitArgument(ctx, "body", "summary", backer, 1, StakeBacking)
+	assert.NoError(t, err)
+
+	p := k.GetParams(ctx)
+	p.VerdictBonusRate = sdk.NewDecWithPrec(10, 2)
+	k.SetParams(ctx, p)
+
+	c, _ := k.claimKeeper.Claim(ctx, 1)
+	c.Result = &claim.ClaimResult{ClaimID: 1, Verdict: claim.VerdictMajorityNotReached}
+	err = k.Hooks().AfterClaimClosed(ctx, c)
+	assert.NoError(t, err)
+	assert.True(t, k.TotalEarnedCoins(ctx, backer).IsZero())
+}
+
+func TestHooks_AfterClaimDeletedRefundsStakes(t *testing.T) {
+	ctx, k, mdb := mockDB()
+	backer := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	upvoter := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+
+	argument, err := k.SubmitArgument(ctx, "body", "summary", backer, 1, StakeBacking)
+	assert.NoError(t, err)
+	_, err = k.SubmitUpvote(ctx, argument.ID, upvoter)
+	assert.NoError(t, err)
+	c := mdb.supplyKeeper.GetModuleAccount(ctx, UserStakesPoolName).GetCoins()
+	assert.True(t, c.AmountOf(app.StakeDenom).IsPositive())
+
+	deleted, _ := k.claimKeeper.Claim(ctx, 1)
+	deleted.ID = 1
+	deleted.Status = claim.StatusDeleted
+	err = k.Hooks().AfterClaimDeleted(ctx, deleted)
+	assert.NoError(t, err)
+
+	// stakes are returned without interest
+	c = mdb.supplyKeeper.GetModuleAccount(ctx, UserStakesPoolName).GetCoins()
+	assert.True(t, c.AmountOf(app.StakeDenom).IsZero())
+	assert.True(t, k.TotalEarnedCoins(ctx, backer).IsZero())
+	for _, s := range k.ArgumentStakes(ctx, argument.ID) {
+		assert.True(t, s.Expired)
+		assert.Nil(t, s.Result)
+	}
+
+	// expired stakes are out of the active queue
+	EndBlocker(ctx.WithBlockTime(ctx.BlockHeader().Time.Add(k.GetParams(ctx).Period)), k)
+	assert.True(t, k.TotalEarnedCoins(ctx, backer).IsZero())
+
+	deletedArgument, ok := k.Argument(ctx, argument.ID)
+	assert.True(t, ok)
+	assert.True(t, deletedArgument.IsDeleted)
+	assert.Len(t, visibleArguments(k.ClaimArguments(ctx, 1)), 0)
+}
+
+func TestHooks_AfterClaimEditedEmitsAffectedUsers(t *testing.T) {
+	ctx, k, mdb := mockDB()
+	backer := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	upvoter := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+
+	argument, err := k.SubmitArgument(ctx, "body", "summary", backer, 1, StakeBacking)
+	assert.NoError(t, err)
+	_, err = k.SubmitUpvote(ctx, argument.ID, upvoter)
+	assert.NoError(t, err)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	edited, _ := k.claimKeeper.Claim(ctx, 1)
+	edited.ID = 1
+	err = k.Hooks().AfterClaimEdited(ctx, edited)
+	assert.NoError(t, err)
+
+	events := ctx.EventManager().Events()
+	assert.Len(t, events, 1)
+	assert.Equal(t, EventTypeClaimEditAffectedUsers, events[0].Type)
+
+	var argumentCreators, stakeCreators []sdk.AccAddress
+	for _, attr := range events[0].Attributes {
+		switch string(attr.Key) {
+		case AttributeKeyArgumentCreators:
+			assert.NoError(t, k.codec.UnmarshalJSON(attr.Value, &argumentCreators))
+		case AttributeKeyStakeCreators:
+			assert.NoError(t, k.codec.UnmarshalJSON(attr.Value, &stakeCreators))
+		}
+	}
+	assert.Equal(t, []sdk.AccAddress{backer}, argumentCreators)
+	assert.ElementsMatch(t, []sdk.AccAddress{backer, upvoter}, stakeCreators)
+}
+
+func TestHooks_AfterAccountRecoveredMovesStakes(t *testing.T) {
+	ctx, k, mdb := mockDB()
+	lost := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	upvoter := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	_, _, recovered := keyPubAddr()
+
+	argument, err := k.SubmitArgument(ctx, "body", "summary", lost, 1, StakeBacking)
+	assert.NoError(t, err)
+	upvote, err := k.SubmitUpvote(ctx, argument.ID, upvoter)
+	assert.NoError(t, err)
+	k.addEarnedCoin(ctx, lost, "crypto", sdk.NewInt(app.Shanev*5))
+
+	err = k.Hooks().AfterAccountRecovered(ctx, lost, recovered)
+	assert.NoError(t, err)
+
+	assert.Len(t, k.UserStakes(ctx, lost), 0)
+	assert.Len(t, k.UserArguments(ctx, lost), 0)
+	assert.Len(t, k.UserCommunityStakes(ctx, lost, argument.CommunityID), 0)
+	assert.True(t, k.TotalEarnedCoins(ctx, lost).IsZero())
+
+	stakes := k.UserStakes(ctx, recovered)
+	assert.Len(t, stakes, 1)
+	assert.Equal(t, recovered, stakes[0].Creator)
+	assert.Len(t, k.UserCommunityStakes(ctx, recovered, argument.CommunityID), 1)
+	arguments := k.UserArguments(ctx, recovered)
+	assert.Len(t, arguments, 1)
+	assert.Equal(t, recovered, arguments[0].Creator)
+	assert.Equal(t, sdk.NewInt(app.Shanev*5), k.TotalEarnedCoins(ctx, recovered))
+
+	// stakes of other users are untouched
+	s, ok := k.Stake(ctx, upvote.ID)
+	assert.True(t, ok)
+	assert.Equal(t, upvoter, s.Creator)
+}
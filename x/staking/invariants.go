@@ -0,0 +1,109 @@
+package staking
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterInvariants registers the staking module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(ModuleName, "community-stats", CommunityStatsInvariant(k))
+}
+
+// communityTotals are the staking aggregates of a community, recounted from the store
+type communityTotals struct {
+	arguments     uint64
+	activeStake   sdk.Int
+	lifetimeStake sdk.Int
+	totalEarned   sdk.Int
+	stakers       map[string]bool
+}
+
+func newCommunityTotals() *communityTotals {
+	return &communityTotals{
+		activeStake:   sdk.ZeroInt(),
+		lifetimeStake: sdk.ZeroInt(),
+		totalEarned:   sdk.ZeroInt(),
+		stakers:       make(map[string]bool),
+	}
+}
+
+// communityTotals recounts the staking aggregates of every community from arguments and stakes
+func (k Keeper) communityTotals(ctx sdk.Context) map[string]*communityTotals {
+	totals := make(map[string]*communityTotals)
+	communityTotalsFor := func(communityID string) *communityTotals {
+		t, ok := totals[communityID]
+		if !ok {
+			t = newCommunityTotals()
+			totals[communityID] = t
+		}
+		return t
+	}
+
+	for _, argument := range k.Arguments(ctx) {
+		if argument.IsDeleted {
+			continue
+		}
+		communityTotalsFor(argument.CommunityID).arguments++
+	}
+	for _, stake := range k.Stakes(ctx) {
+		t := communityTotalsFor(stake.CommunityID)
+		t.lifetimeStake = t.lifetimeStake.Add(stake.Amount.Amount)
+		if !stake.Expired {
+			t.activeStake = t.activeStake.Add(stake.Amount.Amount)
+		}
+		t.stakers[stake.Creator.String()] = true
+		if stake.Result != nil {
+			t.totalEarned = t.totalEarned.
+				Add(stake.Result.ArgumentCreatorReward.Amount).
+				Add(stake.Result.StakeCreatorReward.Amount).
+				Add(stake.Result.ClaimCreatorReward.Amount)
+		}
+		if stake.VerdictBonus != nil {
+			t.totalEarned = t.totalEarned.Add(stake.VerdictBonus.Amount)
+		}
+	}
+
+	return totals
+}
+
+// CommunityStatsInvariant checks the argument, stake and earnings aggregates of the
+// community stats by recounting arguments and stakes
+func CommunityStatsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		totals := k.communityTotals(ctx)
+		var msg string
+		broken := false
+		for _, community := range k.communityKeeper.Communities(ctx) {
+			id := community.ID
+			t, ok := totals[id]
+			if !ok {
+				t = newCommunityTotals()
+			}
+			stats := k.communityKeeper.Stats(ctx, id)
+			if stats.ArgumentCount != t.arguments {
+				broken = true
+				msg += fmt.Sprintf("\tcommunity %s has %d arguments, stats count %d\n", id, t.arguments, stats.ArgumentCount)
+			}
+			if stats.UniqueStakers != uint64(len(t.stakers)) {
+				broken = true
+				msg += fmt.Sprintf("\tcommunity %s has %d stakers, stats count %d\n", id, len(t.stakers), stats.UniqueStakers)
+			}
+			if !stats.ActiveStake.Amount.Equal(t.activeStake) {
+				broken = true
+				msg += fmt.Sprintf("\tcommunity %s has %s active stake, stats count %s\n", id, t.activeStake, stats.ActiveStake)
+			}
+			if !stats.LifetimeStake.Amount.Equal(t.lifetimeStake) {
+				broken = true
+				msg += fmt.Sprintf("\tcommunity %s has %s lifetime stake, stats count %s\n", id, t.lifetimeStake, stats.LifetimeStake)
+			}
+			if !stats.TotalEarned.Amount.Equal(t.totalEarned) {
+				broken = true
+				msg += fmt.Sprintf("\tcommunity %s distributed %s earned coins, stats count %s\n", id, t.totalEarned, stats.TotalEarned)
+			}
+		}
+
+		return sdk.FormatInvariant(ModuleName, "community stats", msg), broken
+	}
+}
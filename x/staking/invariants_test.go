@@ -0,0 +1,56 @@
+package staking
+
+import (
+	"testing"
+
+	app "github.com/TruStory/truchain/types"
+	"github.com/TruStory/truchain/x/claim"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCommunityStatsInvariant(t *testing.T) {
+	ctx, k, mdb := mockDB()
+	mockedClaimKeeper := mdb.claimKeeper.(*mockClaimKeeper)
+	addr := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*250)})
+	addr2 := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*250)})
+	mockedClaimKeeper.SetClaims(map[uint64]claim.Claim{
+		1: {ID: 1, CommunityID: "crypto", Body: "body", Creator: addr},
+	})
+
+	argument, err := k.SubmitArgument(ctx.WithBlockTime(mustParseTime("2019-01-01")),
+		"arg1", "summary1", addr, 1, StakeChallenge)
+	assert.NoError(t, err)
+	_, err = k.SubmitUpvote(ctx.WithBlockTime(mustParseTime("2019-01-02")), argument.ID, addr2)
+	assert.NoError(t, err)
+
+	stats := mdb.communityKeeper.Stats(ctx, "crypto")
+	assert.Equal(t, uint64(1), stats.ArgumentCount)
+	assert.Equal(t, uint64(2), stats.UniqueStakers)
+	assert.Equal(t, sdk.NewInt64Coin(app.StakeDenom, app.Shanev*60), stats.ActiveStake)
+	assert.Equal(t, stats.ActiveStake, stats.LifetimeStake)
+	assert.True(t, stats.TotalEarned.IsZero())
+
+	invariant := CommunityStatsInvariant(k)
+	_, broken := invariant(ctx)
+	assert.False(t, broken)
+
+	EndBlocker(ctx.WithBlockTime(mustParseTime("2019-01-13")), k)
+	stats = mdb.communityKeeper.Stats(ctx, "crypto")
+	assert.True(t, stats.ActiveStake.IsZero())
+	assert.Equal(t, sdk.NewInt64Coin(app.StakeDenom, app.Shanev*60), stats.LifetimeStake)
+	assert.True(t, stats.TotalEarned.IsPositive())
+	_, broken = invariant(ctx)
+	assert.False(t, broken)
+
+	stats.UniqueStakers++
+	mdb.communityKeeper.SetStats(ctx, stats)
+	_, broken = invariant(ctx)
+	assert.True(t, broken)
+
+	// InitGenesis rebuilds the stats, e.g. for genesis files exported without them
+	InitGenesis(ctx, k, ExportGenesis(ctx, k))
+	_, broken = invariant(ctx)
+	assert.False(t, broken)
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	app "github.com/TruStory/truchain/types"
+	"github.com/TruStory/truchain/x/claim"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/gaskv"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -14,30 +15,35 @@ import (
 
 // Keeper is the model object for the package staking module
 type Keeper struct {
-	storeKey      sdk.StoreKey
-	codec         *codec.Codec
-	paramStore    params.Subspace
-	codespace     sdk.CodespaceType
-	bankKeeper    BankKeeper
-	accountKeeper AccountKeeper
-	claimKeeper   ClaimKeeper
-	supplyKeeper  supply.Keeper
+	storeKey        sdk.StoreKey
+	codec           *codec.Codec
+	paramStore      params.Subspace
+	codespace       sdk.CodespaceType
+	bankKeeper      BankKeeper
+	accountKeeper   AccountKeeper
+	claimKeeper     ClaimKeeper
+	communityKeeper CommunityKeeper
+	searchKeeper    SearchKeeper
+	supplyKeeper    supply.Keeper
 }
 
 // NewKeeper creates a staking keeper.
 func NewKeeper(codec *codec.Codec, storeKey sdk.StoreKey,
-	accountKeeper AccountKeeper, bankKeeper BankKeeper, claimKeeper ClaimKeeper, supplyKeeper supply.Keeper,
-	paramStore params.Subspace,
+	accountKeeper AccountKeeper, bankKeeper BankKeeper, claimKeeper ClaimKeeper,
+	communityKeeper CommunityKeeper, searchKeeper SearchKeeper,
+	supplyKeeper supply.Keeper, paramStore params.Subspace,
 	codespace sdk.CodespaceType) Keeper {
 	return Keeper{
-		storeKey:      storeKey,
-		codec:         codec,
-		paramStore:    paramStore.WithKeyTable(ParamKeyTable()),
-		codespace:     codespace,
-		bankKeeper:    bankKeeper,
-		accountKeeper: accountKeeper,
-		claimKeeper:   claimKeeper,
-		supplyKeeper:  supplyKeeper,
+		storeKey:        storeKey,
+		codec:           codec,
+		paramStore:      paramStore.WithKeyTable(ParamKeyTable()),
+		codespace:       codespace,
+		bankKeeper:      bankKeeper,
+		accountKeeper:   accountKeeper,
+		claimKeeper:     claimKeeper,
+		communityKeeper: communityKeeper,
+		searchKeeper:    searchKeeper,
+		supplyKeeper:    supplyKeeper,
 	}
 }
 
@@ -138,8 +144,11 @@ func (k Keeper) SubmitUpvote(ctx sdk.Context, argumentID uint64, creator sdk.Acc
 	if !ok {
 		return Stake{}, ErrCodeUnknownClaim(argument.ClaimID)
 	}
+	if !claim.IsOpen() {
+		return Stake{}, ErrCodeClaimNotOpen(argument.ClaimID)
+	}
 
-	upvoteStake := k.GetParams(ctx).UpvoteStake
+	upvoteStake := k.EffectiveParams(ctx, claim.CommunityID).UpvoteStake
 	stake, err := k.newStake(ctx, upvoteStake, creator, StakeUpvote, argumentID, claim.CommunityID)
 	if err != nil {
 		return stake, err
@@ -178,12 +187,16 @@ func (k Keeper) checkJailed(ctx sdk.Context, address sdk.AccAddress) sdk.Error {
 }
 
 func (k Keeper) SubmitArgument(ctx sdk.Context, body, summary string,
-	creator sdk.AccAddress, claimID uint64, stakeType StakeType) (Argument, sdk.Error) {
+	creator sdk.AccAddress, claimID uint64, stakeType StakeType, evidence ...claim.Evidence) (Argument, sdk.Error) {
 	// only backing or challenge
 	if !stakeType.ValidForArgument() {
 		return Argument{}, ErrCodeInvalidStakeType(stakeType)
 	}
-	err := k.checkJailed(ctx, creator)
+	err := k.validateEvidence(ctx, evidence)
+	if err != nil {
+		return Argument{}, err
+	}
+	err = k.checkJailed(ctx, creator)
 	if err != nil {
 		return Argument{}, err
 	}
@@ -191,6 +204,9 @@ func (k Keeper) SubmitArgument(ctx sdk.Context, body, summary string,
 	if !ok {
 		return Argument{}, ErrCodeUnknownClaim(claimID)
 	}
+	if !claim.IsOpen() {
+		return Argument{}, ErrCodeClaimNotOpen(claimID)
+	}
 
 	arguments := k.ClaimArguments(ctx, claimID)
 	count := 0
@@ -199,10 +215,14 @@ func (k Keeper) SubmitArgument(ctx sdk.Context, body, summary string,
 			count++
 		}
 	}
-	p := k.GetParams(ctx)
+	p := k.EffectiveParams(ctx, claim.CommunityID)
 	if count >= p.MaxArgumentsPerClaim {
 		return Argument{}, ErrCodeMaxNumOfArgumentsReached(p.MaxArgumentsPerClaim)
 	}
+	err = validateArgumentLength(p, body, summary)
+	if err != nil {
+		return Argument{}, err
+	}
 
 	creationAmount := p.ArgumentCreationStake
 	argumentID, err := k.argumentID(ctx)
@@ -223,6 +243,7 @@ func (k Keeper) SubmitArgument(ctx sdk.Context, body, summary string,
 		TotalStake:   creationAmount,
 		EditedTime:   ctx.BlockHeader().Time,
 		Edited:       false,
+		Evidence:     evidence,
 	}
 	_, err = k.newStake(ctx, creationAmount, creator, stakeType, argument.ID, claim.CommunityID)
 	if err != nil {
@@ -232,7 +253,11 @@ func (k Keeper) SubmitArgument(ctx sdk.Context, body, summary string,
 	k.setArgument(ctx, argument)
 	k.setArgumentID(ctx, argumentID+1)
 	k.setClaimArgument(ctx, claimID, argument.ID)
+	stats := k.communityKeeper.Stats(ctx, claim.CommunityID)
+	stats.ArgumentCount++
+	k.communityKeeper.SetStats(ctx, stats)
 	k.setUserArgument(ctx, creator, argument.ID)
+	k.searchKeeper.IndexArgument(ctx, argument.ID, argument.Summary)
 
 	if claim.FirstArgumentTime.Equal(time.Time{}) {
 		err = k.claimKeeper.SetFirstArgumentTime(ctx, claimID, ctx.BlockHeader().Time)
@@ -293,6 +318,9 @@ func (k Keeper) SetStakeExpired(ctx sdk.Context, stakeID uint64) sdk.Error {
 	if !ok {
 		return ErrCodeUnknownStake(stakeID)
 	}
+	if !stake.Expired {
+		k.subtractActiveStake(ctx, stake)
+	}
 	stake.Expired = true
 	k.setStake(ctx, stake)
 	return nil
@@ -339,6 +367,11 @@ func (k Keeper) RemoveAdmin(ctx sdk.Context, admin, remover sdk.AccAddress) (err
 	return
 }
 
+// isModerator returns true for staking admins and the moderators of the community
+func (k Keeper) isModerator(ctx sdk.Context, address sdk.AccAddress, communityID string) bool {
+	return k.isAdmin(ctx, address) || k.communityKeeper.IsModerator(ctx, communityID, address)
+}
+
 func (k Keeper) isAdmin(ctx sdk.Context, address sdk.AccAddress) bool {
 	for _, admin := range k.GetParams(ctx).StakingAdmins {
 		if address.Equals(admin) {
@@ -382,16 +415,19 @@ func (k Keeper) checkStakeThreshold(ctx sdk.Context, address sdk.AccAddress, amo
 
 	staked := sdk.NewInt(0)
 	fromDate := ctx.BlockHeader().Time.Add(time.Duration(-1) * period)
-	k.IterateAfterCreatedTimeUserStakes(ctx, address,
-		fromDate, func(stake Stake) bool {
-			// only account for non expired since expired would already have refunded the stake
-			if stake.Expired {
+	// stakes made with any key linked to the account count towards the same limit
+	for _, linked := range k.accountKeeper.Addresses(ctx, address) {
+		k.IterateAfterCreatedTimeUserStakes(ctx, linked,
+			fromDate, func(stake Stake) bool {
+				// only account for non expired since expired would already have refunded the stake
+				if stake.Expired {
+					return false
+				}
+				staked = staked.Add(stake.Amount.Amount)
 				return false
-			}
-			staked = staked.Add(stake.Amount.Amount)
-			return false
-		},
-	)
+			},
+		)
+	}
 	if balance.Sub(amount).LT(defaultMinimumBalance) {
 		return ErrCodeMinBalance()
 	}
@@ -453,7 +489,7 @@ func (k Keeper) newStake(ctx sdk.Context, amount sdk.Coin, creator sdk.AccAddres
 	if err != nil {
 		return Stake{}, err
 	}
-	period := k.GetParams(ctx).Period
+	period := k.EffectiveParams(ctx, communityID).Period
 	stakeID, err := k.stakeID(ctx)
 	if err != nil {
 		return Stake{}, err
@@ -477,6 +513,14 @@ func (k Keeper) newStake(ctx sdk.Context, amount sdk.Coin, creator sdk.AccAddres
 		Amount:      amount,
 		Type:        stakeType,
 	}
+	stats := k.communityKeeper.Stats(ctx, communityID)
+	if !k.hasCommunityStake(ctx, creator, communityID) {
+		stats.UniqueStakers++
+	}
+	stats.ActiveStake = stats.ActiveStake.Add(amount)
+	stats.LifetimeStake = stats.LifetimeStake.Add(amount)
+	k.communityKeeper.SetStats(ctx, stats)
+
 	k.setStake(ctx, stake)
 	k.setStakeID(ctx, stakeID+1)
 	k.InsertActiveStakeQueue(ctx, stakeID, stake.EndTime)
@@ -487,6 +531,28 @@ func (k Keeper) newStake(ctx sdk.Context, amount sdk.Coin, creator sdk.AccAddres
 	return stake, nil
 }
 
+// hasCommunityStake returns true if the user ever staked in the community
+func (k Keeper) hasCommunityStake(ctx sdk.Context, user sdk.AccAddress, communityID string) bool {
+	found := false
+	k.IterateUserCommunityStakes(ctx, user, communityID, func(stake Stake) bool {
+		found = true
+		return true
+	})
+	return found
+}
+
+// subtractActiveStake removes an expiring stake from the active stake of its community
+func (k Keeper) subtractActiveStake(ctx sdk.Context, stake Stake) {
+	stats := k.communityKeeper.Stats(ctx, stake.CommunityID)
+	// don't halt the chain on stats that were never imported, the invariant reports them
+	if stats.ActiveStake.IsLT(stake.Amount) {
+		stats.ActiveStake = sdk.NewInt64Coin(app.StakeDenom, 0)
+	} else {
+		stats.ActiveStake = stats.ActiveStake.Sub(stake.Amount)
+	}
+	k.communityKeeper.SetStats(ctx, stats)
+}
+
 func (k Keeper) Stake(ctx sdk.Context, stakeID uint64) (Stake, bool) {
 	stake := Stake{}
 	bz := k.store(ctx).Get(stakeKey(stakeID))
@@ -519,9 +585,10 @@ func (k Keeper) setID(ctx sdk.Context, key []byte, length uint64) {
 	k.store(ctx).Set(key, b)
 }
 
+// getEarnedCoins returns the coins earned by the account a user address belongs to
 func (k Keeper) getEarnedCoins(ctx sdk.Context, user sdk.AccAddress) sdk.Coins {
 	earnedCoins := sdk.Coins{}
-	bz := k.store(ctx).Get(userEarnedCoinsKey(user))
+	bz := k.store(ctx).Get(userEarnedCoinsKey(k.accountKeeper.PrimaryAddress(ctx, user)))
 	if bz == nil {
 		return sdk.NewCoins()
 	}
@@ -531,13 +598,17 @@ func (k Keeper) getEarnedCoins(ctx sdk.Context, user sdk.AccAddress) sdk.Coins {
 
 func (k Keeper) setEarnedCoins(ctx sdk.Context, user sdk.AccAddress, earnedCoins sdk.Coins) {
 	b := k.codec.MustMarshalBinaryLengthPrefixed(earnedCoins)
-	k.store(ctx).Set(userEarnedCoinsKey(user), b)
+	k.store(ctx).Set(userEarnedCoinsKey(k.accountKeeper.PrimaryAddress(ctx, user)), b)
 }
 
 func (k Keeper) addEarnedCoin(ctx sdk.Context, user sdk.AccAddress, communityID string, amount sdk.Int) {
 	earnedCoins := k.getEarnedCoins(ctx, user)
 	earnedCoins = earnedCoins.Add(sdk.NewCoins(sdk.NewCoin(communityID, amount)))
 	k.setEarnedCoins(ctx, user, earnedCoins)
+
+	stats := k.communityKeeper.Stats(ctx, communityID)
+	stats.TotalEarned = stats.TotalEarned.Add(sdk.NewCoin(app.StakeDenom, amount))
+	k.communityKeeper.SetStats(ctx, stats)
 }
 
 func (k Keeper) SubtractEarnedCoin(ctx sdk.Context, user sdk.AccAddress, communityID string, amount sdk.Int) {
@@ -604,25 +675,13 @@ func (k Keeper) UsersEarnings(ctx sdk.Context) []UserEarnedCoins {
 func (k Keeper) EditArgument(ctx sdk.Context, body, summary string,
 	creator sdk.AccAddress, argumentID uint64) (Argument, sdk.Error) {
 
-	err := k.checkJailed(ctx, creator)
+	argument, err := k.editableArgument(ctx, creator, argumentID)
 	if err != nil {
 		return Argument{}, err
 	}
-
-	argument, ok := k.Argument(ctx, argumentID)
-	if !ok {
-		return Argument{}, ErrCodeUnknownArgument(argumentID)
-	}
-
-	isAdmin := k.isAdmin(ctx, creator)
-
-	if !argument.Creator.Equals(creator) && !isAdmin {
-		return Argument{}, ErrCodeCannotEditArgumentWrongCreator(argumentID)
-	}
-
-	stakes := k.ArgumentStakes(ctx, argumentID)
-	if len(stakes) > 1 && !isAdmin {
-		return Argument{}, ErrCodeCannotEditArgumentAlreadyStaked(argumentID)
+	err = validateArgumentLength(k.EffectiveParams(ctx, argument.CommunityID), body, summary)
+	if err != nil {
+		return Argument{}, err
 	}
 
 	editedArgument := Argument{
@@ -640,8 +699,52 @@ func (k Keeper) EditArgument(ctx sdk.Context, body, summary string,
 		UpvotedCount: argument.UpvotedCount,
 		EditedTime:   ctx.BlockHeader().Time,
 		Edited:       true,
+		Evidence:     argument.Evidence,
 	}
 
 	k.setArgument(ctx, editedArgument)
+	k.searchKeeper.IndexArgument(ctx, argumentID, summary)
+	return argument, nil
+}
+
+// validateArgumentLength checks the body and summary of an argument against the params of its community
+func validateArgumentLength(p Params, body, summary string) sdk.Error {
+	bodyLength := len([]rune(body))
+	if bodyLength < p.ArgumentBodyMinLength || bodyLength > p.ArgumentBodyMaxLength {
+		return ErrCodeInvalidBodyLength()
+	}
+	summaryLength := len([]rune(summary))
+	if summaryLength < p.ArgumentSummaryMinLength || summaryLength > p.ArgumentSummaryMaxLength {
+		return ErrCodeInvalidSummaryLength()
+	}
+
+	return nil
+}
+
+// editableArgument returns the argument if the editor is allowed to edit it.
+// Only the creator can edit an argument until someone else stakes on it,
+// admins and moderators of the argument's community can always edit.
+func (k Keeper) editableArgument(ctx sdk.Context, editor sdk.AccAddress, argumentID uint64) (Argument, sdk.Error) {
+	err := k.checkJailed(ctx, editor)
+	if err != nil {
+		return Argument{}, err
+	}
+
+	argument, ok := k.Argument(ctx, argumentID)
+	if !ok {
+		return Argument{}, ErrCodeUnknownArgument(argumentID)
+	}
+
+	isModerator := k.isModerator(ctx, editor, argument.CommunityID)
+
+	if !argument.Creator.Equals(editor) && !isModerator {
+		return Argument{}, ErrCodeCannotEditArgumentWrongCreator(argumentID)
+	}
+
+	stakes := k.ArgumentStakes(ctx, argumentID)
+	if len(stakes) > 1 && !isModerator {
+		return Argument{}, ErrCodeCannotEditArgumentAlreadyStaked(argumentID)
+	}
+
 	return argument, nil
 }
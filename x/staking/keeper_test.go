@@ -10,6 +10,7 @@ import (
 	app "github.com/TruStory/truchain/types"
 	"github.com/TruStory/truchain/x/bank"
 	"github.com/TruStory/truchain/x/claim"
+	"github.com/TruStory/truchain/x/community"
 )
 
 func TestKeeper_SubmitArgumentMaxLimit(t *testing.T) {
@@ -168,6 +169,36 @@ func TestKeeper_SubmitArgument(t *testing.T) {
 	assert.Equal(t, []Stake{expectedStake, expectedStake2}, expiringStakes)
 }
 
+func TestKeeper_ClaimNotOpen(t *testing.T) {
+	ctx, k, mdb := mockDB()
+	mockedClaimKeeper := mdb.claimKeeper.(*mockClaimKeeper)
+	addr := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	addr2 := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	claims := make(map[uint64]claim.Claim)
+	claims[1] = claim.Claim{
+		ID:              1,
+		CommunityID:     "crypto",
+		TotalBacked:     sdk.NewInt64Coin(app.StakeDenom, 0),
+		TotalChallenged: sdk.NewInt64Coin(app.StakeDenom, 0),
+	}
+	mockedClaimKeeper.SetClaims(claims)
+
+	argument, err := k.SubmitArgument(ctx, "body", "summary", addr, 1, StakeBacking)
+	assert.NoError(t, err)
+
+	closed := claims[1]
+	closed.Status = claim.StatusClosed
+	claims[1] = closed
+
+	_, err = k.SubmitArgument(ctx, "body", "summary", addr2, 1, StakeChallenge)
+	assert.Error(t, err)
+	assert.Equal(t, ErrorCodeClaimNotOpen, err.Code())
+
+	_, err = k.SubmitUpvote(ctx, argument.ID, addr2)
+	assert.Error(t, err)
+	assert.Equal(t, ErrorCodeClaimNotOpen, err.Code())
+}
+
 func TestKeeper_FirstArgumentTime(t *testing.T) {
 	ctx, k, mdb := mockDB()
 	ctx = ctx.WithBlockTime(time.Now())
@@ -452,6 +483,30 @@ func TestKeeper_StakeLimitDefaultTier(t *testing.T) {
 	assert.Equal(t, ErrorCodeMaxAmountStakingReached, err.Code())
 }
 
+func TestKeeper_StakeLimitLinkedAddresses(t *testing.T) {
+	ctx, k, mdb := mockDB()
+	primary := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*700)})
+	linked := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*700)})
+	mdb.accountKeeper.(*mockedAccountKeeper).link(primary, linked)
+
+	k.addEarnedCoin(ctx, linked, "crypto", sdk.NewInt(app.Shanev*5))
+	assert.Equal(t, sdk.NewInt(app.Shanev*5), k.TotalEarnedCoins(ctx, primary))
+	assert.Equal(t, k.getEarnedCoins(ctx, primary), k.getEarnedCoins(ctx, linked))
+
+	for i := 1; i <= 5; i++ {
+		_, err := k.SubmitArgument(ctx, "arg1", "summary1", primary, uint64(i), StakeChallenge)
+		assert.NoError(t, err)
+	}
+	for i := 6; i <= 10; i++ {
+		_, err := k.SubmitArgument(ctx, "arg1", "summary1", linked, uint64(i), StakeChallenge)
+		assert.NoError(t, err)
+	}
+
+	_, err := k.SubmitArgument(ctx, "arg1", "summary1", linked, 11, StakeChallenge)
+	assert.Error(t, err)
+	assert.Equal(t, ErrorCodeMaxAmountStakingReached, err.Code())
+}
+
 func TestKeeper_StakeMinBalance(t *testing.T) {
 	ctx, k, mdb := mockDB()
 	addr := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
@@ -542,6 +597,66 @@ func TestKeeper_ClaimTotalsAdded(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestKeeper_ArgumentEvidence(t *testing.T) {
+	ctx, k, mdb := mockDB()
+	ctx = ctx.WithBlockTime(time.Now())
+	addr := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	addr2 := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+
+	link := claim.Evidence{Kind: claim.EvidenceLink, URI: "https://trustory.io/evidence"}
+	snapshot := claim.Evidence{Kind: claim.EvidenceSnapshot, URI: "https://archive.org/web/1"}
+	_, err := k.SubmitArgument(ctx, "body", "summary", addr, 1, StakeBacking, snapshot)
+	assert.Equal(t, ErrorCodeInvalidEvidence, err.Code())
+
+	argument, err := k.SubmitArgument(ctx, "body", "summary", addr, 1, StakeBacking, link)
+	assert.NoError(t, err)
+	assert.Equal(t, []claim.Evidence{link}, argument.Evidence)
+
+	snapshot.Digest = "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+	_, err = k.EditArgumentEvidence(ctx, []claim.Evidence{snapshot}, addr2, argument.ID)
+	assert.Equal(t, ErrorCodeCannotEditArgumentWrongCreator, err.Code())
+
+	edited, err := k.EditArgumentEvidence(ctx, []claim.Evidence{link, snapshot}, addr, argument.ID)
+	assert.NoError(t, err)
+	assert.True(t, edited.Edited)
+	assert.Equal(t, []claim.Evidence{link, snapshot}, edited.Evidence)
+
+	// editing the body keeps the evidence
+	_, err = k.EditArgument(ctx, "new body", "new summary", addr, argument.ID)
+	assert.NoError(t, err)
+	argument, _ = k.Argument(ctx, argument.ID)
+	assert.Equal(t, []claim.Evidence{link, snapshot}, argument.Evidence)
+
+	_, err = k.SubmitUpvote(ctx, argument.ID, addr2)
+	assert.NoError(t, err)
+	_, err = k.EditArgumentEvidence(ctx, nil, addr, argument.ID)
+	assert.Equal(t, ErrorCodeCannotEditArgumentAlreadyStaked, err.Code())
+}
+
+func TestKeeper_EditArgumentModerator(t *testing.T) {
+	ctx, k, mdb := mockDB()
+	ctx = ctx.WithBlockTime(time.Now())
+	addr := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	addr2 := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	moderator := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{})
+	otherModerator := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{})
+	mdb.communityKeeper.addModerator("testunit", moderator)
+	mdb.communityKeeper.addModerator("other", otherModerator)
+
+	argument, err := k.SubmitArgument(ctx, "body", "summary", addr, 1, StakeBacking)
+	assert.NoError(t, err)
+	_, err = k.SubmitUpvote(ctx, argument.ID, addr2)
+	assert.NoError(t, err)
+
+	_, err = k.EditArgument(ctx, "moderated body", "moderated summary", otherModerator, argument.ID)
+	assert.Equal(t, ErrorCodeCannotEditArgumentWrongCreator, err.Code())
+
+	_, err = k.EditArgument(ctx, "moderated body", "moderated summary", moderator, argument.ID)
+	assert.NoError(t, err)
+	argument, _ = k.Argument(ctx, argument.ID)
+	assert.Equal(t, "moderated body", argument.Body)
+}
+
 func TestAddAdmin_Success(t *testing.T) {
 	ctx, keeper, _ := mockDB()
 
@@ -589,3 +704,76 @@ func TestRemoveAdmin_RemoverNotAuthorised(t *testing.T) {
 	assert.NotNil(t, err)
 	assert.Equal(t, ErrAddressNotAuthorised().Code(), err.Code())
 }
+
+func TestKeeper_ParamOverrides(t *testing.T) {
+	ctx, k, mdb := mockDB()
+	ctx = ctx.WithBlockTime(time.Now())
+	addr := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	addr2 := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+
+	maxArguments := 1
+	upvoteStake := sdk.NewInt64Coin(app.StakeDenom, app.Shanev*20)
+	mdb.communityKeeper.overrides["testunit"] = community.ParamOverrides{
+		CommunityID:          "testunit",
+		MaxArgumentsPerClaim: &maxArguments,
+		UpvoteStake:          &upvoteStake,
+	}
+	p := k.EffectiveParams(ctx, "testunit")
+	assert.Equal(t, 1, p.MaxArgumentsPerClaim)
+	assert.Equal(t, k.GetParams(ctx).ArgumentCreationStake, p.ArgumentCreationStake)
+
+	argument, err := k.SubmitArgument(ctx, "body", "summary", addr, 1, StakeBacking)
+	assert.NoError(t, err)
+	_, err = k.SubmitArgument(ctx, "body", "summary", addr, 1, StakeBacking)
+	assert.Equal(t, ErrorCodeMaxNumOfArgumentsReached, err.Code())
+
+	stake, err := k.SubmitUpvote(ctx, argument.ID, addr2)
+	assert.NoError(t, err)
+	assert.Equal(t, upvoteStake, stake.Amount)
+}
+
+func TestKeeper_ParamOverrides_InterestRateCap(t *testing.T) {
+	ctx, k, mdb := mockDB()
+	globalRate := k.GetParams(ctx).InterestRate
+
+	lowerRate := globalRate.QuoInt64(2)
+	mdb.communityKeeper.overrides["testunit"] = community.ParamOverrides{
+		CommunityID:  "testunit",
+		InterestRate: &lowerRate,
+	}
+	assert.Equal(t, lowerRate, k.EffectiveParams(ctx, "testunit").InterestRate)
+
+	higherRate := globalRate.MulInt64(100)
+	mdb.communityKeeper.overrides["testunit"] = community.ParamOverrides{
+		CommunityID:  "testunit",
+		InterestRate: &higherRate,
+	}
+	assert.Equal(t, globalRate, k.EffectiveParams(ctx, "testunit").InterestRate)
+}
+
+func TestKeeper_ParamOverrides_ArgumentLength(t *testing.T) {
+	ctx, k, mdb := mockDB()
+	addr := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+
+	bodyMinLength, summaryMaxLength := 10, 10
+	mdb.communityKeeper.overrides["testunit"] = community.ParamOverrides{
+		CommunityID:              "testunit",
+		ArgumentBodyMinLength:    &bodyMinLength,
+		ArgumentSummaryMaxLength: &summaryMaxLength,
+	}
+	p := k.EffectiveParams(ctx, "testunit")
+	assert.Equal(t, bodyMinLength, p.ArgumentBodyMinLength)
+	assert.Equal(t, k.GetParams(ctx).ArgumentBodyMaxLength, p.ArgumentBodyMaxLength)
+
+	_, err := k.SubmitArgument(ctx, "body", "summary", addr, 1, StakeBacking)
+	assert.Equal(t, ErrorCodeInvalidBodyLength, err.Code())
+	_, err = k.SubmitArgument(ctx, "a longer body", "a longer summary", addr, 1, StakeBacking)
+	assert.Equal(t, ErrorCodeInvalidSummaryLength, err.Code())
+	argument, err := k.SubmitArgument(ctx, "a longer body", "summary", addr, 1, StakeBacking)
+	assert.NoError(t, err)
+
+	_, err = k.EditArgument(ctx, "body", "summary", addr, argument.ID)
+	assert.Equal(t, ErrorCodeInvalidBodyLength, err.Code())
+	_, err = k.EditArgument(ctx, "an edited body", "edited", addr, argument.ID)
+	assert.NoError(t, err)
+}
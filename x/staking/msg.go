@@ -3,6 +3,7 @@ package staking
 import (
 	"fmt"
 
+	"github.com/TruStory/truchain/x/claim"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -11,18 +12,20 @@ var _ sdk.Msg = &MsgSubmitArgument{}
 var _ sdk.Msg = &MsgSubmitUpvote{}
 var _ sdk.Msg = &MsgDeleteArgument{}
 var _ sdk.Msg = &MsgEditArgument{}
+var _ sdk.Msg = &MsgEditArgumentEvidence{}
 var _ sdk.Msg = &MsgAddAdmin{}
 var _ sdk.Msg = &MsgRemoveAdmin{}
 var _ sdk.Msg = &MsgUpdateParams{}
 
 const (
-	TypeMsgSubmitArgument = "submit_argument"
-	TypeMsgSubmitUpvote   = "submit_upvote"
-	TypeMsgDeleteArgument = "delete_argument"
-	TypeMsgEditArgument   = "edit_argument"
-	TypeMsgAddAdmin       = "add_admin"
-	TypeMsgRemoveAdmin    = "remove_admin"
-	TypeMsgUpdateParams   = "update_params"
+	TypeMsgSubmitArgument       = "submit_argument"
+	TypeMsgSubmitUpvote         = "submit_upvote"
+	TypeMsgDeleteArgument       = "delete_argument"
+	TypeMsgEditArgument         = "edit_argument"
+	TypeMsgEditArgumentEvidence = "edit_argument_evidence"
+	TypeMsgAddAdmin             = "add_admin"
+	TypeMsgRemoveAdmin          = "remove_admin"
+	TypeMsgUpdateParams         = "update_params"
 )
 
 // MsgSubmitArgument msg for creating an argument.
@@ -32,6 +35,8 @@ type MsgSubmitArgument struct {
 	Body      string         `json:"body"`
 	StakeType StakeType      `json:"stake_type"`
 	Creator   sdk.AccAddress `json:"creator"`
+	// Evidence is attached to the argument alongside its body
+	Evidence []claim.Evidence `json:"evidence,omitempty"`
 }
 
 // NewMsgSubmitArgument returns a new submit argument message.
@@ -207,6 +212,53 @@ func (msg MsgEditArgument) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Creator}
 }
 
+// MsgEditArgumentEvidence msg for replacing the evidence of an argument.
+type MsgEditArgumentEvidence struct {
+	Creator    sdk.AccAddress   `json:"creator"`
+	ArgumentID uint64           `json:"argument_id"`
+	Evidence   []claim.Evidence `json:"evidence"`
+}
+
+// NewMsgEditArgumentEvidence returns a new edit argument evidence message.
+func NewMsgEditArgumentEvidence(creator sdk.AccAddress, argumentID uint64, evidence []claim.Evidence) MsgEditArgumentEvidence {
+	return MsgEditArgumentEvidence{
+		Creator:    creator,
+		ArgumentID: argumentID,
+		Evidence:   evidence,
+	}
+}
+
+func (MsgEditArgumentEvidence) Route() string {
+	return RouterKey
+}
+
+func (MsgEditArgumentEvidence) Type() string {
+	return TypeMsgEditArgumentEvidence
+}
+
+func (msg MsgEditArgumentEvidence) ValidateBasic() sdk.Error {
+	if msg.ArgumentID == 0 {
+		return ErrCodeUnknownArgument(msg.ArgumentID)
+	}
+
+	if len(msg.Creator) == 0 {
+		return sdk.ErrInvalidAddress("Must provide a valid address")
+	}
+
+	return nil
+}
+
+// GetSignBytes gets the bytes for Msg signer to sign on
+func (msg MsgEditArgumentEvidence) GetSignBytes() []byte {
+	msgBytes := ModuleCodec.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(msgBytes)
+}
+
+// GetSigners gets the address of the signer of the Msg
+func (msg MsgEditArgumentEvidence) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Creator}
+}
+
 // MsgAddAdmin defines the message to add a new admin
 type MsgAddAdmin struct {
 	Admin   sdk.AccAddress `json:"admin"`
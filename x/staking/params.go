@@ -9,6 +9,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/params"
 
 	app "github.com/TruStory/truchain/types"
+	"github.com/TruStory/truchain/x/community"
 )
 
 var (
@@ -26,6 +27,10 @@ var (
 	ParamKeyStakeLimitDays           = []byte("stakeLimitDays")
 	ParamKeyUnjailUpvotes            = []byte("unjailUpvotes")
 	ParamKeyMaxArgumentsPerClaim     = []byte("maxArgumentsPerClaim")
+	ParamKeyVerdictBonusRate         = []byte("verdictBonusRate")
+	ParamKeyClaimCreatorShare        = []byte("claimCreatorShare")
+	ParamKeyMaxEvidenceCount         = []byte("maxEvidenceCount")
+	ParamKeyMaxEvidenceURILength     = []byte("maxEvidenceURILength")
 )
 
 type Params struct {
@@ -45,6 +50,14 @@ type Params struct {
 	StakeLimitDays       time.Duration `json:"stake_limit_days"`
 	UnjailUpvotes        int           `json:"unjail_upvotes"`
 	MaxArgumentsPerClaim int           `json:"max_arguments_per_claim"`
+	// VerdictBonusRate is the share of each winning stake paid as a bonus when a claim closes, zero disables it
+	VerdictBonusRate sdk.Dec `json:"verdict_bonus_rate"`
+	// ClaimCreatorShare is the share of argument interest paid on top to the claim creator
+	ClaimCreatorShare sdk.Dec `json:"claim_creator_share"`
+	// MaxEvidenceCount is the number of pieces of evidence an argument can have
+	MaxEvidenceCount int `json:"max_evidence_count"`
+	// MaxEvidenceURILength is the maximum length of an evidence URI
+	MaxEvidenceURILength int `json:"max_evidence_uri_length"`
 }
 
 func DefaultParams() Params {
@@ -63,6 +76,10 @@ func DefaultParams() Params {
 		StakeLimitDays:           time.Hour * 24 * 7,
 		UnjailUpvotes:            1,
 		MaxArgumentsPerClaim:     5,
+		VerdictBonusRate:         sdk.ZeroDec(),
+		ClaimCreatorShare:        sdk.NewDecWithPrec(10, 2),
+		MaxEvidenceCount:         10,
+		MaxEvidenceURILength:     2048,
 	}
 }
 
@@ -82,6 +99,10 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		{Key: ParamKeyStakeLimitDays, Value: &p.StakeLimitDays},
 		{Key: ParamKeyUnjailUpvotes, Value: &p.UnjailUpvotes},
 		{Key: ParamKeyMaxArgumentsPerClaim, Value: &p.MaxArgumentsPerClaim},
+		{Key: ParamKeyVerdictBonusRate, Value: &p.VerdictBonusRate},
+		{Key: ParamKeyClaimCreatorShare, Value: &p.ClaimCreatorShare},
+		{Key: ParamKeyMaxEvidenceCount, Value: &p.MaxEvidenceCount},
+		{Key: ParamKeyMaxEvidenceURILength, Value: &p.MaxEvidenceURILength},
 	}
 }
 
@@ -97,6 +118,56 @@ func (k Keeper) GetParams(ctx sdk.Context) Params {
 	return paramSet
 }
 
+// EffectiveParams gets the staking params inside a community, the global params
+// with the community param overrides applied
+func (k Keeper) EffectiveParams(ctx sdk.Context, communityID string) Params {
+	params := k.GetParams(ctx)
+	overrides := k.communityKeeper.EffectiveOverrides(ctx, communityID)
+	if overrides.ArgumentCreationStake != nil {
+		params.ArgumentCreationStake = *overrides.ArgumentCreationStake
+	}
+	if overrides.UpvoteStake != nil {
+		params.UpvoteStake = *overrides.UpvoteStake
+	}
+	if overrides.ArgumentBodyMinLength != nil {
+		params.ArgumentBodyMinLength = *overrides.ArgumentBodyMinLength
+	}
+	if overrides.ArgumentBodyMaxLength != nil {
+		params.ArgumentBodyMaxLength = *overrides.ArgumentBodyMaxLength
+	}
+	if overrides.ArgumentSummaryMinLength != nil {
+		params.ArgumentSummaryMinLength = *overrides.ArgumentSummaryMinLength
+	}
+	if overrides.ArgumentSummaryMaxLength != nil {
+		params.ArgumentSummaryMaxLength = *overrides.ArgumentSummaryMaxLength
+	}
+	if overrides.MaxArgumentsPerClaim != nil {
+		params.MaxArgumentsPerClaim = *overrides.MaxArgumentsPerClaim
+	}
+	// communities can lower the interest rate, but not raise it above the global rate,
+	// which can be lowered after the overrides were set
+	if overrides.InterestRate != nil && overrides.InterestRate.LT(params.InterestRate) {
+		params.InterestRate = *overrides.InterestRate
+	}
+
+	return params
+}
+
+// GlobalParamOverrides returns the global staking params that communities can override
+func (k Keeper) GlobalParamOverrides(ctx sdk.Context) community.ParamOverrides {
+	params := k.GetParams(ctx)
+	return community.ParamOverrides{
+		ArgumentCreationStake:    &params.ArgumentCreationStake,
+		UpvoteStake:              &params.UpvoteStake,
+		ArgumentBodyMinLength:    &params.ArgumentBodyMinLength,
+		ArgumentBodyMaxLength:    &params.ArgumentBodyMaxLength,
+		ArgumentSummaryMinLength: &params.ArgumentSummaryMinLength,
+		ArgumentSummaryMaxLength: &params.ArgumentSummaryMaxLength,
+		MaxArgumentsPerClaim:     &params.MaxArgumentsPerClaim,
+		InterestRate:             &params.InterestRate,
+	}
+}
+
 // SetParams sets the params for staking module
 func (k Keeper) SetParams(ctx sdk.Context, params Params) {
 	logger := ctx.Logger().With("module", ModuleName)
@@ -2,6 +2,7 @@ package staking
 
 import (
 	app "github.com/TruStory/truchain/types"
+	"github.com/TruStory/truchain/x/claim"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 )
@@ -19,6 +20,8 @@ const (
 	QueryClaimTopArgument    = "claim_top_argument"
 	QueryEarnedCoins         = "earned_coins"
 	QueryTotalEarnedCoins    = "total_earned_coins"
+	QueryArgumentEvidence    = "argument_evidence"
+	QueryEffectiveParams     = "effective_params"
 	QueryParams              = "params"
 )
 
@@ -40,6 +43,8 @@ type QueryArgumentStakesParams struct {
 
 type QueryCommunityStakesParams struct {
 	CommunityID string `json:"community_id"`
+	// IncludeDescendants adds the stakes of every community nested under the community
+	IncludeDescendants bool `json:"include_descendants,omitempty"`
 }
 
 type QueryStakeParams struct {
@@ -99,6 +104,10 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryEarnedCoins(ctx, req, keeper)
 		case QueryTotalEarnedCoins:
 			return queryTotalEarnedCoins(ctx, req, keeper)
+		case QueryArgumentEvidence:
+			return queryArgumentEvidence(ctx, req, keeper)
+		case QueryEffectiveParams:
+			return queryEffectiveParams(ctx, req, keeper)
 		case QueryParams:
 			return queryParams(ctx, keeper)
 		default:
@@ -114,7 +123,7 @@ func queryClaimArgument(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (
 		return nil, ErrInvalidQueryParams(err)
 	}
 	argument, ok := keeper.Argument(ctx, params.ArgumentID)
-	if !ok {
+	if !ok || argument.IsDeleted {
 		return nil, ErrCodeUnknownArgument(params.ArgumentID)
 	}
 	bz, err := keeper.codec.MarshalJSON(argument)
@@ -124,13 +133,34 @@ func queryClaimArgument(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (
 	return bz, nil
 }
 
+func queryArgumentEvidence(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params QueryClaimArgumentParams
+	err := keeper.codec.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, ErrInvalidQueryParams(err)
+	}
+	argument, ok := keeper.Argument(ctx, params.ArgumentID)
+	if !ok || argument.IsDeleted {
+		return nil, ErrCodeUnknownArgument(params.ArgumentID)
+	}
+	evidence := argument.Evidence
+	if evidence == nil {
+		evidence = make([]claim.Evidence, 0)
+	}
+	bz, err := keeper.codec.MarshalJSON(evidence)
+	if err != nil {
+		return nil, ErrJSONParse(err)
+	}
+	return bz, nil
+}
+
 func queryUserArguments(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
 	var params QueryUserArgumentsParams
 	err := keeper.codec.UnmarshalJSON(req.Data, &params)
 	if err != nil {
 		return nil, ErrInvalidQueryParams(err)
 	}
-	arguments := keeper.UserArguments(ctx, params.Address)
+	arguments := visibleArguments(keeper.UserArguments(ctx, params.Address))
 	bz, err := keeper.codec.MarshalJSON(arguments)
 	if err != nil {
 		return nil, ErrJSONParse(err)
@@ -144,7 +174,7 @@ func queryClaimArguments(ctx sdk.Context, req abci.RequestQuery, keeper Keeper)
 	if err != nil {
 		return nil, ErrInvalidQueryParams(err)
 	}
-	arguments := keeper.ClaimArguments(ctx, params.ClaimID)
+	arguments := visibleArguments(keeper.ClaimArguments(ctx, params.ClaimID))
 	bz, err := keeper.codec.MarshalJSON(arguments)
 	if err != nil {
 		return nil, ErrJSONParse(err)
@@ -173,6 +203,11 @@ func queryCommunityStakes(ctx sdk.Context, req abci.RequestQuery, keeper Keeper)
 		return nil, ErrInvalidQueryParams(err)
 	}
 	stakes := keeper.CommunityStakes(ctx, params.CommunityID)
+	if params.IncludeDescendants {
+		for _, communityID := range keeper.communityKeeper.Descendants(ctx, params.CommunityID) {
+			stakes = append(stakes, keeper.CommunityStakes(ctx, communityID)...)
+		}
+	}
 	bz, err := keeper.codec.MarshalJSON(stakes)
 	if err != nil {
 		return nil, ErrJSONParse(err)
@@ -208,7 +243,7 @@ func queryArgumentsByIDs(ctx sdk.Context, req abci.RequestQuery, keeper Keeper)
 	var arguments []Argument
 	for _, id := range params.ArgumentIDs {
 		a, ok := keeper.Argument(ctx, id)
-		if !ok {
+		if !ok || a.IsDeleted {
 			return nil, ErrCodeUnknownArgument(id)
 		}
 		arguments = append(arguments, a)
@@ -255,7 +290,7 @@ func queryClaimTopArgument(ctx sdk.Context, req abci.RequestQuery, keeper Keeper
 	if err != nil {
 		return nil, ErrInvalidQueryParams(err)
 	}
-	arguments := keeper.ClaimArguments(ctx, params.ClaimID)
+	arguments := visibleArguments(keeper.ClaimArguments(ctx, params.ClaimID))
 	topArgument := Argument{}
 	if len(arguments) == 0 {
 		bz, err := keeper.codec.MarshalJSON(topArgument)
@@ -310,6 +345,30 @@ func queryTotalEarnedCoins(ctx sdk.Context, req abci.RequestQuery, keeper Keeper
 	return bz, nil
 }
 
+// visibleArguments filters out arguments on deleted claims
+func visibleArguments(arguments []Argument) []Argument {
+	visible := make([]Argument, 0, len(arguments))
+	for _, a := range arguments {
+		if !a.IsDeleted {
+			visible = append(visible, a)
+		}
+	}
+	return visible
+}
+
+func queryEffectiveParams(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params QueryCommunityStakesParams
+	err := keeper.codec.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, ErrInvalidQueryParams(err)
+	}
+	bz, err := keeper.codec.MarshalJSON(keeper.EffectiveParams(ctx, params.CommunityID))
+	if err != nil {
+		return nil, ErrJSONParse(err)
+	}
+	return bz, nil
+}
+
 func queryParams(ctx sdk.Context, keeper Keeper) (result []byte, err sdk.Error) {
 	params := keeper.GetParams(ctx)
 
@@ -11,6 +11,7 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	app "github.com/TruStory/truchain/types"
+	"github.com/TruStory/truchain/x/claim"
 )
 
 func TestQuerier_EmptyTopArgument(t *testing.T) {
@@ -118,6 +119,28 @@ func TestQuerier_ArgumentsByIDs(t *testing.T) {
 	assert.Len(t, arguments, 2)
 }
 
+func TestQuerier_ArgumentEvidence(t *testing.T) {
+	ctx, k, mdb := mockDB()
+	ctx = ctx.WithBlockTime(time.Now())
+	addr := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+
+	evidence := claim.Evidence{Kind: claim.EvidenceLink, URI: "https://trustory.io/evidence"}
+	argument, err := k.SubmitArgument(ctx, "body", "summary", addr, 1, StakeBacking, evidence)
+	assert.NoError(t, err)
+
+	querier := NewQuerier(k)
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{"custom", QuerierRoute, QueryArgumentEvidence}, "/"),
+		Data: k.codec.MustMarshalJSON(QueryClaimArgumentParams{ArgumentID: argument.ID}),
+	}
+	bz, err := querier(ctx, []string{QueryArgumentEvidence}, query)
+	assert.NoError(t, err)
+
+	var returned []claim.Evidence
+	k.codec.MustUnmarshalJSON(bz, &returned)
+	assert.Equal(t, []claim.Evidence{evidence}, returned)
+}
+
 func TestQuerier_CommunityStakes(t *testing.T) {
 	ctx, k, mdb := mockDB()
 	ctx = ctx.WithBlockTime(time.Now())
@@ -148,6 +171,21 @@ func TestQuerier_CommunityStakes(t *testing.T) {
 	var stakes []Stake
 	k.codec.UnmarshalJSON(bz, &stakes)
 	assert.Len(t, stakes, 2)
+
+	mdb.communityKeeper.descendants["parent"] = []string{claim1.CommunityID}
+	queryParams = QueryCommunityStakesParams{CommunityID: "parent"}
+	query.Data = k.codec.MustMarshalJSON(&queryParams)
+	bz, err = querier(ctx, []string{QueryCommunityStakes}, query)
+	assert.NoError(t, err)
+	k.codec.UnmarshalJSON(bz, &stakes)
+	assert.Len(t, stakes, 0)
+
+	queryParams.IncludeDescendants = true
+	query.Data = k.codec.MustMarshalJSON(&queryParams)
+	bz, err = querier(ctx, []string{QueryCommunityStakes}, query)
+	assert.NoError(t, err)
+	k.codec.UnmarshalJSON(bz, &stakes)
+	assert.Len(t, stakes, 2)
 }
 
 func TestQuerier_UserCommunityStakes(t *testing.T) {
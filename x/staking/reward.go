@@ -4,6 +4,7 @@ import (
 	"time"
 
 	app "github.com/TruStory/truchain/types"
+	"github.com/TruStory/truchain/x/claim"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -28,6 +29,8 @@ type RewardResult struct {
 	ArgumentCreatorReward sdk.Coin         `json:"argument_creator_reward"`
 	StakeCreator          sdk.AccAddress   `json:"stake_creator"`
 	StakeCreatorReward    sdk.Coin         `json:"stake_creator_reward"`
+	ClaimCreator          sdk.AccAddress   `json:"claim_creator,omitempty"`
+	ClaimCreatorReward    sdk.Coin         `json:"claim_creator_reward"`
 }
 
 func (k Keeper) distributeReward(ctx sdk.Context, stake Stake) (RewardResult, sdk.Error) {
@@ -41,28 +44,18 @@ func (k Keeper) distributeReward(ctx sdk.Context, stake Stake) (RewardResult, sd
 	}
 
 	// refund
-	var refundType TransactionType
-
-	switch stake.Type {
-	case StakeBacking:
-		refundType = TransactionBackingReturned
-	case StakeChallenge:
-		refundType = TransactionChallengeReturned
-	case StakeUpvote:
-		refundType = TransactionUpvoteReturned
-	default:
-		return RewardResult{}, ErrCodeUnknownStakeType()
+	err := k.refundStake(ctx, stake, argument.CommunityID)
+	if err != nil {
+		return RewardResult{}, err
 	}
 
-	_, err := k.bankKeeper.AddCoin(ctx, stake.Creator, stake.Amount, stake.ArgumentID,
-		refundType, WithCommunityID(argument.CommunityID),
-		FromModuleAccount(UserStakesPoolName),
-	)
+	interestRate := k.EffectiveParams(ctx, stake.CommunityID).InterestRate
+	interest := Interest(interestRate, stake.Amount, stake.EndTime.Sub(stake.CreatedTime))
+	claimCreatorReward, err := k.payClaimCreatorReward(ctx, claim, stake, interest)
 	if err != nil {
 		return RewardResult{}, err
 	}
 
-	interest := k.interest(ctx, stake.Amount, stake.EndTime.Sub(stake.CreatedTime))
 	// creator receives 100% interest of his own stake
 	if argument.Creator.Equals(stake.Creator) {
 		reward := sdk.NewCoin(app.StakeDenom, interest.RoundInt())
@@ -80,7 +73,9 @@ func (k Keeper) distributeReward(ctx sdk.Context, stake Stake) (RewardResult, sd
 		k.addEarnedCoin(ctx, argument.Creator, claim.CommunityID, reward.Amount)
 		return RewardResult{Type: RewardResultArgumentCreation,
 			ArgumentCreator:       argument.Creator,
-			ArgumentCreatorReward: reward}, nil
+			ArgumentCreatorReward: reward,
+			ClaimCreator:          claim.Creator,
+			ClaimCreatorReward:    claimCreatorReward}, nil
 	}
 	creatorReward, stakerReward := k.splitReward(ctx, interest)
 	creatorRewardCoin := sdk.NewCoin(app.StakeDenom, creatorReward)
@@ -117,10 +112,66 @@ func (k Keeper) distributeReward(ctx sdk.Context, stake Stake) (RewardResult, sd
 		ArgumentCreatorReward: creatorRewardCoin,
 		StakeCreator:          stake.Creator,
 		StakeCreatorReward:    stakerRewardCoin,
+		ClaimCreator:          claim.Creator,
+		ClaimCreatorReward:    claimCreatorReward,
 	}
 	return rewardResult, nil
 }
 
+// refundStake returns the staked amount to the stake creator
+func (k Keeper) refundStake(ctx sdk.Context, stake Stake, communityID string) sdk.Error {
+	var refundType TransactionType
+
+	switch stake.Type {
+	case StakeBacking:
+		refundType = TransactionBackingReturned
+	case StakeChallenge:
+		refundType = TransactionChallengeReturned
+	case StakeUpvote:
+		refundType = TransactionUpvoteReturned
+	default:
+		return ErrCodeUnknownStakeType()
+	}
+
+	_, err := k.bankKeeper.AddCoin(ctx, stake.Creator, stake.Amount, stake.ArgumentID,
+		refundType, WithCommunityID(communityID),
+		FromModuleAccount(UserStakesPoolName),
+	)
+
+	return err
+}
+
+// payClaimCreatorReward pays the claim creator a share of the interest generated by a stake on the claim.
+// The reward comes on top of the argument and stake creator rewards. Upvotes and stakes
+// made by the claim creator itself, from any of its keys, don't pay a claim creator reward.
+func (k Keeper) payClaimCreatorReward(ctx sdk.Context, c claim.Claim, stake Stake, interest sdk.Dec) (sdk.Coin, sdk.Error) {
+	noReward := sdk.NewCoin(app.StakeDenom, sdk.ZeroInt())
+	if c.Creator.Empty() || stake.Type == StakeUpvote {
+		return noReward, nil
+	}
+	if k.accountKeeper.PrimaryAddress(ctx, stake.Creator).Equals(k.accountKeeper.PrimaryAddress(ctx, c.Creator)) {
+		return noReward, nil
+	}
+	reward := sdk.NewCoin(app.StakeDenom, interest.Mul(k.GetParams(ctx).ClaimCreatorShare).RoundInt())
+	if !reward.IsPositive() {
+		return noReward, nil
+	}
+	_, err := k.bankKeeper.AddCoin(ctx,
+		c.Creator,
+		reward,
+		stake.ID,
+		TransactionInterestClaimCreation,
+		WithCommunityID(c.CommunityID),
+		FromModuleAccount(UserRewardPoolName),
+	)
+	if err != nil {
+		return reward, err
+	}
+	k.addEarnedCoin(ctx, c.Creator, c.CommunityID, reward.Amount)
+
+	return reward, nil
+}
+
 func (k Keeper) interest(ctx sdk.Context, amount sdk.Coin, period time.Duration) sdk.Dec {
 	interestRate := k.GetParams(ctx).InterestRate
 	return Interest(interestRate, amount, period)
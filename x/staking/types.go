@@ -7,6 +7,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/TruStory/truchain/x/bank"
+	"github.com/TruStory/truchain/x/claim"
 )
 
 // Defines staking module constants
@@ -22,7 +23,19 @@ const (
 	EventTypeStakeLimitIncreased  = "stake-limit-increased"
 	AttributeKeyStakeLimitUpgrade = "stake-limit-upgrade"
 
-	UserStakesPoolName = "user_stakes_tokens_pool"
+	EventTypeVerdictBonusPaid = "verdict-bonus-paid"
+	AttributeKeyBonusedStakes = "bonused-stakes"
+
+	EventTypeStakesRefunded    = "stakes-refunded"
+	AttributeKeyRefundedStakes = "refunded-stakes"
+
+	EventTypeClaimEditAffectedUsers = "claim-edit-affected-users"
+	AttributeKeyClaimID             = "claim-id"
+	AttributeKeyArgumentCreators    = "argument-creators"
+	AttributeKeyStakeCreators       = "stake-creators"
+
+	EventTypeArgumentEvidenceEdited = "argument-evidence-edited"
+	AttributeKeyArgumentID          = "argument-id"
 )
 
 type StakeType byte
@@ -91,6 +104,8 @@ type Stake struct {
 	EndTime     time.Time      `json:"end_time"`
 	Expired     bool           `json:"expired"`
 	Result      *RewardResult  `json:"result,omitempty"`
+	// VerdictBonus is set once the stake received a bonus for being on the winning side of a claim
+	VerdictBonus *sdk.Coin `json:"verdict_bonus,omitempty"`
 }
 
 func (s Stake) String() string {
@@ -118,6 +133,10 @@ type Argument struct {
 	UpdatedTime    time.Time      `json:"updated_time"`
 	EditedTime     time.Time      `json:"edited_time"`
 	Edited         bool           `json:"edited"`
+	// IsDeleted is set when the claim of the argument is deleted
+	IsDeleted bool `json:"is_deleted,omitempty"`
+	// Evidence supports the argument alongside its body
+	Evidence []claim.Evidence `json:"evidence,omitempty"`
 }
 
 type StakeLimitUpgrade struct {